@@ -0,0 +1,182 @@
+package galldir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const adminPath = "/admin"
+
+// Admin configures the administration page at /admin, which shows the state
+// of the Provider's cache, recent problems reading the gallery and the
+// effective configuration, and from which the cache may be invalidated and
+// the gallery crawled. It is reached with HTTP basic authentication as
+// Username with Password, and is disabled if Password is empty.
+type Admin struct {
+	Username string
+	Password string
+	// Config is the configuration of the gallery, by setting name, shown
+	// on the page. It should not hold any secrets.
+	Config map[string]string
+	// token is sent with each form, so that other sites cannot have the
+	// browser of a signed in administrator submit them.
+	token     string
+	tokenOnce sync.Once
+	crawling  int32
+}
+
+// authorized returns true if the request carries the Admin's credentials.
+func (a *Admin) authorized(r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	if !ok || a.Password == "" {
+		return false
+	}
+	// comparing hashes keeps the time taken from revealing the lengths
+	usernameOK := equalHashes(username, a.Username)
+	passwordOK := equalHashes(password, a.Password)
+	return usernameOK && passwordOK
+}
+
+func equalHashes(a, b string) bool {
+	hashA, hashB := sha256.Sum256([]byte(a)), sha256.Sum256([]byte(b))
+	return subtle.ConstantTimeCompare(hashA[:], hashB[:]) == 1
+}
+
+// csrfToken returns the token sent with the Admin's forms, which is made
+// up afresh each time the gallery starts.
+func (a *Admin) csrfToken() string {
+	a.tokenOnce.Do(func() {
+		b := make([]byte, 16)
+		rand.Read(b)
+		a.token = hex.EncodeToString(b)
+	})
+	return a.token
+}
+
+// The outcomes of the Admin's actions, passed back to the page after each.
+var adminMessages = map[string]string{
+	"invalidate": "Removed %d entries from the cache.",
+	"thumbs":     "Removed %d thumbnails from the cache.",
+	"crawl":      "Started a crawl of the gallery.",
+	"crawling":   "A crawl of the gallery is already running.",
+}
+
+func (s *Server) admin(w http.ResponseWriter, r *http.Request) {
+	if !s.Admin.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="galldir", charset="UTF-8"`)
+		s.error(w, r, http.StatusUnauthorized, errors.New("not signed in as administrator"))
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		s.renderAdmin(w, r)
+	case http.MethodPost:
+		s.adminAction(w, r)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		s.error(w, r, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	}
+}
+
+// adminAction carries out the action posted from the administration page,
+// then redirects back to it.
+func (s *Server) adminAction(w http.ResponseWriter, r *http.Request) {
+	token := r.PostFormValue("token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.Admin.csrfToken())) != 1 {
+		s.error(w, r, http.StatusForbidden, errors.New("missing or wrong admin form token"))
+		return
+	}
+	action := r.PostFormValue("action")
+	removed := 0
+	switch action {
+	case "invalidate":
+		albumPath := path.Clean("/" + r.PostFormValue("path"))
+		removed = s.Provider.Invalidate(albumPath)
+		requestLogger(r).Info("invalidated cache", "album", albumPath, "entries", removed)
+	case "thumbs":
+		removed = s.Provider.InvalidateThumbs()
+		requestLogger(r).Info("invalidated thumbnails", "entries", removed)
+	case "crawl":
+		if !s.crawl() {
+			action = "crawling"
+		}
+	default:
+		s.error(w, r, http.StatusBadRequest, fmt.Errorf("unknown admin action %q", action))
+		return
+	}
+	query := url.Values{"done": {action}, "removed": {strconv.Itoa(removed)}}
+	http.Redirect(w, r, s.pathPrefix(r)+adminPath+"?"+query.Encode(), http.StatusSeeOther)
+}
+
+// crawl starts loading every album in the gallery in the background, so
+// that they are cached and, if there is an Index, indexed. It returns false
+// if a crawl started this way is already running.
+func (s *Server) crawl() bool {
+	if !atomic.CompareAndSwapInt32(&s.Admin.crawling, 0, 1) {
+		return false
+	}
+	go func() {
+		defer atomic.StoreInt32(&s.Admin.crawling, 0)
+		start := time.Now()
+		if s.Index != nil {
+			s.Index.Crawl()
+		} else {
+			s.Provider.Walk("/", func(*Album) {})
+		}
+		s.logger().Info("crawled the gallery", "duration", time.Since(start))
+	}()
+	return true
+}
+
+func (s *Server) renderAdmin(w http.ResponseWriter, r *http.Request) {
+	message := ""
+	if format, ok := adminMessages[r.URL.Query().Get("done")]; ok {
+		message = format
+		if strings.Contains(format, "%d") {
+			removed, _ := requestParamInt(r, "removed")
+			message = fmt.Sprintf(format, removed)
+		}
+	}
+	indexed := 0
+	if s.Index != nil {
+		indexed = len(s.Index.Albums())
+	}
+	buf := bytes.NewBuffer(nil)
+	err := s.template(r, "admin.html").Execute(buf, struct {
+		Token    string
+		Message  string
+		Crawling bool
+		Indexed  int
+		Cache    []CacheStats
+		Problems []Problem
+		Config   map[string]string
+	}{
+		Token:    s.Admin.csrfToken(),
+		Message:  message,
+		Crawling: atomic.LoadInt32(&s.Admin.crawling) == 1,
+		Indexed:  indexed,
+		Cache:    s.Provider.CacheStats(),
+		Problems: s.Provider.Problems(),
+		Config:   s.Admin.Config,
+	})
+	if err != nil {
+		s.error(w, r, http.StatusInternalServerError, fmt.Errorf("failed to render admin.html: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,194 @@
+package galldir_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func adminServer(t *testing.T) *galldir.Server {
+	t.Helper()
+	s := testServer(t)
+	s.Admin = &galldir.Admin{
+		Username: "admin",
+		Password: "secret",
+		Config:   map[string]string{"pagesize": "100"},
+	}
+	return s
+}
+
+func adminRequest(s *galldir.Server, method, target string, form url.Values) *httptest.ResponseRecorder {
+	var r *http.Request
+	if form != nil {
+		r = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		r = httptest.NewRequest(method, target, nil)
+	}
+	r.SetBasicAuth("admin", "secret")
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w
+}
+
+// adminToken returns the token sent with the forms on the admin page.
+func adminToken(t *testing.T, s *galldir.Server) string {
+	t.Helper()
+	w := adminRequest(s, "GET", "/admin", nil)
+	match := regexp.MustCompile(`name="token" value="([0-9a-f]+)"`).FindStringSubmatch(w.Body.String())
+	if match == nil {
+		t.Fatalf("no token in admin page:\n%s", w.Body.String())
+	}
+	return match[1]
+}
+
+func TestAdminAuth(t *testing.T) {
+	tests := []struct {
+		username string
+		password string
+		status   int
+		contains string
+	}{
+		{"", "", http.StatusUnauthorized, "Unauthorized"},
+		{"admin", "wrong", http.StatusUnauthorized, "Unauthorized"},
+		{"someone", "secret", http.StatusUnauthorized, "Unauthorized"},
+		{"admin", "secret", http.StatusOK, "<dt>pagesize</dt><dd>100</dd>"},
+	}
+	s := adminServer(t)
+	for _, tc := range tests {
+		r := httptest.NewRequest("GET", "/admin", nil)
+		if tc.username != "" {
+			r.SetBasicAuth(tc.username, tc.password)
+		}
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, r)
+		if w.Code != tc.status {
+			t.Errorf("%s/%s: expected status %d, got %d", tc.username, tc.password, tc.status, w.Code)
+		}
+		if !strings.Contains(w.Body.String(), tc.contains) {
+			t.Errorf("%s/%s: expected body containing %q", tc.username, tc.password, tc.contains)
+		}
+		if tc.status == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("%s/%s: no WWW-Authenticate header", tc.username, tc.password)
+		}
+	}
+
+	s.Admin = nil
+	w := adminRequest(s, "GET", "/admin", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("admin disabled: expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestAdminActions(t *testing.T) {
+	s := adminServer(t)
+	token := adminToken(t, s)
+	cached := func(name string) bool {
+		_, ok := s.Provider.Cache.Get(name)
+		return ok
+	}
+
+	tests := []struct {
+		form     url.Values
+		status   int
+		location string
+		message  string
+	}{
+		{url.Values{"action": {"thumbs"}}, http.StatusForbidden, "", ""},
+		{url.Values{"action": {"thumbs"}, "token": {"wrong"}}, http.StatusForbidden, "", ""},
+		{url.Values{"action": {"explode"}, "token": {token}}, http.StatusBadRequest, "", ""},
+		{
+			url.Values{"action": {"invalidate"}, "path": {"/subalbum"}, "token": {token}},
+			http.StatusSeeOther, "/admin?done=invalidate&removed=",
+			"entries from the cache.",
+		},
+		{
+			url.Values{"action": {"crawl"}, "token": {token}},
+			http.StatusSeeOther, "/admin?done=crawl",
+			"Started a crawl of the gallery.",
+		},
+		{
+			url.Values{"action": {"thumbs"}, "token": {token}},
+			http.StatusSeeOther, "/admin?done=thumbs&removed=1",
+			"Removed 1 thumbnails from the cache.",
+		},
+	}
+	for _, tc := range tests {
+		// each action starts with the albums and a thumbnail cached
+		for _, path := range []string{"/", "/subalbum/", "/subalbum/icon.png?thumb=10"} {
+			if w := adminRequest(s, "GET", path, nil); w.Code != http.StatusOK {
+				t.Fatalf("%s: status %d", path, w.Code)
+			}
+		}
+		w := adminRequest(s, "POST", "/admin", tc.form)
+		if w.Code != tc.status {
+			t.Errorf("%v: expected status %d, got %d", tc.form, tc.status, w.Code)
+			continue
+		}
+		location := w.Header().Get("Location")
+		if !strings.HasPrefix(location, tc.location) {
+			t.Errorf("%v: expected redirect to %s, got %s", tc.form, tc.location, location)
+		}
+		if tc.message == "" {
+			continue
+		}
+		page := adminRequest(s, "GET", location, nil)
+		if !strings.Contains(page.Body.String(), tc.message) {
+			t.Errorf("%v: expected message %q", tc.form, tc.message)
+		}
+	}
+
+	// the last action left the albums cached and removed the thumbnail
+	if !cached("album-/") || !cached("album-/subalbum/") {
+		t.Error("albums not cached after removing thumbnails")
+	}
+	if cached(galldir.ThumbName("thumb", 10, "/subalbum/icon.png")) {
+		t.Error("thumbnail still cached")
+	}
+	for _, path := range []string{"/", "/subalbum/"} {
+		if w := adminRequest(s, "GET", path, nil); w.Code != http.StatusOK {
+			t.Fatalf("%s: status %d", path, w.Code)
+		}
+	}
+	s.Provider.Invalidate("/subalbum/icon.png")
+	if !cached("album-/") || cached("album-/subalbum/") {
+		t.Error("invalidated the wrong albums for a photo")
+	}
+	s.Provider.Invalidate("/subalbum/")
+	if cached("album-/") {
+		t.Error("left the parent album's listing cached")
+	}
+}
+
+func TestAdminProblems(t *testing.T) {
+	backend := &brokenFS{FileSystem: http.Dir("testdata/broken")}
+	provider := galldir.NewProvider(backend)
+
+	if _, err := provider.ImageThumb("/broken.jpg", 10); err == nil {
+		t.Error("expected an error decoding a broken photo")
+	}
+	backend.set(errors.New("backend down"), 0)
+	if _, err := provider.Album("/", false); err == nil {
+		t.Error("expected an error from a broken backend")
+	}
+	backend.set(os.ErrNotExist, 0)
+	provider.Album("/missing/", false)
+
+	problems := provider.Problems()
+	if len(problems) != 2 {
+		t.Fatalf("expected 2 problems, got %v", problems)
+	}
+	if problems[0].Kind != galldir.ProblemBackend || problems[0].Path != "/" || problems[0].Err != "backend down" {
+		t.Errorf("unexpected newest problem %v", problems[0])
+	}
+	if problems[1].Kind != galldir.ProblemDecode || problems[1].Path != "/broken.jpg" {
+		t.Errorf("unexpected oldest problem %v", problems[1])
+	}
+}
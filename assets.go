@@ -0,0 +1,221 @@
+package galldir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sync"
+	"text/template/parse"
+	"time"
+)
+
+// AssetServer serves static assets. Each asset has a fingerprinted URL
+// that changes along with its content, so it may be cached indefinitely.
+// Text assets are compressed for clients that accept it, using a
+// precompressed name.br or name.gz alongside the asset where there is one.
+type AssetServer struct {
+	FS         http.FileSystem
+	fileServer http.Handler
+	mutex      sync.Mutex
+	hashes     map[string]string
+	encoded    map[string]encodedAsset
+}
+
+// encodedAsset is an asset compressed with a content encoding.
+type encodedAsset struct {
+	content []byte
+	modTime time.Time
+}
+
+// NewAssetServer returns an AssetServer for the assets in fs.
+func NewAssetServer(fs http.FileSystem) *AssetServer {
+	a := &AssetServer{
+		FS:      fs,
+		hashes:  make(map[string]string),
+		encoded: make(map[string]encodedAsset),
+	}
+	if fs != nil {
+		a.fileServer = http.FileServer(fs)
+	}
+	return a
+}
+
+const assetCacheControl = "public, max-age=31536000, immutable"
+
+func (a *AssetServer) hash(name string) (string, error) {
+	if a.FS == nil {
+		return "", fmt.Errorf("no assets")
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if hash, ok := a.hashes[name]; ok {
+		return hash, nil
+	}
+	f, err := a.FS.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", err
+	}
+	hash := fmt.Sprintf("%x", hasher.Sum(nil))[:16]
+	a.hashes[name] = hash
+	return hash, nil
+}
+
+// URL returns the fingerprinted URL of the named asset. If the asset
+// cannot be read the name is returned unchanged.
+func (a *AssetServer) URL(name string) string {
+	hash, err := a.hash(name)
+	if err != nil {
+		return name
+	}
+	return name + "?v=" + hash
+}
+
+func (a *AssetServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if a.fileServer == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if v := r.URL.Query().Get("v"); v != "" {
+		if hash, err := a.hash(r.URL.Path); err == nil && hash == v {
+			w.Header().Set("Cache-Control", assetCacheControl)
+		}
+	}
+	name := path.Clean("/" + r.URL.Path)
+	if contentType := assetContentType(name); compressible(contentType) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if encoding := acceptedEncoding(r); encoding != "" {
+			if asset, err := a.encode(name, encoding); err == nil {
+				w.Header().Set("Content-Type", contentType)
+				w.Header().Set("Content-Encoding", encoding)
+				http.ServeContent(w, r, name, asset.modTime, bytes.NewReader(asset.content))
+				return
+			}
+		}
+	}
+	a.fileServer.ServeHTTP(w, r)
+}
+
+// precompressedExt gives the extension of the precompressed variants of
+// assets for each encoding.
+var precompressedExt = map[string]string{
+	encodingBrotli: ".br",
+	encodingGzip:   ".gz",
+}
+
+// encode returns the named asset compressed with the encoding, read from
+// its precompressed variant if there is one and otherwise compressed once
+// and kept.
+func (a *AssetServer) encode(name, encoding string) (encodedAsset, error) {
+	key := encoding + ":" + name
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if asset, ok := a.encoded[key]; ok {
+		return asset, nil
+	}
+	f, err := a.FS.Open(name)
+	if err != nil {
+		return encodedAsset{}, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return encodedAsset{}, err
+	}
+	if fi.IsDir() {
+		return encodedAsset{}, fmt.Errorf("%s is a directory", name)
+	}
+	asset := encodedAsset{modTime: fi.ModTime()}
+	if pre, err := a.FS.Open(name + precompressedExt[encoding]); err == nil {
+		defer pre.Close()
+		asset.content, err = ioutil.ReadAll(pre)
+		if err != nil {
+			return encodedAsset{}, err
+		}
+	} else {
+		content, err := ioutil.ReadAll(f)
+		if err != nil {
+			return encodedAsset{}, err
+		}
+		asset.content, err = compress(encoding, content)
+		if err != nil {
+			return encodedAsset{}, err
+		}
+	}
+	a.encoded[key] = asset
+	return asset, nil
+}
+
+// checkAssets returns an error if the template refers to any asset that
+// the AssetServer is unable to open.
+func (a *AssetServer) checkAssets(tree *parse.Tree) error {
+	if a.FS == nil || tree == nil {
+		return nil
+	}
+	for _, name := range assetNames(tree.Root) {
+		f, err := a.FS.Open(name)
+		if err != nil {
+			return fmt.Errorf("missing asset %s: %v", name, err)
+		}
+		f.Close()
+	}
+	return nil
+}
+
+// assetNames returns the literal arguments of every call to the asset
+// template function beneath the node.
+func assetNames(node parse.Node) []string {
+	var names []string
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			names = append(names, assetNames(child)...)
+		}
+	case *parse.ActionNode:
+		names = assetNames(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			names = append(names, assetNames(cmd)...)
+		}
+	case *parse.CommandNode:
+		if len(n.Args) == 2 {
+			ident, isIdent := n.Args[0].(*parse.IdentifierNode)
+			str, isString := n.Args[1].(*parse.StringNode)
+			if isIdent && isString && ident.Ident == "asset" {
+				return []string{str.Text}
+			}
+		}
+		for _, arg := range n.Args {
+			names = append(names, assetNames(arg)...)
+		}
+	case *parse.IfNode:
+		names = branchAssetNames(&n.BranchNode)
+	case *parse.RangeNode:
+		names = branchAssetNames(&n.BranchNode)
+	case *parse.WithNode:
+		names = branchAssetNames(&n.BranchNode)
+	case *parse.TemplateNode:
+		names = assetNames(n.Pipe)
+	}
+	return names
+}
+
+func branchAssetNames(n *parse.BranchNode) []string {
+	names := assetNames(n.Pipe)
+	names = append(names, assetNames(n.List)...)
+	return append(names, assetNames(n.ElseList)...)
+}
@@ -0,0 +1,57 @@
+package galldir_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+	"github.com/jamesfcarter/galldir/data"
+)
+
+func TestDefaultThemeAssets(t *testing.T) {
+	// NewTheme checks that every asset used by the templates exists.
+	_, err := galldir.NewTheme("", data.Assets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = galldir.NewTheme("testdata/badtheme", data.Assets)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestAssetServer(t *testing.T) {
+	assets := galldir.NewAssetServer(http.Dir("testdata"))
+	url := assets.URL("/hello")
+	if !strings.HasPrefix(url, "/hello?v=") {
+		t.Fatalf("unexpected URL: %s", url)
+	}
+	if url := assets.URL("/not_there"); url != "/not_there" {
+		t.Errorf("unexpected URL: %s", url)
+	}
+	tests := []struct {
+		url          string
+		cacheControl string
+	}{
+		{url, "public, max-age=31536000, immutable"},
+		{"/hello?v=stale", ""},
+		{"/hello", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			assets.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if cc := w.Header().Get("Cache-Control"); cc != tc.cacheControl {
+				t.Errorf("unexpected Cache-Control: %s", cc)
+			}
+			if body := w.Body.String(); body != "hello" {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package galldir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The Cache-Control policies of each type of response. Photos rarely
+// change once added, album covers change as their albums do, and pages
+// are always revalidated, which their ETags make cheap.
+const (
+	cachePhoto = "public, max-age=86400"
+	cacheCover = "public, max-age=3600"
+	cachePage  = "no-cache"
+)
+
+func hashETag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// etag returns an ETag identifying a response by the things it is derived
+// from, such as the path, size and modification time of its source and
+// the size of any thumbnail.
+func etag(parts ...interface{}) string {
+	buf := bytes.NewBuffer(nil)
+	for _, part := range parts {
+		fmt.Fprintf(buf, "%v\x00", part)
+	}
+	return hashETag(buf.Bytes())
+}
+
+// photoETag returns the ETag of a rendition of a photo, where thumbSize is
+// 0 for the original.
+func photoETag(path string, size int64, modTime time.Time, thumbSize int) string {
+	return etag(path, size, modTime.UnixNano(), thumbSize)
+}
+
+// notModified returns true, having said so, if the client already holds
+// the response identified by tag. This saves preparing content that would
+// not be sent. Otherwise the caller sets the tag with cacheable once the
+// response is ready, so that an error in its place is never cached.
+func notModified(w http.ResponseWriter, r *http.Request, tag, cacheControl string) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	for _, candidate := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == tag || candidate == "*" {
+			cacheable(w, tag, cacheControl)
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// cacheable sets the ETag and Cache-Control of a response.
+func cacheable(w http.ResponseWriter, tag, cacheControl string) {
+	w.Header().Set("ETag", tag)
+	w.Header().Set("Cache-Control", cacheControl)
+}
+
+// serveBody serves a generated page, identified by an ETag of its content
+// so that an unchanged page need not be sent again. The page is compressed
+// if the client accepts it.
+func serveBody(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	encoding := ""
+	if len(body) >= minCompressSize && compressible(contentType) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding = acceptedEncoding(r)
+	}
+	tag := hashETag(body)
+	encodedTag := encodedETag(tag, encoding)
+	if notModified(w, r, encodedTag, cachePage) {
+		return
+	}
+	cacheable(w, encodedTag, cachePage)
+	if encoding != "" {
+		compressed, err := compress(encoding, body)
+		if err != nil {
+			requestLogger(r).Error("failed to compress response", "err", err)
+			w.Header().Set("ETag", tag)
+		} else {
+			w.Header().Set("Content-Encoding", encoding)
+			body = compressed
+		}
+	}
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(body))
+}
@@ -0,0 +1,114 @@
+package galldir_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestServeConditional(t *testing.T) {
+	tests := []struct {
+		url          string
+		cacheControl string
+	}{
+		{"/subalbum/", "no-cache"},
+		{"/subalbum/?format=json", "no-cache"},
+		{"/subalbum/feed.atom", "no-cache"},
+		{"/subalbum/icon.png?view=page", "no-cache"},
+		{"/subalbum/icon.png", "public, max-age=86400"},
+		{"/subalbum/icon.png?thumb=50", "public, max-age=86400"},
+		{"/subalbum?thumb=50", "public, max-age=3600"},
+	}
+	server := testServer(t)
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if cc := w.Header().Get("Cache-Control"); cc != tc.cacheControl {
+				t.Errorf("unexpected Cache-Control: %s", cc)
+			}
+			etag := w.Header().Get("ETag")
+			if etag == "" {
+				t.Fatal("no ETag")
+			}
+
+			again := httptest.NewRecorder()
+			server.ServeHTTP(again, httptest.NewRequest("GET", tc.url, nil))
+			if again.Header().Get("ETag") != etag {
+				t.Errorf("unstable ETag: %s then %s", etag, again.Header().Get("ETag"))
+			}
+
+			r := httptest.NewRequest("GET", tc.url, nil)
+			r.Header.Set("If-None-Match", `"other", `+etag)
+			w = httptest.NewRecorder()
+			server.ServeHTTP(w, r)
+			if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+				t.Errorf("unexpected response to If-None-Match: %d", w.Code)
+			}
+
+			r = httptest.NewRequest("GET", tc.url, nil)
+			r.Header.Set("If-None-Match", `"other"`)
+			w = httptest.NewRecorder()
+			server.ServeHTTP(w, r)
+			if w.Code != http.StatusOK {
+				t.Errorf("unexpected response to stale If-None-Match: %d", w.Code)
+			}
+		})
+	}
+}
+
+func TestServeIfModifiedSince(t *testing.T) {
+	for _, url := range []string{"/subalbum/icon.png", "/subalbum/icon.png?thumb=50", "/subalbum?thumb=50"} {
+		t.Run(url, func(t *testing.T) {
+			server := testServer(t)
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+			lastModified := w.Header().Get("Last-Modified")
+			if lastModified == "" {
+				t.Fatal("no Last-Modified")
+			}
+			r := httptest.NewRequest("GET", url, nil)
+			r.Header.Set("If-Modified-Since", lastModified)
+			w = httptest.NewRecorder()
+			server.ServeHTTP(w, r)
+			if w.Code != http.StatusNotModified {
+				t.Errorf("unexpected status: %d", w.Code)
+			}
+		})
+	}
+}
+
+func TestThumbETags(t *testing.T) {
+	server := testServer(t)
+	etags := make(map[string]string)
+	for _, url := range []string{"/subalbum/icon.png", "/subalbum/icon.png?thumb=50", "/subalbum/icon.png?thumb=100"} {
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+		etag := w.Header().Get("ETag")
+		if other, ok := etags[etag]; ok {
+			t.Errorf("%s and %s share ETag %s", url, other, etag)
+		}
+		etags[etag] = url
+	}
+}
+
+func TestServeErrorNotCached(t *testing.T) {
+	server := testServer(t)
+	server.Provider = galldir.NewProvider(http.Dir("testdata/broken"))
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/broken.jpg?thumb=250", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+	if etag := w.Header().Get("ETag"); etag != "" {
+		t.Errorf("error has ETag %s", etag)
+	}
+	if cc := w.Header().Get("Cache-Control"); cc != "" {
+		t.Errorf("error has Cache-Control %s", cc)
+	}
+}
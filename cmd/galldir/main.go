@@ -1,11 +1,19 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"flag"
+	"html/template"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/jamesfcarter/galldir"
 	"github.com/jamesfcarter/galldir/data"
@@ -36,23 +44,236 @@ func filesystem(dir string) http.FileSystem {
 
 func main() {
 	dir := flag.String("dir", "", "Directory to serve")
-	addr := flag.String("addr", "", "Address to serve")
+	addr := flag.String("addr", "", `Address to serve, or "unix:" followed by the path of a Unix socket`)
+	tlsCert := flag.String("tlscert", "", "File holding the TLS certificate, which is reloaded when it changes")
+	tlsKey := flag.String("tlskey", "", "File holding the TLS private key")
+	redirectAddr := flag.String("redirect", "", "Address on which to redirect HTTP requests to HTTPS")
+	maxConns := flag.Int("maxconns", 0, "Greatest number of connections open at once, or 0 for no limit")
+	readTimeout := flag.Duration("readtimeout", time.Minute, "Time allowed to read a request")
+	writeTimeout := flag.Duration("writetimeout", 0, "Time allowed to write a response, or 0 for no limit (ZIP downloads may take a long time)")
+	idleTimeout := flag.Duration("idletimeout", 2*time.Minute, "Time an idle connection is kept open")
+	metricsEnabled := flag.Bool("metrics", false, "Serve Prometheus metrics at /metrics")
+	pathPrefix := flag.String("prefix", "", "Path at which the gallery appears on the site, e.g. /photos")
+	forwardedPrefix := flag.Bool("forwardedprefix", false, "Take the path at which the gallery appears from the X-Forwarded-Prefix header set by a reverse proxy")
+	shutdownTimeout := flag.Duration("shutdowntimeout", 30*time.Second, "Time allowed for requests to finish when shutting down")
+	themeDir := flag.String("theme", "", "Directory of templates and assets overriding the defaults")
+	pageSize := flag.Int("pagesize", 100, "Number of photos and albums per page")
+	scroll := flag.Bool("scroll", false, "Load further pages as the user scrolls")
+	dateFallback := flag.String("datefallback", "", `Date undated albums by their "newest" or "oldest" photo rather than modification time`)
+	indexInterval := flag.Duration("index", 0, "Interval between crawls of the gallery to index it for search, e.g. 1h, or 0 to disable search")
+	tileURL := flag.String("tiles", "", "URL template of the map tiles, e.g. https://tile.example.com/{z}/{x}/{y}.png")
+	tileAttribution := flag.String("tileattribution", "", "HTML crediting the source of the map tiles")
+	baseURL := flag.String("baseurl", "", "Public URL of the gallery, e.g. https://photos.example.com, used in feeds and link previews")
+	recent := flag.Int("recent", 50, "Number of photos in the album of recent photos on the front page, or 0 to leave it out")
+	recentBy := flag.String("recentby", "added", `Whether the recent photos are those most recently "added" or "taken"`)
+	downloadLimit := flag.Int64("downloadlimit", 2048, "Largest size in MB of the photos that may be downloaded together as a ZIP, or 0 to disable downloads")
+	readyTimeout := flag.Duration("readytimeout", 5*time.Second, "Time allowed for /readyz to list the root of the gallery")
+	readyErrors := flag.Int("readyerrors", 10, "Number of files per minute that may fail to open before /readyz reports the gallery degraded")
+	logLevel := flag.String("loglevel", "info", `Least severe messages to log: "debug", "info", "warn" or "error"`)
+	logFormat := flag.String("logformat", "text", `Format of log messages: "text" or "json"`)
+	accessLog := flag.String("accesslog", "", `File to which to log requests, or "-" for standard output`)
+	accessLogFormat := flag.String("accesslogformat", galldir.AccessLogCommon, `Format of the access log: "common" or "combined"`)
+	adminUser := flag.String("adminuser", "admin", "User name with which to sign in to /admin")
+	adminPasswordFile := flag.String("adminpasswordfile", "", "File holding the password with which to sign in to /admin, which is disabled without one")
 	flag.Parse()
 
+	level, err := galldir.ParseLogLevel(*logLevel)
+	if err != nil {
+		log.Fatalf("unknown -loglevel %q", *logLevel)
+	}
+	options := &slog.HandlerOptions{Level: level}
+	var logHandler slog.Handler
+	switch *logFormat {
+	case "text":
+		logHandler = slog.NewTextHandler(os.Stderr, options)
+	case "json":
+		logHandler = slog.NewJSONHandler(os.Stderr, options)
+	default:
+		log.Fatalf("unknown -logformat %q", *logFormat)
+	}
+	logger := slog.New(logHandler)
+	slog.SetDefault(logger)
+	if *accessLogFormat != galldir.AccessLogCommon && *accessLogFormat != galldir.AccessLogCombined {
+		fatal("unknown -accesslogformat", "format", *accessLogFormat)
+	}
+
+	if *recentBy != "added" && *recentBy != "taken" {
+		fatal("unknown -recentby", "recentby", *recentBy)
+	}
+	if (*tlsCert == "") != (*tlsKey == "") {
+		fatal("-tlscert and -tlskey must be given together")
+	}
+	if *redirectAddr != "" && *tlsCert == "" {
+		fatal("-redirect needs -tlscert and -tlskey")
+	}
+
+	theme, err := galldir.NewTheme(*themeDir, data.Assets)
+	if err != nil {
+		fatal("failed to load theme", "err", err)
+	}
 	provider := galldir.NewProvider(filesystem(*dir))
+	provider.DateFallback = galldir.DateFallback(*dateFallback)
+	provider.Logger = logger
+	var metrics *galldir.Metrics
+	if *metricsEnabled {
+		metrics = galldir.NewMetrics(provider)
+	}
+	health := galldir.NewHealth(provider)
+	health.Timeout = *readyTimeout
+	health.ErrorThreshold = *readyErrors
 	server := &galldir.Server{
-		Provider: provider,
-		Assets:   data.Assets,
+		Provider:             provider,
+		Assets:               theme.Assets.FS,
+		Theme:                theme,
+		PageSize:             *pageSize,
+		InfiniteScroll:       *scroll,
+		TileURL:              *tileURL,
+		TileAttribution:      template.HTML(*tileAttribution),
+		BaseURL:              *baseURL,
+		RecentPhotos:         *recent,
+		RecentByTaken:        *recentBy == "taken",
+		DownloadLimit:        *downloadLimit << 20,
+		PathPrefix:           galldir.CleanPathPrefix(*pathPrefix),
+		TrustForwardedPrefix: *forwardedPrefix,
+		Metrics:              metrics,
+		Logger:               logger,
+	}
+	if *indexInterval > 0 {
+		server.Index = galldir.NewIndex(provider)
+		go server.Index.Run(*indexInterval)
+	}
+	if *adminPasswordFile != "" {
+		password, err := os.ReadFile(*adminPasswordFile)
+		if err != nil {
+			fatal("failed to read admin password", "err", err)
+		}
+		server.Admin = &galldir.Admin{
+			Username: *adminUser,
+			Password: strings.TrimSpace(string(password)),
+			Config:   make(map[string]string),
+		}
+		if server.Admin.Password == "" {
+			fatal("admin password is empty", "file", *adminPasswordFile)
+		}
 	}
 
-	assets := http.FileServer(data.Assets)
-
+	mux := http.NewServeMux()
 	for _, dir := range []string{
 		"/favicon.ico", "/img/", "/js/", "/css/", "/fonts/",
 	} {
-		http.Handle(dir, assets)
+		mux.Handle(dir, metrics.Instrument("asset", theme.Assets))
+	}
+	if metrics != nil {
+		mux.Handle("/metrics", metrics)
+	}
+	mux.Handle("/", server)
+
+	// probes reach the health checks wherever the gallery is mounted
+	probes := http.NewServeMux()
+	probes.HandleFunc("/healthz", galldir.Alive)
+	probes.Handle("/readyz", health)
+	probes.Handle("/", mount(server.PathPrefix, mux))
+	var handler http.Handler = probes
+	if *accessLog != "" {
+		out := os.Stdout
+		if *accessLog != "-" {
+			out, err = os.OpenFile(*accessLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+			if err != nil {
+				fatal("failed to open access log", "err", err)
+			}
+		}
+		handler = galldir.AccessLog(out, *accessLogFormat, handler)
 	}
-	http.Handle("/", server)
 
-	log.Fatal(http.ListenAndServe(*addr, nil))
+	httpServer := &http.Server{
+		Handler:           handler,
+		ErrorLog:          slog.NewLogLogger(logHandler, slog.LevelWarn),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       *readTimeout,
+		WriteTimeout:      *writeTimeout,
+		IdleTimeout:       *idleTimeout,
+	}
+	if *tlsCert != "" {
+		certs, err := galldir.NewCertReloader(*tlsCert, *tlsKey)
+		if err != nil {
+			fatal("failed to load certificate", "err", err)
+		}
+		httpServer.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certs.GetCertificate,
+			NextProtos:     []string{"h2", "http/1.1"},
+		}
+	}
+	if *addr == "" {
+		*addr = ":http"
+		if *tlsCert != "" {
+			*addr = ":https"
+		}
+	}
+	if server.Admin != nil {
+		flag.VisitAll(func(f *flag.Flag) {
+			server.Admin.Config[f.Name] = f.Value.String()
+		})
+	}
+	listener, err := galldir.Listen(*addr, *maxConns)
+	if err != nil {
+		fatal("failed to listen", "addr", *addr, "err", err)
+	}
+	slog.Info("serving", "addr", *addr)
+	servers := []*http.Server{httpServer}
+	go serve(httpServer, func() error {
+		if httpServer.TLSConfig != nil {
+			return httpServer.ServeTLS(listener, "", "")
+		}
+		return httpServer.Serve(listener)
+	})
+	if *redirectAddr != "" {
+		redirect := &http.Server{
+			Addr:              *redirectAddr,
+			Handler:           galldir.RedirectToHTTPS(*addr),
+			ErrorLog:          httpServer.ErrorLog,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       *readTimeout,
+			WriteTimeout:      *readTimeout,
+			IdleTimeout:       *idleTimeout,
+		}
+		servers = append(servers, redirect)
+		go serve(redirect, redirect.ListenAndServe)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+	slog.Info("shutting down")
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	for _, s := range servers {
+		if err := s.Shutdown(ctx); err != nil {
+			slog.Error("failed to shut down cleanly", "err", err)
+		}
+	}
+}
+
+// mount returns a handler that serves h beneath the path prefix.
+func mount(prefix string, h http.Handler) http.Handler {
+	if prefix == "" {
+		return h
+	}
+	mux := http.NewServeMux()
+	// the mux redirects requests for the prefix itself to prefix/
+	mux.Handle(prefix+"/", http.StripPrefix(prefix, h))
+	return mux
+}
+
+// serve runs a server until it is shut down, exiting if it fails.
+func serve(s *http.Server, run func() error) {
+	if err := run(); err != http.ErrServerClosed {
+		fatal("failed to serve", "err", err)
+	}
+}
+
+// fatal logs an error and exits.
+func fatal(msg string, args ...interface{}) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
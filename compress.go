@@ -0,0 +1,107 @@
+package galldir
+
+import (
+	"bytes"
+	"compress/gzip"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// The content encodings that responses may be compressed with, in order of
+// preference.
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+var encodings = []string{encodingBrotli, encodingGzip}
+
+// minCompressSize is the size below which compressing a response isn't
+// worth the effort.
+const minCompressSize = 512
+
+// acceptedEncoding returns the encoding, out of those galldir can produce,
+// that the client most prefers, or "" if it accepts none of them.
+func acceptedEncoding(r *http.Request) string {
+	best, bestQ := "", 0.0
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if value, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = value
+				}
+			}
+		}
+		qualities[name] = q
+	}
+	for _, encoding := range encodings {
+		q, ok := qualities[encoding]
+		if !ok {
+			q, ok = qualities["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = encoding, q
+		}
+	}
+	return best
+}
+
+// compressible returns true if content of the type is worth compressing.
+// Photos are not, being compressed already.
+func compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "json") ||
+		strings.HasSuffix(mediaType, "xml") ||
+		mediaType == "application/javascript" ||
+		mediaType == "image/svg+xml" ||
+		mediaType == "image/x-icon" ||
+		mediaType == "image/vnd.microsoft.icon"
+}
+
+// compress returns the data compressed with the encoding.
+func compress(encoding string, data []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	var err error
+	switch encoding {
+	case encodingBrotli:
+		bw := brotli.NewWriterLevel(buf, brotli.DefaultCompression)
+		if _, err = bw.Write(data); err == nil {
+			err = bw.Close()
+		}
+	case encodingGzip:
+		gw := gzip.NewWriter(buf)
+		if _, err = gw.Write(data); err == nil {
+			err = gw.Close()
+		}
+	}
+	return buf.Bytes(), err
+}
+
+// encodedETag returns the ETag of an encoding of the content with the
+// given ETag, which must differ from that of the content itself.
+func encodedETag(tag, encoding string) string {
+	if encoding == "" {
+		return tag
+	}
+	return strings.TrimSuffix(tag, `"`) + "-" + encoding + `"`
+}
+
+// assetContentType returns the type of the named asset, going by its
+// extension.
+func assetContentType(name string) string {
+	return mime.TypeByExtension(path.Ext(name))
+}
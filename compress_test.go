@@ -0,0 +1,128 @@
+package galldir_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/andybalholm/brotli"
+	"github.com/jamesfcarter/galldir"
+)
+
+func decode(t *testing.T, encoding string, body []byte) string {
+	t.Helper()
+	var content []byte
+	var err error
+	switch encoding {
+	case "br":
+		content, err = ioutil.ReadAll(brotli.NewReader(bytes.NewReader(body)))
+	case "gzip":
+		var gr *gzip.Reader
+		if gr, err = gzip.NewReader(bytes.NewReader(body)); err == nil {
+			content, err = ioutil.ReadAll(gr)
+		}
+	default:
+		content = body
+	}
+	if err != nil {
+		t.Fatalf("failed to decode %s: %v", encoding, err)
+	}
+	return string(content)
+}
+
+func TestServeCompressed(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		encoding       string
+	}{
+		{"", ""},
+		{"gzip", "gzip"},
+		{"gzip, deflate, br", "br"},
+		{"br;q=0.5, gzip", "gzip"},
+		{"br;q=0, *", "gzip"},
+		{"identity", ""},
+	}
+	server := testServer(t)
+	for _, url := range []string{"/subalbum/", "/subalbum/feed.atom"} {
+		plain := httptest.NewRecorder()
+		server.ServeHTTP(plain, httptest.NewRequest("GET", url, nil))
+		for _, tc := range tests {
+			t.Run(url+" "+tc.acceptEncoding, func(t *testing.T) {
+				r := httptest.NewRequest("GET", url, nil)
+				r.Header.Set("Accept-Encoding", tc.acceptEncoding)
+				w := httptest.NewRecorder()
+				server.ServeHTTP(w, r)
+				if w.Code != http.StatusOK {
+					t.Fatalf("unexpected status: %d", w.Code)
+				}
+				if ce := w.Header().Get("Content-Encoding"); ce != tc.encoding {
+					t.Errorf("unexpected Content-Encoding: %q", ce)
+				}
+				if vary := w.Header().Get("Vary"); vary != "Accept-Encoding" {
+					t.Errorf("unexpected Vary: %q", vary)
+				}
+				etag := w.Header().Get("ETag")
+				if (etag == plain.Header().Get("ETag")) != (tc.encoding == "") {
+					t.Errorf("unexpected ETag: %s", etag)
+				}
+				if body := decode(t, tc.encoding, w.Body.Bytes()); body != plain.Body.String() {
+					t.Errorf("unexpected body: %s", body)
+				}
+			})
+		}
+	}
+}
+
+func TestAssetServerCompressed(t *testing.T) {
+	style := strings.Repeat("body { margin: 0; }\n", 100)
+	precompressed := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(precompressed)
+	gw.Write([]byte("precompressed"))
+	gw.Close()
+	assets := galldir.NewAssetServer(http.FS(fstest.MapFS{
+		"style.css":     {Data: []byte(style)},
+		"script.js":     {Data: []byte("plain")},
+		"script.js.gz":  {Data: precompressed.Bytes()},
+		"image.png":     {Data: []byte(style)},
+		"css/empty.css": {Data: nil},
+	}))
+	tests := []struct {
+		url            string
+		acceptEncoding string
+		encoding       string
+		contentType    string
+		body           string
+	}{
+		{"/style.css", "", "", "text/css; charset=utf-8", style},
+		{"/style.css", "gzip", "gzip", "text/css; charset=utf-8", style},
+		{"/style.css", "br, gzip", "br", "text/css; charset=utf-8", style},
+		{"/script.js", "gzip", "gzip", "", "precompressed"},
+		{"/script.js", "", "", "", "plain"},
+		{"/image.png", "gzip", "", "image/png", style},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url+" "+tc.acceptEncoding, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.url, nil)
+			r.Header.Set("Accept-Encoding", tc.acceptEncoding)
+			w := httptest.NewRecorder()
+			assets.ServeHTTP(w, r)
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if ce := w.Header().Get("Content-Encoding"); ce != tc.encoding {
+				t.Errorf("unexpected Content-Encoding: %q", ce)
+			}
+			if ct := w.Header().Get("Content-Type"); tc.contentType != "" && ct != tc.contentType {
+				t.Errorf("unexpected Content-Type: %q", ct)
+			}
+			if body := decode(t, tc.encoding, w.Body.Bytes()); body != tc.body {
+				t.Errorf("unexpected body: %.40s", body)
+			}
+		})
+	}
+}
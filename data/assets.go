@@ -1,6 +1,23 @@
+// Package data holds the static assets served alongside the gallery.
 package data
 
-// This file just exists to trigger the building of the assets in
-// assets_vfsdata.go via go generate.
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
 
-//go:generate go run assets_generate.go
+//go:generate go run vendor.go
+
+//go:embed assets
+var assets embed.FS
+
+// Assets holds the style sheets, scripts, fonts and images used by the
+// gallery's templates.
+var Assets = func() http.FileSystem {
+	sub, err := fs.Sub(assets, "assets")
+	if err != nil {
+		panic(err)
+	}
+	return http.FS(sub)
+}()
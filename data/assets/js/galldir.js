@@ -0,0 +1,198 @@
+/**!
+ * galldir.js
+ * Starts lightGallery on an album page and, in infinite scroll mode, loads
+ * further pages of the album as the end of the page comes into view.
+ * @license GPLv3
+ */
+(function () {
+    'use strict';
+
+    var thumbSize = 250;
+    // the path the gallery is mounted at, which paths in albums are beneath
+    var base = '';
+
+    function escapePath(path) {
+        return base + path.split('/').map(encodeURIComponent).join('/');
+    }
+
+    function thumbImage(path, size) {
+        var img = document.createElement('img');
+        var url = escapePath(path) + '?thumb=';
+        img.setAttribute('loading', 'lazy');
+        img.setAttribute('src', url + size);
+        img.setAttribute('srcset', url + size + ' 1x, ' + url + (2 * size) + ' 2x');
+        return img;
+    }
+
+    var months = ['January', 'February', 'March', 'April', 'May', 'June', 'July',
+        'August', 'September', 'October', 'November', 'December'];
+
+    // parseDate returns the day, month and year of a time from the JSON of
+    // an album, in the time zone it was given in, or null for the zero time.
+    function parseDate(s) {
+        var m = /^(\d+)-(\d+)-(\d+)T/.exec(s || '');
+        if (!m || s.indexOf('0001-01-01T00:00:00Z') === 0) {
+            return null;
+        }
+        return { year: +m[1], month: months[m[2] - 1], day: +m[3] };
+    }
+
+    function formatDate(d) {
+        return d ? d.day + ' ' + d.month + ' ' + d.year : '';
+    }
+
+    // dateRange formats the dates from start to end as the dateRange
+    // template function does.
+    function dateRange(start, end) {
+        var s = parseDate(start), e = parseDate(end);
+        if (!s || !e) {
+            return formatDate(s);
+        }
+        if (s.year !== e.year) {
+            return formatDate(s) + ' \u2013 ' + formatDate(e);
+        }
+        if (s.month !== e.month) {
+            return s.day + ' ' + s.month + ' \u2013 ' + formatDate(e);
+        }
+        if (s.day !== e.day) {
+            return s.day + '\u2013' + formatDate(e);
+        }
+        return formatDate(s);
+    }
+
+    function escapeHTML(s) {
+        return s.replace(/&/g, '&amp;').replace(/</g, '&lt;').replace(/>/g, '&gt;')
+            .replace(/"/g, '&#34;').replace(/'/g, '&#39;');
+    }
+
+    // subHTML returns the HTML that lightgallery shows beneath a photo, as
+    // the subHTML template function does.
+    function subHTML(caption, url) {
+        var link = '<a class="galldir-permalink" href="' + escapeHTML(url) + '?view=page">Photo page</a>';
+        if (!caption) {
+            return '<p>' + link + '</p>';
+        }
+        return '<p>' + escapeHTML(caption) + '</p><p>' + link + '</p>';
+    }
+
+    function albumFigure(album) {
+        var figure = document.createElement('figure');
+        var p = document.createElement('p');
+        var a = document.createElement('a');
+        var caption = document.createElement('figcaption');
+        var lines = [album.name];
+        if (parseDate(album.endTime)) {
+            lines.push(dateRange(album.time, album.endTime));
+        }
+        if (album.count) {
+            lines.push(album.count + ' photo' + (album.count === 1 ? '' : 's'));
+        }
+        lines.forEach(function (line, i) {
+            if (i) {
+                caption.appendChild(document.createElement('br'));
+            }
+            caption.appendChild(document.createTextNode(line));
+        });
+        a.setAttribute('href', escapePath(album.path));
+        a.appendChild(thumbImage(album.path, thumbSize));
+        a.appendChild(caption);
+        p.appendChild(a);
+        figure.appendChild(p);
+        return figure;
+    }
+
+    function photoLink(photo) {
+        var a = document.createElement('a');
+        var img = thumbImage(photo.path, thumbSize);
+        a.setAttribute('href', escapePath(photo.path));
+        a.setAttribute('data-sub-html', subHTML(photo.description || '', escapePath(photo.path)));
+        img.setAttribute('alt', photo.description || '');
+        a.appendChild(img);
+        return a;
+    }
+
+    function startGallery(el, options) {
+        var uid = el.getAttribute('lg-uid');
+        if (uid && window.lgData[uid]) {
+            window.lgData[uid].destroy(true);
+        }
+        lightGallery(el, options);
+    }
+
+    function infiniteScroll(gallery, options) {
+        var albums = document.querySelector('.galldir-albums');
+        var pages = document.querySelector('.galldir-pages');
+        var loading = false;
+
+        if (!pages || !window.IntersectionObserver || !window.fetch) {
+            return;
+        }
+
+        function nextPage() {
+            var next = pages.querySelector('a[rel=next]');
+            return next ? next.getAttribute('href') : null;
+        }
+
+        function load() {
+            var next = nextPage();
+            if (loading || !next) {
+                return;
+            }
+            loading = true;
+            fetch(next + '&format=json', { credentials: 'same-origin' })
+                .then(function (response) {
+                    if (!response.ok) {
+                        throw new Error(response.statusText);
+                    }
+                    return response.json();
+                })
+                .then(function (page) {
+                    page.albums.forEach(function (album) {
+                        albums.appendChild(albumFigure(album));
+                    });
+                    page.photos.forEach(function (photo) {
+                        gallery.appendChild(photoLink(photo));
+                    });
+                    if (page.photos.length) {
+                        startGallery(gallery, options);
+                    }
+                    var link = pages.querySelector('a[rel=next]');
+                    loading = false;
+                    if (page.page < page.pages) {
+                        var url = new URL(link.href);
+                        url.searchParams.set('page', page.page + 1);
+                        link.setAttribute('href', url.search);
+                        // observing afresh reports whether the end of the
+                        // page is still in view
+                        observer.unobserve(pages);
+                        observer.observe(pages);
+                    } else {
+                        link.parentNode.removeChild(link);
+                        observer.disconnect();
+                    }
+                })
+                .catch(function () {
+                    loading = false;
+                });
+        }
+
+        pages.style.visibility = 'hidden';
+        var observer = new IntersectionObserver(function (entries) {
+            if (entries[0].isIntersecting) {
+                load();
+            }
+        }, { rootMargin: '500px' });
+        observer.observe(pages);
+    }
+
+    window.galldir = {
+        init: function (options, scroll, basePath) {
+            base = basePath || '';
+            var gallery = document.getElementById('lightgallery');
+            startGallery(gallery, options);
+            if (scroll) {
+                infiniteScroll(gallery, options);
+            }
+        }
+    };
+})();
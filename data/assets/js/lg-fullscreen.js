@@ -0,0 +1,89 @@
+/**!
+ * lg-fullscreen.js | galldir
+ * Fullscreen module for lightgallery.js 1.x.
+ * @license GPLv3
+ */
+(function () {
+    'use strict';
+
+    var defaults = {
+        fullScreen: true
+    };
+
+    function fullscreenElement() {
+        return document.fullscreenElement || document.webkitFullscreenElement ||
+            document.mozFullScreenElement || document.msFullscreenElement;
+    }
+
+    function fullscreenSupported() {
+        return document.fullscreenEnabled || document.webkitFullscreenEnabled ||
+            document.mozFullScreenEnabled || document.msFullscreenEnabled;
+    }
+
+    var Fullscreen = function Fullscreen(element) {
+        this.el = element;
+        this.core = window.lgData[this.el.getAttribute('lg-uid')];
+        this.core.s = Object.assign({}, defaults, this.core.s);
+
+        if (this.core.s.fullScreen && fullscreenSupported()) {
+            this.init();
+        }
+        return this;
+    };
+
+    Fullscreen.prototype.init = function () {
+        var _this = this;
+        _this.core.outer.querySelector('.lg-toolbar').insertAdjacentHTML('beforeend',
+            '<span class="lg-fullscreen lg-icon"></span>');
+
+        utils.on(document, 'fullscreenchange.lgfullscreen webkitfullscreenchange.lgfullscreen mozfullscreenchange.lgfullscreen MSFullscreenChange.lgfullscreen', function () {
+            if (fullscreenElement()) {
+                utils.addClass(_this.core.outer, 'lg-fullscreen-on');
+            } else {
+                utils.removeClass(_this.core.outer, 'lg-fullscreen-on');
+            }
+        });
+
+        utils.on(_this.core.outer.querySelector('.lg-fullscreen'), 'click.lg', function () {
+            if (fullscreenElement()) {
+                _this.exitFullscreen();
+            } else {
+                _this.requestFullscreen();
+            }
+        });
+    };
+
+    Fullscreen.prototype.requestFullscreen = function () {
+        var el = document.documentElement;
+        if (el.requestFullscreen) {
+            el.requestFullscreen();
+        } else if (el.msRequestFullscreen) {
+            el.msRequestFullscreen();
+        } else if (el.mozRequestFullScreen) {
+            el.mozRequestFullScreen();
+        } else if (el.webkitRequestFullscreen) {
+            el.webkitRequestFullscreen();
+        }
+    };
+
+    Fullscreen.prototype.exitFullscreen = function () {
+        if (document.exitFullscreen) {
+            document.exitFullscreen();
+        } else if (document.msExitFullscreen) {
+            document.msExitFullscreen();
+        } else if (document.mozCancelFullScreen) {
+            document.mozCancelFullScreen();
+        } else if (document.webkitExitFullscreen) {
+            document.webkitExitFullscreen();
+        }
+    };
+
+    Fullscreen.prototype.destroy = function () {
+        if (fullscreenElement()) {
+            this.exitFullscreen();
+        }
+        utils.off(document, '.lgfullscreen');
+    };
+
+    window.lgModules.fullscreen = Fullscreen;
+})();
@@ -0,0 +1,6 @@
+/**!
+ * lg-fullscreen.js | galldir
+ * Fullscreen module for lightgallery.js 1.x.
+ * @license GPLv3
+ */
+(function(){"use strict";var e,n={fullScreen:!0};function t(){return document.fullscreenElement||document.webkitFullscreenElement||document.mozFullScreenElement||document.msFullscreenElement}function s(){return document.fullscreenEnabled||document.webkitFullscreenEnabled||document.mozFullScreenEnabled||document.msFullscreenEnabled}e=function(t){return this.el=t,this.core=window.lgData[this.el.getAttribute("lg-uid")],this.core.s=Object.assign({},n,this.core.s),this.core.s.fullScreen&&s()&&this.init(),this},e.prototype.init=function(){var e=this;e.core.outer.querySelector(".lg-toolbar").insertAdjacentHTML("beforeend",'<span class="lg-fullscreen lg-icon"></span>'),utils.on(document,"fullscreenchange.lgfullscreen webkitfullscreenchange.lgfullscreen mozfullscreenchange.lgfullscreen MSFullscreenChange.lgfullscreen",function(){t()?utils.addClass(e.core.outer,"lg-fullscreen-on"):utils.removeClass(e.core.outer,"lg-fullscreen-on")}),utils.on(e.core.outer.querySelector(".lg-fullscreen"),"click.lg",function(){t()?e.exitFullscreen():e.requestFullscreen()})},e.prototype.requestFullscreen=function(){var e=document.documentElement;e.requestFullscreen?e.requestFullscreen():e.msRequestFullscreen?e.msRequestFullscreen():e.mozRequestFullScreen?e.mozRequestFullScreen():e.webkitRequestFullscreen&&e.webkitRequestFullscreen()},e.prototype.exitFullscreen=function(){document.exitFullscreen?document.exitFullscreen():document.msExitFullscreen?document.msExitFullscreen():document.mozCancelFullScreen?document.mozCancelFullScreen():document.webkitExitFullscreen&&document.webkitExitFullscreen()},e.prototype.destroy=function(){t()&&this.exitFullscreen(),utils.off(document,".lgfullscreen")},window.lgModules.fullscreen=e})()
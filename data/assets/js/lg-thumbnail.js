@@ -0,0 +1,200 @@
+/**!
+ * lg-thumbnail.js | galldir
+ * Thumbnail strip module for lightgallery.js 1.x.
+ * @license GPLv3
+ */
+(function () {
+    'use strict';
+
+    var defaults = {
+        thumbnail: true,
+        animateThumb: true,
+        currentPagerPosition: 'middle',
+        thumbWidth: 100,
+        thumbContHeight: 100,
+        thumbMargin: 5,
+        exThumbImage: false,
+        showThumbByDefault: true,
+        toggleThumb: true,
+        pullCaptionUp: true
+    };
+
+    var Thumbnail = function Thumbnail(element) {
+        this.el = element;
+        this.core = window.lgData[this.el.getAttribute('lg-uid')];
+        this.core.s = Object.assign({}, defaults, this.core.s);
+
+        this.thumbOuter = null;
+        this.thumbOuterWidth = 0;
+        this.thumbTotalWidth = this.core.items.length * (this.core.s.thumbWidth + this.core.s.thumbMargin);
+        this.thumbIndex = this.core.index;
+
+        if (this.core.s.thumbnail && this.core.items.length > 1) {
+            this.init();
+        }
+        return this;
+    };
+
+    Thumbnail.prototype.init = function () {
+        var _this = this;
+
+        if (_this.core.s.showThumbByDefault) {
+            setTimeout(function () {
+                utils.addClass(_this.core.outer, 'lg-thumb-open');
+            }, 700);
+        }
+        if (_this.core.s.pullCaptionUp) {
+            utils.addClass(_this.core.outer, 'lg-pull-caption-up');
+        }
+
+        _this.build();
+        if (_this.core.s.toggleThumb) {
+            _this.toggle();
+        }
+        _this.thumbKeyPress();
+    };
+
+    Thumbnail.prototype.thumbSrc = function (item) {
+        if (this.core.s.dynamic) {
+            return item.thumb;
+        }
+        if (this.core.s.exThumbImage) {
+            return item.getAttribute(this.core.s.exThumbImage);
+        }
+        var img = item.querySelector('img');
+        return img ? (img.currentSrc || img.getAttribute('src')) : item.getAttribute('href');
+    };
+
+    Thumbnail.prototype.build = function () {
+        var _this = this;
+        var thumbList = '';
+
+        for (var i = 0; i < _this.core.items.length; i++) {
+            thumbList += '<div data-lg-item-id="' + i + '" class="lg-thumb-item' +
+                (i === _this.core.index ? ' active' : '') +
+                '" style="width:' + _this.core.s.thumbWidth + 'px; margin-right: ' + _this.core.s.thumbMargin + 'px">' +
+                '<img src="' + _this.thumbSrc(_this.core.items[i]) + '" /></div>';
+        }
+
+        var html = '<div class="lg-thumb-outer">' +
+            '<div class="lg-thumb lg-group">' + thumbList + '</div>' +
+            '</div>';
+
+        utils.addClass(_this.core.outer, 'lg-has-thumb');
+        _this.core.outer.querySelector('.lg').insertAdjacentHTML('beforeend', html);
+
+        _this.thumbOuter = _this.core.outer.querySelector('.lg-thumb-outer');
+        _this.thumbOuterWidth = _this.thumbOuter.offsetWidth;
+        _this.thumbOuter.style.height = _this.core.s.thumbContHeight + 'px';
+
+        var thumb = _this.core.outer.querySelector('.lg-thumb');
+        if (_this.core.s.animateThumb) {
+            thumb.style.width = _this.thumbTotalWidth + 'px';
+            thumb.style.position = 'relative';
+        }
+
+        var items = _this.core.outer.querySelectorAll('.lg-thumb-item');
+        for (var n = 0; n < items.length; n++) {
+            (function (index) {
+                utils.on(items[index], 'click.lg', function () {
+                    setTimeout(function () {
+                        _this.core.index = index;
+                        _this.core.slide(index, false, true);
+                    }, 50);
+                });
+            })(n);
+        }
+
+        utils.on(_this.core.el, 'onBeforeSlide.lgtm', function (event) {
+            for (var j = 0; j < items.length; j++) {
+                utils.removeClass(items[j], 'active');
+            }
+            utils.addClass(items[event.detail.index], 'active');
+            _this.thumbIndex = event.detail.index;
+            if (_this.core.s.animateThumb) {
+                _this.animateThumb(event.detail.index);
+            }
+        });
+
+        utils.on(window, 'resize.lgthumb orientationchange.lgthumb', function () {
+            setTimeout(function () {
+                _this.thumbOuterWidth = _this.thumbOuter.offsetWidth;
+                if (_this.core.s.animateThumb) {
+                    _this.animateThumb(_this.thumbIndex);
+                }
+            }, 200);
+        });
+
+        if (_this.core.s.animateThumb) {
+            _this.animateThumb(_this.core.index);
+        }
+    };
+
+    Thumbnail.prototype.animateThumb = function (index) {
+        var thumb = this.core.outer.querySelector('.lg-thumb');
+        var step = this.core.s.thumbWidth + this.core.s.thumbMargin;
+        var position = 0;
+
+        switch (this.core.s.currentPagerPosition) {
+            case 'left':
+                position = 0;
+                break;
+            case 'middle':
+                position = (this.thumbOuterWidth / 2) - (this.core.s.thumbWidth / 2);
+                break;
+            case 'right':
+                position = this.thumbOuterWidth - this.core.s.thumbWidth;
+        }
+
+        var left = (step * index) - 1 - position;
+        if (left > this.thumbTotalWidth - this.thumbOuterWidth) {
+            left = this.thumbTotalWidth - this.thumbOuterWidth;
+        }
+        if (left < 0) {
+            left = 0;
+        }
+
+        if (this.core.lGalleryOn) {
+            utils.setVendor(thumb, 'TransitionDuration', this.core.s.speed + 'ms');
+        }
+        utils.setVendor(thumb, 'Transform', 'translate3d(-' + left + 'px, 0px, 0px)');
+    };
+
+    Thumbnail.prototype.toggle = function () {
+        var _this = this;
+        utils.addClass(_this.core.outer, 'lg-can-toggle');
+        _this.thumbOuter.insertAdjacentHTML('beforeend', '<span class="lg-toggle-thumb lg-icon"></span>');
+        utils.on(_this.core.outer.querySelector('.lg-toggle-thumb'), 'click.lg', function () {
+            if (utils.hasClass(_this.core.outer, 'lg-thumb-open')) {
+                utils.removeClass(_this.core.outer, 'lg-thumb-open');
+            } else {
+                utils.addClass(_this.core.outer, 'lg-thumb-open');
+            }
+        });
+    };
+
+    Thumbnail.prototype.thumbKeyPress = function () {
+        var _this = this;
+        utils.on(window, 'keydown.lgthumb', function (e) {
+            if (e.keyCode === 38) {
+                e.preventDefault();
+                utils.addClass(_this.core.outer, 'lg-thumb-open');
+            } else if (e.keyCode === 40) {
+                e.preventDefault();
+                utils.removeClass(_this.core.outer, 'lg-thumb-open');
+            }
+        });
+    };
+
+    Thumbnail.prototype.destroy = function () {
+        if (this.core.s.thumbnail && this.core.items.length > 1) {
+            utils.off(window, '.lgthumb');
+            if (this.thumbOuter && this.thumbOuter.parentNode) {
+                this.thumbOuter.parentNode.removeChild(this.thumbOuter);
+            }
+            utils.removeClass(this.core.outer, 'lg-has-thumb');
+        }
+    };
+
+    window.lgModules.thumbnail = Thumbnail;
+})();
@@ -0,0 +1,6 @@
+/**!
+ * lg-thumbnail.js | galldir
+ * Thumbnail strip module for lightgallery.js 1.x.
+ * @license GPLv3
+ */
+(function(){"use strict";var t={thumbnail:!0,animateThumb:!0,currentPagerPosition:"middle",thumbWidth:100,thumbContHeight:100,thumbMargin:5,exThumbImage:!1,showThumbByDefault:!0,toggleThumb:!0,pullCaptionUp:!0},e=function(n){return this.el=n,this.core=window.lgData[this.el.getAttribute("lg-uid")],this.core.s=Object.assign({},t,this.core.s),this.thumbOuter=null,this.thumbOuterWidth=0,this.thumbTotalWidth=this.core.items.length*(this.core.s.thumbWidth+this.core.s.thumbMargin),this.thumbIndex=this.core.index,this.core.s.thumbnail&&this.core.items.length>1&&this.init(),this};e.prototype.init=function(){var e=this;e.core.s.showThumbByDefault&&setTimeout(function(){utils.addClass(e.core.outer,"lg-thumb-open")},700),e.core.s.pullCaptionUp&&utils.addClass(e.core.outer,"lg-pull-caption-up"),e.build(),e.core.s.toggleThumb&&e.toggle(),e.thumbKeyPress()},e.prototype.thumbSrc=function(e){if(this.core.s.dynamic)return e.thumb;if(this.core.s.exThumbImage)return e.getAttribute(this.core.s.exThumbImage);var t=e.querySelector("img");return t?t.currentSrc||t.getAttribute("src"):e.getAttribute("href")},e.prototype.build=function(){for(var t,s,o,a,e=this,i="",n=0;n<e.core.items.length;n++)i+='<div data-lg-item-id="'+n+'" class="lg-thumb-item'+(n===e.core.index?" active":"")+'" style="width:'+e.core.s.thumbWidth+"px; margin-right: "+e.core.s.thumbMargin+'px"><img src="'+e.thumbSrc(e.core.items[n])+'" /></div>';a='<div class="lg-thumb-outer"><div class="lg-thumb lg-group">'+i+"</div></div>",utils.addClass(e.core.outer,"lg-has-thumb"),e.core.outer.querySelector(".lg").insertAdjacentHTML("beforeend",a),e.thumbOuter=e.core.outer.querySelector(".lg-thumb-outer"),e.thumbOuterWidth=e.thumbOuter.offsetWidth,e.thumbOuter.style.height=e.core.s.thumbContHeight+"px",o=e.core.outer.querySelector(".lg-thumb"),e.core.s.animateThumb&&(o.style.width=e.thumbTotalWidth+"px",o.style.position="relative");for(t=e.core.outer.querySelectorAll(".lg-thumb-item"),s=0;s<t.length;s++)(function(n){utils.on(t[n],"click.lg",function(){setTimeout(function(){e.core.index=n,e.core.slide(n,!1,!0)},50)})})(s);utils.on(e.core.el,"onBeforeSlide.lgtm",function(n){for(var s=0;s<t.length;s++)utils.removeClass(t[s],"active");utils.addClass(t[n.detail.index],"active"),e.thumbIndex=n.detail.index,e.core.s.animateThumb&&e.animateThumb(n.detail.index)}),utils.on(window,"resize.lgthumb orientationchange.lgthumb",function(){setTimeout(function(){e.thumbOuterWidth=e.thumbOuter.offsetWidth,e.core.s.animateThumb&&e.animateThumb(e.thumbIndex)},200)}),e.core.s.animateThumb&&e.animateThumb(e.core.index)},e.prototype.animateThumb=function(e){var t,s=this.core.outer.querySelector(".lg-thumb"),o=this.core.s.thumbWidth+this.core.s.thumbMargin,n=0;switch(this.core.s.currentPagerPosition){case"left":n=0;break;case"middle":n=this.thumbOuterWidth/2-this.core.s.thumbWidth/2;break;case"right":n=this.thumbOuterWidth-this.core.s.thumbWidth}t=o*e-1-n,t>this.thumbTotalWidth-this.thumbOuterWidth&&(t=this.thumbTotalWidth-this.thumbOuterWidth),t<0&&(t=0),this.core.lGalleryOn&&utils.setVendor(s,"TransitionDuration",this.core.s.speed+"ms"),utils.setVendor(s,"Transform","translate3d(-"+t+"px, 0px, 0px)")},e.prototype.toggle=function(){var e=this;utils.addClass(e.core.outer,"lg-can-toggle"),e.thumbOuter.insertAdjacentHTML("beforeend",'<span class="lg-toggle-thumb lg-icon"></span>'),utils.on(e.core.outer.querySelector(".lg-toggle-thumb"),"click.lg",function(){utils.hasClass(e.core.outer,"lg-thumb-open")?utils.removeClass(e.core.outer,"lg-thumb-open"):utils.addClass(e.core.outer,"lg-thumb-open")})},e.prototype.thumbKeyPress=function(){var e=this;utils.on(window,"keydown.lgthumb",function(t){t.keyCode===38?(t.preventDefault(),utils.addClass(e.core.outer,"lg-thumb-open")):t.keyCode===40&&(t.preventDefault(),utils.removeClass(e.core.outer,"lg-thumb-open"))})},e.prototype.destroy=function(){this.core.s.thumbnail&&this.core.items.length>1&&(utils.off(window,".lgthumb"),this.thumbOuter&&this.thumbOuter.parentNode&&this.thumbOuter.parentNode.removeChild(this.thumbOuter),utils.removeClass(this.core.outer,"lg-has-thumb"))},window.lgModules.thumbnail=e})()
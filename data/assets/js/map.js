@@ -0,0 +1,310 @@
+/**!
+ * map.js
+ * A small slippy map showing where photos were taken, drawn from any
+ * {z}/{x}/{y} tile server. Photos close together at the current zoom are
+ * clustered into a single marker that zooms in when clicked.
+ * @license GPLv3
+ */
+(function () {
+    'use strict';
+
+    var tileSize = 256;
+    var minZoom = 1;
+    var maxZoom = 18;
+    // photos closer together than this many pixels share a marker
+    var clusterSize = 64;
+    var thumbSize = 48;
+
+    function escapePath(base, path) {
+        return base + path.split('/').map(encodeURIComponent).join('/');
+    }
+
+    // project converts a longitude and latitude to Web Mercator pixels at
+    // zoom level 0, where the whole world fits on one tile.
+    function project(lon, lat) {
+        var sin = Math.sin(lat * Math.PI / 180);
+        sin = Math.min(Math.max(sin, -0.9999), 0.9999);
+        return {
+            x: tileSize * (lon + 180) / 360,
+            y: tileSize * (0.5 - Math.log((1 + sin) / (1 - sin)) / (4 * Math.PI))
+        };
+    }
+
+    function clamp(value, low, high) {
+        return Math.min(Math.max(value, low), high);
+    }
+
+    function element(tag, className, parent) {
+        var el = document.createElement(tag);
+        el.className = className;
+        if (parent) {
+            parent.appendChild(el);
+        }
+        return el;
+    }
+
+    function GalldirMap(el, options) {
+        var map = this;
+        this.el = el;
+        this.options = options;
+        this.photos = [];
+        this.tiles = {};
+        this.center = { x: tileSize / 2, y: tileSize / 2 };
+        this.zoom = minZoom;
+
+        this.tilePane = element('div', 'galldir-map-tiles', el);
+        this.markerPane = element('div', 'galldir-map-markers', el);
+        var controls = element('div', 'galldir-map-controls', el);
+        this.button(controls, '+', 'Zoom in', function () { map.zoomBy(1); });
+        this.button(controls, '−', 'Zoom out', function () { map.zoomBy(-1); });
+        element('div', 'galldir-map-attribution', el).innerHTML = options.attribution || '';
+        this.message = element('div', 'galldir-map-message', el);
+
+        this.listen();
+        window.addEventListener('resize', function () { map.render(); });
+    }
+
+    GalldirMap.prototype.button = function (parent, label, title, action) {
+        var button = element('button', 'galldir-map-button', parent);
+        button.type = 'button';
+        button.title = title;
+        button.textContent = label;
+        button.addEventListener('click', action);
+    };
+
+    GalldirMap.prototype.scale = function () {
+        return Math.pow(2, this.zoom);
+    };
+
+    // offset returns the position of a point on the page relative to the
+    // centre of the map.
+    GalldirMap.prototype.offset = function (event) {
+        var rect = this.el.getBoundingClientRect();
+        return {
+            x: event.clientX - rect.left - this.el.clientWidth / 2,
+            y: event.clientY - rect.top - this.el.clientHeight / 2
+        };
+    };
+
+    // setZoom changes the zoom level, keeping the point at offset (from the
+    // centre of the map) in place.
+    GalldirMap.prototype.setZoom = function (zoom, offset) {
+        zoom = clamp(zoom, minZoom, maxZoom);
+        offset = offset || { x: 0, y: 0 };
+        var before = this.scale();
+        var after = Math.pow(2, zoom);
+        this.center.x += offset.x / before - offset.x / after;
+        this.center.y += offset.y / before - offset.y / after;
+        this.zoom = zoom;
+        this.render();
+    };
+
+    GalldirMap.prototype.zoomBy = function (delta, offset) {
+        this.setZoom(this.zoom + delta, offset);
+    };
+
+    GalldirMap.prototype.listen = function () {
+        var map = this;
+        var drag = null;
+
+        this.el.addEventListener('pointerdown', function (event) {
+            if (event.button !== 0 || event.target.closest('a, button')) {
+                return;
+            }
+            drag = { x: event.clientX, y: event.clientY };
+            map.el.setPointerCapture(event.pointerId);
+            map.el.classList.add('galldir-map-dragging');
+        });
+        this.el.addEventListener('pointermove', function (event) {
+            if (!drag) {
+                return;
+            }
+            map.center.x -= (event.clientX - drag.x) / map.scale();
+            map.center.y -= (event.clientY - drag.y) / map.scale();
+            drag = { x: event.clientX, y: event.clientY };
+            map.render();
+        });
+        function endDrag() {
+            drag = null;
+            map.el.classList.remove('galldir-map-dragging');
+        }
+        this.el.addEventListener('pointerup', endDrag);
+        this.el.addEventListener('pointercancel', endDrag);
+        this.el.addEventListener('wheel', function (event) {
+            event.preventDefault();
+            map.zoomBy(event.deltaY < 0 ? 1 : -1, map.offset(event));
+        }, { passive: false });
+        this.el.addEventListener('dblclick', function (event) {
+            if (!event.target.closest('a, button')) {
+                map.zoomBy(1, map.offset(event));
+            }
+        });
+    };
+
+    GalldirMap.prototype.tileURL = function (x, y, z) {
+        return this.options.tiles
+            .replace('{s}', 'a')
+            .replace('{z}', z)
+            .replace('{x}', x)
+            .replace('{y}', y);
+    };
+
+    GalldirMap.prototype.renderTiles = function (left, top) {
+        var w = this.el.clientWidth;
+        var h = this.el.clientHeight;
+        var count = Math.pow(2, this.zoom);
+        var wanted = {};
+        var x, y;
+        for (y = Math.max(0, Math.floor(top / tileSize)); y <= Math.min(count - 1, Math.floor((top + h) / tileSize)); y++) {
+            for (x = Math.floor(left / tileSize); x <= Math.floor((left + w) / tileSize); x++) {
+                var key = this.zoom + '/' + x + '/' + y;
+                var tile = this.tiles[key];
+                if (!tile) {
+                    tile = element('img', 'galldir-map-tile', this.tilePane);
+                    tile.alt = '';
+                    tile.draggable = false;
+                    tile.src = this.tileURL(((x % count) + count) % count, y, this.zoom);
+                    this.tiles[key] = tile;
+                }
+                tile.style.left = (x * tileSize - left) + 'px';
+                tile.style.top = (y * tileSize - top) + 'px';
+                wanted[key] = true;
+            }
+        }
+        for (var old in this.tiles) {
+            if (!wanted[old]) {
+                this.tilePane.removeChild(this.tiles[old]);
+                delete this.tiles[old];
+            }
+        }
+    };
+
+    GalldirMap.prototype.clusters = function () {
+        var scale = this.scale();
+        var cells = {};
+        var clusters = [];
+        this.photos.forEach(function (photo) {
+            var x = photo.point.x * scale;
+            var y = photo.point.y * scale;
+            var key = Math.floor(x / clusterSize) + ',' + Math.floor(y / clusterSize);
+            var cluster = cells[key];
+            if (!cluster) {
+                cluster = cells[key] = { x: 0, y: 0, photos: [] };
+                clusters.push(cluster);
+            }
+            cluster.x += x;
+            cluster.y += y;
+            cluster.photos.push(photo);
+        });
+        clusters.forEach(function (cluster) {
+            cluster.x /= cluster.photos.length;
+            cluster.y /= cluster.photos.length;
+        });
+        return clusters;
+    };
+
+    GalldirMap.prototype.renderMarkers = function (left, top) {
+        var map = this;
+        var w = this.el.clientWidth;
+        var h = this.el.clientHeight;
+        this.markerPane.textContent = '';
+        this.clusters().forEach(function (cluster) {
+            var x = cluster.x - left;
+            var y = cluster.y - top;
+            if (x < -thumbSize || y < -thumbSize || x > w + thumbSize || y > h + thumbSize) {
+                return;
+            }
+            var marker;
+            if (cluster.photos.length === 1) {
+                var photo = cluster.photos[0];
+                var path = escapePath(map.options.base || '', photo.path);
+                var img = document.createElement('img');
+                marker = element('a', 'galldir-map-marker galldir-map-photo', map.markerPane);
+                marker.href = path + '?view=page';
+                marker.title = photo.description || photo.name;
+                img.src = path + '?thumb=' + thumbSize;
+                img.srcset = img.src + ' 1x, ' + path + '?thumb=' + (2 * thumbSize) + ' 2x';
+                img.alt = photo.description || photo.name;
+                img.draggable = false;
+                marker.appendChild(img);
+            } else {
+                marker = element('button', 'galldir-map-marker galldir-map-cluster', map.markerPane);
+                marker.type = 'button';
+                marker.title = cluster.photos.length + ' photos';
+                marker.textContent = cluster.photos.length;
+                marker.addEventListener('click', function () {
+                    map.center = { x: cluster.x / map.scale(), y: cluster.y / map.scale() };
+                    map.zoomBy(2);
+                });
+            }
+            marker.style.left = x + 'px';
+            marker.style.top = y + 'px';
+        });
+    };
+
+    GalldirMap.prototype.render = function () {
+        var scale = this.scale();
+        var left = this.center.x * scale - this.el.clientWidth / 2;
+        var top = this.center.y * scale - this.el.clientHeight / 2;
+        this.renderTiles(left, top);
+        this.renderMarkers(left, top);
+    };
+
+    // fit centres the map on the photos, zoomed in as far as possible
+    // while showing them all.
+    GalldirMap.prototype.fit = function () {
+        if (this.photos.length === 0) {
+            this.render();
+            return;
+        }
+        var minX = Infinity, minY = Infinity, maxX = -Infinity, maxY = -Infinity;
+        this.photos.forEach(function (photo) {
+            minX = Math.min(minX, photo.point.x);
+            minY = Math.min(minY, photo.point.y);
+            maxX = Math.max(maxX, photo.point.x);
+            maxY = Math.max(maxY, photo.point.y);
+        });
+        this.center = { x: (minX + maxX) / 2, y: (minY + maxY) / 2 };
+        var fit = Math.min(
+            this.el.clientWidth / Math.max(maxX - minX, 1e-9),
+            this.el.clientHeight / Math.max(maxY - minY, 1e-9)
+        );
+        this.zoom = clamp(Math.floor(Math.log(fit * 0.8) / Math.LN2), minZoom, 15);
+        this.render();
+    };
+
+    GalldirMap.prototype.load = function (features) {
+        this.photos = features.map(function (feature) {
+            var coordinates = feature.geometry.coordinates;
+            return {
+                path: feature.properties.path,
+                name: feature.properties.name,
+                description: feature.properties.description,
+                point: project(coordinates[0], coordinates[1])
+            };
+        });
+        this.message.textContent = this.photos.length ? '' : 'None of these photos has a location.';
+        this.fit();
+    };
+
+    window.galldirMap = {
+        init: function (el, options) {
+            var map = new GalldirMap(el, options);
+            map.render();
+            fetch(options.data, { credentials: 'same-origin' })
+                .then(function (response) {
+                    if (!response.ok) {
+                        throw new Error(response.statusText);
+                    }
+                    return response.json();
+                })
+                .then(function (geojson) {
+                    map.load(geojson.features);
+                })
+                .catch(function (err) {
+                    map.message.textContent = 'Failed to load photo locations: ' + err.message;
+                });
+            return map;
+        }
+    };
+})();
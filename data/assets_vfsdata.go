@@ -1,418 +0,0 @@
-// Code generated by vfsgen; DO NOT EDIT.
-
-package data
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"net/http"
-	"os"
-	pathpkg "path"
-	"time"
-)
-
-// Assets statically implements the virtual filesystem provided to vfsgen.
-var Assets = func() http.FileSystem {
-	fs := vfsgen۰FS{
-		"/": &vfsgen۰DirInfo{
-			name:    "/",
-			modTime: time.Date(2019, 3, 26, 11, 30, 23, 997043492, time.UTC),
-		},
-		"/css": &vfsgen۰DirInfo{
-			name:    "css",
-			modTime: time.Date(2019, 3, 27, 11, 53, 23, 407282855, time.UTC),
-		},
-		"/css/galldir.css": &vfsgen۰CompressedFileInfo{
-			name:             "galldir.css",
-			modTime:          time.Date(2019, 3, 27, 11, 53, 23, 407282855, time.UTC),
-			uncompressedSize: 180,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xcd\x41\xaa\xc2\x40\x0c\xc6\xf1\xfd\x9c\x22\x17\xe8\xe3\x29\xe8\x62\xe6\x34\x99\x26\x4e\x83\x69\x3a\xa4\x53\xac\x48\xef\x2e\x4a\x05\x37\x6e\x7f\xf0\x7d\xff\xe1\x00\x8f\x00\x00\xd0\x78\x6d\x1d\xaa\x14\x8b\xd0\xb3\x35\xf6\x14\xb6\x10\xfe\x0a\xaa\x92\x78\x87\x9a\x97\x71\x86\x8b\x94\xc5\x79\xdf\xdc\x84\xda\x10\xe1\x78\x3a\xd7\x35\xbd\x85\x64\xae\x8a\xf7\x08\x62\x2a\xc6\x5d\xd6\xa9\xbf\xa6\x9f\x81\x97\xe7\xc9\x89\x3d\xc2\xff\xe7\xa3\x22\x91\x58\xf9\x92\x11\xbd\x88\xed\xb0\x85\x67\x00\x00\x00\xff\xff\x34\x03\x92\xf7\xb4\x00\x00\x00"),
-		},
-		"/css/lg-fb-comment-box.css": &vfsgen۰CompressedFileInfo{
-			name:             "lg-fb-comment-box.css",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 1083,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x51\x8b\xd4\x30\x10\xc7\xdf\xfb\x29\xc6\x3b\x04\x3d\x48\xb7\xca\xbd\xd8\xc3\x6f\xa0\x2f\x82\xcf\xcb\x34\x9d\x66\x07\x93\x4c\x48\xa6\x6e\x55\xfc\xee\x92\xdd\x1e\xb7\xb0\x5c\x4f\xc4\x7d\xc9\x64\x60\x7e\xb3\xff\xfe\xf3\x6f\xbd\x33\x32\x2b\xe5\x76\x1a\x8c\x95\x10\x28\x6a\x81\xda\xe5\xe0\xcc\x31\x63\x82\x5f\x0d\x40\xc2\x71\xe4\xe8\x4c\x66\x77\xd0\x1e\xee\xbb\x2e\x2d\xf0\x8a\x43\x92\xac\x18\xf5\x01\x7e\x37\xcf\x90\x2e\x2f\x95\x74\xa0\x33\xe2\x5d\xd7\xbd\x7e\x68\x00\xe4\x3b\xe5\xc9\xcb\xd1\xfc\xe8\x01\x67\x95\xda\x4b\x52\x58\x59\x62\x0f\x38\x14\xf1\xb3\x52\xed\xae\xbb\xbb\x5a\xab\xa4\xb5\x3a\xf2\xa8\x87\x1e\xee\xdf\x77\x69\xa9\xf7\x9f\x86\xe3\x48\x4b\x0f\x1f\xea\xaf\x76\x06\xb4\xdf\x5c\x96\x39\x8e\x3d\xdc\x4e\xd3\x04\x73\xf6\x6f\x6e\xda\x76\xc7\xc1\xed\xbc\x60\x15\xd6\x3a\x9e\x6e\xde\x42\x14\x93\x29\x11\x2a\x14\x9b\xc5\x7b\xb0\x14\x95\xf2\x7a\x54\x95\x00\x2f\xeb\x6c\xa7\x61\xcf\x53\xc6\x40\xfb\x23\x8f\x8e\xf4\x24\xfc\xf2\x8f\x18\x0e\xe8\xa8\x87\x28\x91\xce\xd4\x7f\xe2\x5e\x35\xf6\x55\x0e\xe5\x75\xdf\x7f\x96\xfe\xfc\x53\x51\x11\x3f\xe0\x79\xed\xe3\x0b\x79\xf4\x63\xf5\xe7\x64\xed\x26\x04\x6d\xb5\xfc\x5c\x47\x5a\xf4\x9a\xb6\x39\xce\x4a\xe1\x34\xb2\xf5\x95\xb7\xc7\xeb\x69\x25\x24\x4f\x4a\xd7\x11\xd8\x22\xff\x65\x8a\x2e\xed\xa8\x4e\x5c\x1b\xf1\x92\x0f\xa0\x19\x63\x49\x98\x69\x33\x74\xde\x99\x32\x0f\xe6\xa0\xc1\x5f\xc6\x77\x8d\xcc\x53\xbc\x8a\xa2\xb2\xad\xa0\x66\x77\x77\x0b\x45\xe6\x6c\xe9\x33\xa6\xc4\xd1\x7d\xfd\xf2\xe9\xa3\x77\xe6\x89\x6b\x06\x59\x5a\x5b\x4a\x1b\x30\xc1\xdd\xae\xf9\x13\x00\x00\xff\xff\x5c\x78\xc3\x7d\x3b\x04\x00\x00"),
-		},
-		"/css/lg-fb-comment-box.css.map": &vfsgen۰CompressedFileInfo{
-			name:             "lg-fb-comment-box.css.map",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 644,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x5d\x4f\xf2\x30\x18\x86\xcf\xf7\x2b\x48\x8f\x1f\xe0\xcd\x6b\x42\x0c\x3b\xba\xdb\x95\xa5\x41\x24\xa8\x98\xa8\xf1\x60\xe0\x36\x26\xfb\xc0\x15\x8c\x1f\xf1\xbf\x9b\xc7\x69\xe8\x9c\x67\xdd\xb5\x6b\xbd\xef\xf5\xe9\xbb\x27\x9e\xe3\xda\x66\x55\x29\xc6\xbd\x13\xf2\x44\x11\xed\x76\x59\x99\x5a\x31\xee\x09\x20\x34\xb4\x55\x40\xe4\x6b\xa8\x2b\x8a\x80\x88\x34\xa0\x29\x95\x40\x2a\x7d\x40\x97\xca\x35\x66\xc0\xac\x31\x0c\x60\x98\xdd\xd2\x12\x58\xb6\xd9\x46\xd2\x02\x58\x34\x90\x57\x0c\x73\x49\x53\x60\xda\x40\x05\x28\x86\x17\x14\x02\x61\x9b\x9d\x3b\x1e\xaf\x9a\x94\x39\x30\x6f\xb3\x07\x27\x79\x14\x00\xa3\x80\xe9\x9b\xa6\x22\x00\x0a\xe9\x1b\xa8\xe4\xfb\x47\x9c\x7a\x06\x6a\x2f\xe9\xbf\x06\x5e\xa5\x3f\x83\xaa\x65\x77\x1b\xc0\xd4\x13\xca\x14\x70\xd3\x94\xfc\xab\xd0\x91\x7d\xed\x0b\xe8\x84\xfe\x29\xe0\x49\xf2\xfb\x47\xf9\xfb\x23\x40\x6f\x24\x9d\x4a\xe0\x92\x85\xb3\x6e\x35\x0d\x9d\x4b\x3a\x04\x1c\x6b\x38\xb6\x63\x00\x26\x6f\xcd\xe3\x58\x7d\xab\x81\xad\xf6\x81\x30\x9f\xb8\xc6\xf1\xdc\x7e\xce\xf7\xda\x19\x0e\xcf\x53\x90\x27\x6c\x75\xa8\xd7\x31\x5f\x8a\x3b\x31\x18\x0c\x6d\x64\xed\x30\x4f\xfb\xc9\xaa\xbf\xae\x8a\x22\x2e\xf7\xfd\x55\xf5\x32\xb0\x6b\x6b\xc5\x3d\x79\xa2\x8c\x8a\x46\xe6\x87\x24\xcb\x63\xbe\x4d\x5d\x9f\x75\xef\xc3\xfb\x0c\x00\x00\xff\xff\xf4\x89\x39\xe0\x84\x02\x00\x00"),
-		},
-		"/css/lg-fb-comment-box.min.css": &vfsgen۰CompressedFileInfo{
-			name:             "lg-fb-comment-box.min.css",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 805,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x52\xe1\x4a\xf4\x30\x10\x7c\x95\xfb\xf8\x10\x14\x4c\x2f\x8a\x7f\x4c\x1e\xe6\xd8\xb6\x9b\x74\x31\xc9\x86\x74\x6b\xab\xe5\xde\x5d\x6a\x3d\xee\x40\xa8\xa8\x98\x3f\x9b\x6c\x32\x3b\x93\xd9\xad\x82\x57\x3c\x08\x96\xca\xd5\xaa\xe1\x18\x31\x49\xbf\xbb\x3c\xcc\x1d\x92\xef\xc4\xdc\x69\x7d\x65\xf9\x19\x8b\x0b\x3c\xaa\x17\x03\x83\xb0\xcd\xdc\x93\x10\x27\x03\x75\xcf\x61\x10\xb4\xe5\xfd\xb1\xb6\xc2\xd9\x68\x3b\x52\x2b\x9d\x79\xb8\xd7\x79\xb2\xaf\x8a\x52\x8b\x93\x79\x5c\x96\xad\xa1\x79\xf2\x85\x87\xd4\x9a\xa1\x84\xeb\xaa\xda\x53\xf4\xfb\xc0\xd0\x52\xf2\x95\x27\x77\xb3\x6b\x30\x09\x96\x53\x48\xac\x0a\x66\x04\xd9\xfd\x77\xce\x1d\xbf\x16\x5e\xb9\xfa\x40\xae\x40\xc4\xc3\x48\xad\x47\x99\xcf\x9c\x8a\x22\x78\x34\x89\x13\xfe\xa4\xd2\xa7\xc4\x61\x11\x8e\x65\xfe\xa3\x5f\x05\xaf\x84\x39\xd4\x50\xe6\xd5\xdf\xd5\xd1\xd5\xdd\xa5\x11\x1b\x40\x68\x96\x06\xad\xfb\x84\x93\x5c\x56\xd8\x80\x91\x60\xbc\xdd\xbe\x5e\x62\xc3\x31\x07\x14\x5c\x93\xd1\xab\xb1\x40\xfe\x9e\xd1\x97\xc0\x0c\xed\xe2\x94\xfa\xd0\xa8\x75\x9e\xfe\x51\xcc\x5c\x04\x92\xfc\x62\x66\x36\xb8\xfb\xa1\x56\x9d\xc4\x70\xe2\x36\xfa\x3c\xd5\xbd\x80\x50\x73\x7c\x0b\x00\x00\xff\xff\x87\x2d\xf7\x83\x25\x03\x00\x00"),
-		},
-		"/css/lg-transitions.css": &vfsgen۰CompressedFileInfo{
-			name:             "lg-transitions.css",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 47195,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x61\x6f\xdb\x46\x12\xfd\xee\x5f\x41\xe0\x70\x80\x13\x90\x8a\x64\xc3\xb9\x6b\x82\xfe\x83\xf6\x4b\x81\xfe\x00\x59\x66\x5c\x22\xb2\x29\x90\x94\x9d\xa4\xc8\x7f\x2f\x28\xd9\xae\x48\xee\xce\xce\x9b\x59\x89\x8c\x33\x80\x8f\x39\xd4\xf3\x86\xdc\x19\xbe\xf7\x76\x97\x96\x38\x5b\xdf\x66\xab\xba\xbe\x6c\xff\xfd\x56\x96\x77\x59\x71\x9f\xb4\xff\xbf\x68\xf2\xbb\xe4\xef\xb3\x24\x29\x37\xcb\x55\xd1\x7c\xfd\x90\xcc\x3f\x26\xdf\xcf\x92\x84\x02\xb4\xff\x6e\xaa\xfc\x21\xab\xd7\xc5\x4d\xbe\x83\x27\x49\xf6\x98\x5f\x7f\x2e\x9a\xac\xa9\x96\xf7\xf5\xa7\xb2\xba\xfb\x90\xd4\xab\xe5\x3a\xbf\xbc\x39\x5f\xcc\x2e\xd3\xe4\xf9\xf0\xe6\xe3\x2e\x3c\x1c\xc6\xba\x8c\xfb\xfc\x4b\x33\x81\xcb\x58\x6d\xab\x2a\xbf\x6f\x18\xd7\x90\x26\xed\x0f\x71\xf6\x4e\xc0\x4b\x57\x16\x68\x57\x52\xa0\x74\xe1\x58\x62\x7c\x45\x53\x94\xf7\x1f\x86\x63\x4e\x16\x75\xb2\xda\x5e\x17\xab\xec\x3a\xff\x56\xe4\xd5\xf9\x3c\x4d\xda\x9f\xd9\xc5\x55\x3b\xbe\x64\x5e\xa7\xcf\xc3\x6b\x43\xf3\x65\x9d\x27\xf3\x7a\x3f\xec\xec\xae\xfc\xd6\xcd\xfe\xf2\x1f\xd4\xa9\xcb\x6e\xe2\x32\x4e\xda\xc3\x9c\xda\x84\xc9\xf7\x33\x47\x3f\xb2\xeb\xe2\x16\xa6\x6c\x07\x84\xd2\xf6\x22\x4d\xda\x1f\xff\xbd\xfa\x12\xc0\x3e\x3d\x42\xd7\x23\x9c\x7e\x44\x9a\x12\x9d\x70\xd2\x8f\x28\x1d\x2f\xde\x28\x3b\x32\x65\xcb\x6d\x03\xf2\xf5\x10\x81\x92\x75\x3e\xfb\x5f\x7b\x99\xfb\x83\xff\xbe\xed\x85\xf1\xae\x03\x61\xed\x31\xaf\x63\x34\xfa\xfa\x1b\xe3\xe0\xa2\xbf\x7a\x8c\x60\x63\xed\xf8\xac\x15\x38\x6d\x1f\x05\xb3\x77\x7f\xbd\x04\x5f\x9e\x03\xf8\xe7\x87\x58\x7b\x84\xf3\x8f\xc9\x56\xcc\x6d\xe9\xea\x31\x01\xc6\xdc\x09\x30\x17\x5e\xd5\xf6\x40\x63\xf0\x56\xb1\xa2\xd5\x4e\x91\x1d\xa7\x1f\x95\xb5\xc8\x6a\x96\x2c\x1d\x2f\xde\x28\x3b\xfe\xaa\x16\x9f\x25\xf7\x40\x23\xac\x6a\x55\xf3\x63\xa5\x62\x38\x4e\x3f\xe6\xaa\x16\x9a\x19\x93\xa5\xe3\xc5\x1b\x65\xc7\xa3\x6c\x5d\x7e\x6a\x76\x8d\xe1\x13\x76\x08\x41\xe9\xba\x98\xb5\x77\xe4\xd3\x81\xda\xb4\xed\x84\x31\x2f\x04\x5b\xd8\xfe\xd2\x56\x6c\x7f\xa0\x16\xb6\x9d\x30\xe6\x85\x8c\x41\xe1\x50\x6f\x52\xa8\x80\x9c\x68\x23\xef\x88\xe4\x6d\xef\x8f\x6c\xbb\x01\xb8\xdb\x47\xb8\xa9\xdb\xa9\xe9\x21\x0f\xfe\xdf\x5e\xe3\xfe\xf0\x66\x3f\x9a\xf5\xb2\xd9\xfd\xea\xbf\x69\xb2\x68\x0f\xcf\x2e\x78\x58\x3e\x61\x86\xbb\x5a\x9d\x82\xa2\x3e\x3f\x8d\x1c\xce\x6b\xc0\x50\xb2\xac\x01\x27\x6d\x40\x4f\xc4\x3c\xd5\x7f\x96\xe1\xee\xc9\x3a\x73\x3f\x77\xd1\x39\x40\x77\xad\x39\xc8\xb0\xb1\x10\x68\x19\x8a\xb4\x20\x5a\x62\x52\x84\x0e\x8c\x60\xf3\x9f\x11\xfd\xa7\xed\x51\xb6\x2a\xaa\xd5\x76\xbd\xac\x00\x17\x72\xe3\x30\x2f\x7a\xba\x21\x3b\x77\x69\xb6\x98\xef\x78\x1f\x54\x41\x26\xd8\x23\x80\x4c\x74\x78\xc1\x18\xc8\x20\x42\x22\xd5\xe6\x1b\x8f\xeb\xb4\x9a\x62\xab\x6a\xad\x2e\xb5\xb8\xd2\xd2\x42\x9b\xc1\x44\x34\x18\x8e\x7a\xa4\x38\x05\xd8\x10\xb3\x9c\xa9\x58\x0e\xb6\xf6\xf1\x42\xa3\x19\xcf\xb3\x10\x8b\xcd\xa7\x9f\x00\x37\xa0\x7e\x06\x99\x09\xf5\xb2\x88\xd1\x60\x13\x62\xf8\x91\xbc\x07\xea\x16\x44\xe9\x80\xaa\x01\xca\xfa\x9b\x4d\x1d\xcb\xa6\xd8\x0b\x22\x26\x3f\x10\x94\xf9\xd5\x64\xfc\xea\xa6\x7c\x04\x9e\x66\x53\xe0\x68\x9e\xa5\xb5\x2c\xb5\x63\x45\x31\x2c\x95\x5f\xb1\xe5\x72\xd0\x81\x18\x86\xa5\xf4\x2b\xad\x5d\xc5\x70\x2b\x8d\x59\xc9\x8b\x6f\x6e\x75\x34\xb7\x22\xa4\x86\x74\x1e\x82\x20\x18\xce\x3c\x6b\x32\x9e\xf5\x90\x57\x4d\xb1\x5a\xae\xc5\xbe\x35\x48\xa0\xf7\xae\xb9\x66\x9e\xef\x00\x03\xaa\xe9\x40\x83\x9a\x39\xcc\x20\x42\x8a\xca\xae\x33\xac\xb9\xc2\xad\x86\x58\xac\xe8\xea\x9a\x8b\x4b\xae\xab\xb8\xb9\xd4\xd1\x5c\x2a\x20\x2c\xa4\xe3\x04\xd8\x81\x63\xcd\xb1\x26\xe7\x58\xd9\x3a\xff\x04\xfc\x25\x22\x2b\x8b\xed\x15\x8e\xb5\x57\xe8\xef\x87\xce\xd4\x6c\x1d\x0c\xaf\x83\xfd\xad\x30\xb7\x3b\xba\xdb\x51\x7a\xc4\xb2\x2d\x8a\x40\xc2\x04\x66\x7e\xd3\x33\x3f\xd5\x5e\xa3\x3b\x8b\xde\xfc\xec\x19\x0d\xfc\x8c\xc6\xdf\x0a\xdb\x7d\x3c\xdd\xee\xa3\xbf\x0b\x66\x79\xc7\xb7\x3c\xe9\x7e\x24\x87\x3b\xc2\x04\x66\x79\x63\x5b\x9e\x74\x63\x92\xb9\x1f\x39\xa4\x06\x7b\x13\x0f\xdd\xb3\x63\x6e\xd5\x05\xaf\x88\x7b\x41\xa2\xeb\x09\x7e\x9e\x85\x25\x14\x1a\x5d\x10\xec\xf7\x08\xb6\x79\xc6\xd8\xdd\x99\xcf\x8e\xc8\x77\x47\xf2\x38\x8c\x1f\x26\x56\x73\xbe\x93\x92\x64\x7d\x76\x5b\x95\x8f\xcd\x5f\x52\xf2\xf7\xe1\xd8\xd4\x76\x76\xd5\x5e\xff\xfe\xe0\xd8\xa2\xbf\xe2\x7c\x10\x03\x49\xe1\xfb\x24\x06\x92\x83\xfa\x28\x06\x90\x47\x81\x17\xb4\x04\xf8\x68\x0c\x75\x0d\xfa\x8e\x44\x68\x48\xa4\x7e\x28\xdb\xa1\xe9\x86\x4d\x75\x63\x4f\x75\x79\x72\x44\xd9\x14\xcd\x1a\x14\x69\x3e\x37\x15\x9f\xab\x3f\xe7\x8f\x59\x79\xbf\xfe\x8a\x3a\xdc\x10\xc8\xf3\xb6\xcf\xf9\xe3\xf9\x62\x7e\x93\xdf\xa6\x49\x7b\x74\x33\xd5\x13\xd4\x63\xa5\x27\xca\xc1\x40\x77\x24\x19\x01\x0d\x3b\xec\x1f\xaf\x72\xd8\x01\xa1\x6e\x93\x73\x86\xcc\x1a\x31\x7b\xc0\xc1\xf1\x0e\x02\xc2\xfa\x19\xba\xd9\x5d\xfa\x17\xba\x53\xf8\x18\x53\xcb\xc9\xa9\x65\x56\xe5\x0f\x62\xc5\xec\x80\xf9\xaa\x99\xb1\xf4\xc3\x15\xe5\xa0\x93\x2b\xcc\x43\x28\x47\x28\x1d\x02\x8f\x9f\x27\x9f\xaf\x77\xfc\x3f\x9f\x8e\x12\x14\x20\x75\x91\xb8\x75\x30\x9c\x69\xea\xf4\x34\x55\x3e\x07\xcd\x24\xb3\xd0\xfd\xfd\xbb\xe0\x70\x6a\xc1\x22\xd5\x82\xcd\xaa\x45\x90\x56\x0b\xa6\x94\x48\xe6\xa1\xaf\x70\xe0\x3f\xa1\x82\x8a\xe6\xa2\xde\xfb\x05\x41\x99\x76\x4e\x50\x3b\x75\x33\x52\xe9\x9c\x74\x7f\x07\x67\x2c\x2d\xc9\x78\x62\x92\xf1\xd5\x24\x0b\xcb\x49\xc6\xd6\x13\xe1\xac\xf4\x35\x57\xe0\x67\x54\x55\xf1\xcc\x54\x3e\x37\xb5\xd9\xe9\xb4\x15\x56\x22\xab\x02\x2d\xbd\x38\xb8\x71\x5d\x7f\x37\xbb\xfb\xdf\xe6\x8b\x9f\x67\x60\x02\x07\x09\xc1\x0c\x1e\x86\x62\x59\xc4\x68\x6e\xf5\x79\x3a\xee\x3d\xad\xb6\xf8\xea\xda\x47\x29\xbd\xaa\xf2\xc2\xc2\x63\xf6\xd1\x7d\xc0\xc5\x29\x39\x00\xa6\x0d\x27\x84\x0e\x3b\x51\x20\x83\x1c\xc9\xf3\x2e\xd0\xb0\x40\x97\x32\x6b\x9a\xac\x35\x89\x67\xfd\xc2\x2d\x68\xb5\x47\xa1\x19\x5c\x5b\xb7\x71\x5c\x0a\x4c\x23\x87\x23\x6d\x60\xee\x84\x6b\xcd\x0a\x4c\x00\x35\x21\x4e\x0f\x74\x2d\x50\x74\xc0\x4c\xeb\x54\xa6\x25\x59\x69\x09\x96\x58\xb6\xb6\x9a\xb4\x81\xad\xaa\xb2\xae\x45\x16\xd6\x45\xa2\x7b\x56\xef\xe5\x1e\x06\x26\xf0\xd2\x98\x9d\x81\xa4\x32\x37\x8b\x18\x8d\xf5\x00\xd9\x35\x73\x9c\x5c\xdb\x02\x75\x07\xa2\x34\x40\x55\x7f\x55\xf9\xcd\xbe\x4e\x66\x5f\x94\xfe\x78\xdd\x88\x22\x0c\x00\x32\x13\x9b\x9e\x89\x89\xd7\x62\x43\x34\xfc\x00\x46\xed\x66\xfc\x0c\xfe\xc7\x16\x71\xfc\x8c\x9d\x46\x0e\xc7\x9b\x01\x3d\x0b\xd2\xda\x1a\x3b\x01\xd4\x8a\x38\x9d\xd0\x35\x42\xdd\x07\xb3\xb7\xd3\xda\x9b\x64\x8d\x16\x62\x10\x08\x34\xab\x9b\x94\xd5\x3d\xe4\xf0\x2b\x38\x06\x38\xbe\xbd\xbd\xf7\xde\xd6\xff\x7e\xde\x95\xe2\x33\x86\x77\x50\x1a\x4b\xe0\x61\x35\x94\x44\x0a\x46\x8a\xcf\xb3\x33\xea\xcc\xca\xda\x6b\x4b\x1f\xa3\xf2\x9a\xc2\x0b\xeb\x6e\xf6\x75\x32\xfb\xf2\xeb\x8d\xd7\x7f\xfc\x2c\x61\x43\xcc\xac\xa6\x66\x56\xe2\x55\x59\x1f\x0b\x3c\x25\xd3\xba\x16\x98\xc0\xf5\x78\x26\x8a\x6f\x61\x59\xc4\x68\xb4\x0b\xcc\x87\x64\x4a\xfb\xc2\xf0\x68\x0f\xa2\xb4\x40\xd5\x01\x45\x03\xcc\xc6\x4e\x69\x63\x92\x35\x18\x4d\x1b\x08\x66\x96\x36\x39\x4b\x93\x3f\x33\x1b\xa2\xd1\xad\xc6\x0b\xb1\xab\x61\x78\x2f\xb1\xb9\x09\x48\x6e\x33\x93\x48\xc1\x78\x0b\x90\x0d\x46\xf7\xf9\x95\x1d\xd0\x36\x20\x46\xfd\x35\xe5\x57\x55\xdf\x0c\xed\xa4\x86\x26\x7a\x72\x16\xe2\x0d\x08\x34\x5b\x9b\xa6\xad\xa9\xd6\x6b\x11\x9e\xa4\x69\xfd\x8d\x9d\xc0\xff\xf0\x26\x8a\xc3\x71\xb3\x88\xd1\xb2\x5e\x40\x0f\xd2\x94\x46\xc7\xc5\xa3\x9d\x88\xd2\x08\x55\x1f\xd4\x6d\x30\xc3\x3b\xbd\xe1\x49\xd7\x71\xe2\xe7\x69\xec\xf6\x9b\xf9\x8d\x60\x7e\xeb\x72\xbd\x2e\x36\x25\xf0\x6a\xe1\x01\xc2\x6d\x6e\xf4\x57\xa6\x92\xef\x7f\xf7\x06\xf2\xae\x25\x28\xee\x3d\x19\xdb\xe5\xde\x7f\xd3\xdd\xf9\x7c\x76\xe5\x94\x13\x1e\xa4\x2b\x22\x3c\x0c\xef\xab\x65\x07\x38\x20\x9e\x57\xb5\x31\xbf\xf1\x36\x70\x4b\xa5\x48\xcf\x19\xc1\xa6\x3b\xd3\xd1\x1d\x6c\xa2\xed\x44\xb1\x26\xd7\xc6\x79\x4f\xe5\xd0\x6f\xde\xe6\x0a\x37\x4b\xb7\x91\x29\xc1\xc9\x54\x88\x3d\x43\x62\xd4\x94\x09\x30\x45\x1a\x5f\x91\xaa\xb2\x59\x36\x39\x5f\x8b\x7a\xf1\x2c\x15\xda\x63\xce\xb3\xcb\xfd\x5f\x25\xba\x54\xc7\x1d\xd2\x55\x19\x77\xcc\x90\x2c\xce\x38\xe2\xf7\x9c\x31\x06\x67\x57\x4f\x49\xc3\x43\x64\x8c\x90\x39\xc0\xc0\xf8\x90\xe1\xd1\x4b\xd2\xa7\x84\xa1\x91\x05\xc7\xc5\x1a\x15\x39\xa6\x83\x5f\x52\x9a\x46\xde\xa4\x29\xbf\xd7\xc1\x50\x53\xb0\xa9\x28\x18\x36\xa3\x72\x60\x10\x25\xfb\x71\x58\x8e\xef\x01\xfe\x98\x62\x0d\x6e\xb2\xfd\xa0\x8a\xc6\x9e\xa3\x05\x9b\xcf\x0a\x37\x75\x1b\x5f\xdd\x9a\xed\x35\x30\x3b\xeb\x44\xb3\x14\xed\xe0\x55\x07\xf3\xc1\xab\x0e\x86\xfb\x55\xde\xb7\x3a\xb0\xc0\xde\x37\x3b\xb0\xd0\xe4\xdb\x1d\x38\x19\x44\xc8\x70\x8d\xd9\x2f\x30\x71\x9e\x4c\x53\x62\x55\x85\xd5\x05\x16\xd7\x17\x2b\xaf\xbd\x91\x04\x43\x51\x36\x42\xe8\x43\xca\xbd\xc9\x03\x81\x66\x1a\xe3\x98\xc6\xd9\xbb\xb7\xff\x49\xea\x72\x5b\xad\xf2\xdf\x97\x9b\x4d\x71\x7f\xfb\xe7\x1f\xbf\xfd\xda\x76\xe8\xe5\x4c\xf5\x6c\x55\xd7\xb3\xbb\xe5\x26\x79\xfb\xee\xec\x9f\x00\x00\x00\xff\xff\x8c\xc0\x33\x57\x5b\xb8\x00\x00"),
-		},
-		"/css/lg-transitions.css.map": &vfsgen۰CompressedFileInfo{
-			name:             "lg-transitions.css.map",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 17134,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x9b\x49\x73\xea\x48\xb3\x86\xf7\xe7\x57\x74\x78\x8b\x6e\x33\x4f\xed\x55\x69\xc0\x96\x87\x63\x01\x32\x02\xdd\xb8\x0b\x0e\x83\x10\x42\x4c\x62\xbe\xf1\xfd\xf7\x2f\x24\xa4\xca\x57\x58\xa6\x21\xc2\xb0\x6c\x65\x97\x2b\x4f\xe6\x53\x6f\x56\x65\x15\xff\xff\xeb\x61\x33\x58\x7a\xf6\x6c\xfa\xf0\xcf\x5f\x79\xe1\xd7\x83\xdb\x9d\xcf\xed\xa9\xe5\x3d\xfc\xf3\xd7\x03\x63\xaf\x75\xa1\x20\x32\xd6\x7c\x54\x98\xf4\x26\x7c\x30\xf6\x21\x28\x8c\x29\x82\xc4\x98\xf4\xa8\x30\xa5\x25\x64\x24\xc6\x2c\xf1\x51\x95\xac\xb7\x9d\x28\xd8\x22\x63\xb6\x78\xfc\x7f\x3c\x91\x31\x57\x7c\x54\x99\xb4\x92\x84\x26\x63\xcd\xd8\x67\x45\x2e\xbf\xae\x44\x1a\x5e\x78\xbd\x72\xf8\xc1\x1f\xbe\x96\x18\xeb\x3e\xaa\xd2\xf6\x75\x19\x1f\x6d\x25\x8f\x8e\x3e\xcb\x9b\xd7\x89\xf8\xf5\xdf\xf3\xd4\x17\x52\xcf\x8c\xa5\xa4\x47\x55\x72\x9b\x1b\x59\x70\x44\xc6\x1c\xff\x4f\x4e\xc5\x67\x61\xad\x30\xe6\xc9\x8f\x2a\x9b\x89\x4b\x45\x18\x30\x36\x10\x14\x36\x17\x99\x30\x8b\x0c\x0b\xdf\xd0\x65\xac\x2b\x28\x6c\x29\x2a\x82\x13\x19\x3c\xdf\xf0\xc9\xd8\xe7\x71\xba\x94\xcc\xd8\xc7\x23\x93\xd3\x8d\xa1\x60\x49\x67\xe3\x5b\x89\x02\xb4\x7f\xd9\x5d\xf3\x4f\x54\xe4\xd5\xcb\x4a\xa4\xe1\x8b\x6b\x87\x4f\xfc\xe1\xb9\x30\xbe\xf6\xcb\x95\xf1\x1d\xbd\x7c\x13\xdf\x9c\x1a\xc6\xb7\x50\xbf\x7d\x7c\x77\xf5\xa1\x50\x3a\xcf\x6f\x2e\x0a\x90\xa3\x5e\x09\x60\xfa\x39\x0c\x50\x30\xbc\xf2\x7c\xe5\xf0\xbc\x3f\x7c\x1b\xc6\x37\xf3\x7c\x65\x7c\x0f\xcf\xdf\xc4\xd7\x89\xe2\xbb\xd0\x6e\x1f\xdf\x89\x36\x14\xec\xf3\xfc\xa6\xf8\x02\x7f\xba\x12\xc0\xc3\xd3\x4a\xa4\xe1\xdb\x6b\x87\x2f\xfd\xe1\x85\x30\xbe\xb3\xa7\x2b\xe3\x3b\x7d\xfa\x26\xbe\x95\x28\xbe\xa9\xdf\xb7\x8f\x6f\xfe\xf7\xe5\xfa\xb0\xa8\x5d\xbb\xc0\x6b\xa8\x0f\xf6\xb5\xc3\xab\x0a\xe8\x43\x49\xb9\x32\xbe\x45\xe5\xdf\xf4\x61\xfb\x7e\xfb\xf8\xce\xdf\x2f\x8f\x6f\x45\xbe\x32\x40\x79\x19\xe3\x9b\xb9\x76\xf8\x46\x86\xf8\x7a\xf2\x95\xf1\x5d\xca\xff\x16\x5f\xfb\xed\xf6\xf1\x2d\xbf\x0e\x85\xca\x79\xfd\x2d\x44\x01\x5a\x4b\x57\x0a\xe8\x5c\x0a\x17\x78\x30\xdc\xbd\x76\xf8\xc8\x1f\xbe\x0f\xe3\x9b\x12\xaf\x8c\x6f\x55\xfc\x26\xbe\x8b\x28\xbe\x99\x97\xdb\xc7\x77\xf5\x72\x79\x7d\x2b\xf9\x00\xf6\x18\xeb\x09\x8a\x5c\x94\xed\x23\x9a\x15\x29\xb0\x94\x15\xa1\xc3\x58\x47\x50\xe4\xb2\xec\x82\xa5\xe2\x5b\x8e\xfe\xc8\x55\xd9\x01\x4b\xca\xb7\x44\x01\x93\xd3\x72\x8f\x4c\x96\x52\x56\xc2\x90\xc9\x23\xc5\xe3\x83\x14\xf6\xb4\x54\xc8\x9f\x3d\xf8\xb3\x8b\xf9\xb3\x07\x7f\x0e\x31\x7f\x32\xe0\x4f\x36\xe6\x4f\x2e\xe6\x4f\x1e\xfd\x29\xc8\xe4\x4f\x51\x3e\xf5\x27\xaa\xc0\x0b\x7f\x85\x85\xee\xcc\x7d\x77\x16\x12\x63\x8b\xa0\x90\xca\x73\xee\xce\xd2\x77\x87\x5b\x3c\xdf\x12\xba\xb3\xf2\xdd\xe1\x96\xb5\x6f\xe1\xee\x6c\xe4\x1e\x99\xb6\xbe\x29\x74\x67\xe7\xbb\x13\x59\x98\xb4\x93\xcf\x97\x74\x57\xbd\xc3\x96\xf4\x79\x28\xb8\xe7\x25\xd1\x96\xc3\x14\x7a\x12\xa5\x70\x29\xd9\xc7\xe5\xb4\x97\x8e\x16\x1e\xb3\x95\xe4\x82\x65\xed\x5b\xc2\x98\x6d\x24\x07\x2c\x5b\xdf\xc2\x63\xb6\x93\x7a\x64\xda\xfb\xa6\x30\x66\x07\xc9\xe3\x83\x14\xf6\x34\x52\xc8\x1f\x07\xfc\x19\xfb\xfe\xf8\xc9\xdd\x4a\x81\x65\xc3\xfd\x99\xf8\xfe\x70\x8b\xeb\x5b\x42\x7f\xa6\xbe\x3f\xdc\x32\xf3\x2d\xdc\x9f\xb9\xd4\x23\xd3\xc2\x37\x85\xfe\x2c\x7d\x7f\x42\x8b\xc2\x9e\xd2\x72\xc0\x97\x8f\x54\x45\x24\xa4\xca\x22\x22\x55\x11\x09\xa9\xaa\x88\x48\xa5\x44\x42\x2a\x2d\x22\x52\x96\x84\x48\x8d\x24\x40\xca\x96\x08\xa9\xb1\x74\x09\x52\xb3\x97\x68\x17\xfe\x74\x7b\xa4\x36\x4f\x43\xc1\x3b\x8f\xd4\x2c\x4a\x61\x8f\x32\xf8\xc7\x16\x85\x92\xc4\x58\xc9\xf7\xb3\x57\xe4\x01\xeb\xbb\xf0\x7d\x50\xe4\xe1\x1a\x3a\xf0\xdd\x12\x8b\x18\x2d\xb1\x47\x26\xdb\x37\x45\xd1\x12\x3d\x3e\x48\x61\x4f\x73\x85\x3c\xf9\x24\x4f\x74\xfb\x58\x68\x0a\xfe\xf0\xcf\x3c\xf7\xa4\xe5\xc2\x77\x23\xcf\x3d\x69\x3b\xf0\xbd\x93\x07\x47\xcc\x1e\x19\xba\x79\xee\xc6\x1f\x8f\x0f\x50\xd8\xd3\xf4\x88\xb4\x8f\xd0\x3b\x11\xf4\x86\x00\xbd\x13\x3f\xbf\x11\x9f\x0f\xa2\x47\x43\x78\xea\xc8\x4e\x03\xd0\x69\x12\x39\xfa\x25\xe0\x94\x22\x70\x66\xb5\x3b\x68\x91\x32\x0c\x56\xd6\x19\x70\xbc\x28\x5d\x2d\x4a\x97\x81\xe9\x6a\x51\xba\x3e\x31\x5d\x3a\xa5\xab\x89\xe9\x6a\x60\xba\xea\x90\x2e\x8d\xd2\xf5\x71\x9a\x2e\xee\x45\x9f\xbc\x18\xd8\xc7\xed\x5b\xce\x1f\xdc\xcf\x72\x2f\x7a\x2e\x7c\xff\x93\xe5\x5e\x74\x1d\xf8\x6e\x66\xc1\x8b\x4e\x8f\x0c\xed\x2c\xf7\xc2\xf0\xf8\x00\x85\x3d\x4d\x14\xc1\x0d\xa1\x99\x80\xee\xb8\x31\xdd\x99\x80\xee\x38\x31\xdd\x19\x83\xee\xd8\x31\xdd\x19\x89\xc8\x8e\x25\x02\x3c\x43\x82\x67\x70\x09\x3c\xee\x6b\xa4\x3a\xf2\x1d\xce\xfe\xf2\x30\x48\xd2\xb9\xde\x55\x94\xb6\x31\x14\x0e\x27\x56\xc8\xc6\x50\xc8\xec\x58\x21\x1b\x41\x21\xb3\x62\x85\x2c\x2d\x62\x21\x4b\x89\x50\xc8\xaa\x22\x15\xb2\x8a\x78\x5a\xc8\xb8\x3f\x4b\xf0\xc7\x8b\x15\xb2\x25\x14\xb2\x45\xac\x90\xcd\xa1\x90\xcd\x62\x85\x6c\x1a\x2b\x64\x2e\x16\xb2\x09\x14\x32\xe7\x4b\x21\x5b\x87\x40\x1d\x24\x02\x2a\x23\x21\x50\x07\x89\x80\xda\x4b\x08\xd4\x4e\x22\xa0\xb6\x12\x02\xb5\x89\x15\xb2\x35\x16\xb2\x15\x14\x32\xef\xa2\x42\x96\x8a\x90\x5a\x48\xb7\x47\x6a\x24\x0d\x03\x65\x3e\xd7\xee\x88\x52\x78\x80\xed\x6d\x46\xc6\x52\x76\x90\xa9\x96\xed\x65\x2c\x66\x3b\x99\xaa\xd9\x56\xc6\x72\xb6\x91\xb1\x9c\xad\x65\x28\x67\x2b\x99\xca\x99\x27\x9f\x96\x33\xee\x4f\x19\xfc\xa9\xc8\xa8\x90\x65\x99\x24\xb2\x24\xa3\x46\x16\x65\x12\xc9\x82\x8c\x2a\x99\x97\x51\x26\x73\x32\xe8\x64\x56\x26\xa1\xcc\xc8\xa7\x4a\x59\x08\x91\x1a\x2b\x84\x94\xa3\x20\x52\x63\x85\x90\xb2\x15\x44\x6a\xa4\x10\x52\x96\x82\x48\xa5\x63\xdb\xed\x14\x6e\xb7\xab\xb0\xdd\xae\x5c\xb4\xdd\xae\xbc\x85\x48\x75\x6f\x4f\x54\xe3\x72\xa0\xc6\x35\xd0\xa8\x1a\x26\x70\x5c\xa3\x04\xda\x35\x4c\xe0\xa8\x46\x09\xb4\x6a\x98\xc0\xb4\x82\x09\x4c\x29\x90\xc0\xaa\x42\x09\xac\x28\xa7\x09\xe4\xfe\x2c\xc1\x1f\xaf\x86\xc5\x6e\x59\xa3\x6a\xb7\xa8\x61\xb9\x9b\xd7\xa8\xde\xcd\x6a\x58\xf0\xa6\x35\xac\x78\x6e\x0d\x4a\xde\xa4\x46\x35\xcf\xa9\x9d\x16\xbd\x08\xa8\x43\x0d\x34\xaa\x16\xd3\xa8\x1a\x68\x54\x2d\xa6\x51\x35\xd0\xa8\x5a\x4c\xa3\x6a\x31\x8d\xaa\xa1\x46\xd5\x40\xa3\x6a\x57\x01\xd5\xbe\x3d\x50\x83\xcb\x4f\x6f\xd9\xd3\x1b\x81\xad\xc8\xd8\x3a\xe8\x79\x64\xb1\xe7\x11\x7e\x56\xe4\xd2\x4b\x56\xa4\xe1\xd5\xd3\xe1\xeb\xe4\xe1\xeb\x68\xb8\xfd\x9a\x15\xf9\xd9\x68\x72\x7a\x61\x33\x4b\x1e\x1d\x7d\x96\xdd\xd7\x55\x72\xc3\x85\x1f\x65\x26\x5f\x2a\xc0\xfe\xbb\xf0\x7a\xdf\x85\x77\xf6\x4d\x78\x6d\xe5\x18\x5e\x4f\x1a\x06\x57\x56\x67\xe2\xbb\x8d\x02\x94\x7e\xa6\x05\x62\xa9\xf6\xb1\x97\x1d\x78\x9a\x7e\xae\x72\x22\x53\xcf\x2e\x58\xaa\xbe\x25\x24\xb2\xf2\xec\x80\xa5\xec\x5b\x38\x91\xa5\xe7\x1e\x99\x8a\xbe\x29\x24\xb2\xf0\xec\xf1\x41\x0a\x7b\x5a\x29\xe4\xcf\x54\x25\x7f\x66\x2a\x36\x5c\xa6\x2a\x35\x5c\x5c\x15\x1b\x2e\x13\x95\x1a\x2e\x8e\x8a\x0d\x97\xb1\x8a\x0d\x17\x5b\x85\x86\xcb\x48\xa5\x86\x8b\xa5\x9e\x36\x5c\x16\xe1\x82\xdd\xa8\xb4\x60\xb7\x2a\x2e\xd8\x8d\x4a\x0b\x76\xad\xe2\x82\x5d\xa9\xb4\x60\x3d\x15\x17\xec\x52\xc5\x05\xbb\x50\x61\xc1\xce\x55\x5a\xb0\x33\xf5\x92\x05\xbb\x8f\x36\x15\x87\x2f\xfb\xd4\x9f\x47\xaa\x24\x0f\x85\xd9\x79\xa4\x9c\x28\x85\xab\x17\x9e\xc2\xe0\x4f\x88\x8c\x19\xfe\xbf\x23\x2f\x86\xf1\x8a\x7d\x3d\x88\x91\x1f\xf8\x35\x1b\x5f\x77\xfc\x7b\x55\x84\x65\x77\xfc\xaa\xc8\xde\x4b\x9f\x66\xcf\xde\x7b\xf6\xcc\x4b\x3f\x60\xb9\x1b\xc8\xcd\x12\x27\xb7\x12\x27\xb7\x12\x27\xb7\xbe\x99\xdc\x4a\x9c\x3c\xfc\x2a\x17\x5e\x5a\x89\x70\xac\x23\xbd\x19\x7d\x39\x01\xff\x3c\x1c\xb3\xda\xe5\x27\xe0\xf2\x6b\x2c\x3d\x4e\x62\x84\x9c\xc4\x08\x39\xdf\x44\xc8\x49\x8c\x90\x13\xa6\xa7\xf4\xda\xa7\xd9\xc7\x6f\x77\x9e\xdd\x7e\xeb\xf3\x13\xef\xf2\xed\xce\x70\xb8\x6f\xc9\x70\xf0\x13\xee\xe6\x4b\x5f\xed\xe7\xe1\xc8\x5d\xd1\x57\x9b\xbf\xdf\x79\xed\xce\xde\xfb\x34\xfb\xee\xde\xb3\x6f\xdf\xfb\xbc\x87\x56\x7c\xbf\x33\x1c\x99\xf7\x64\x38\x78\xef\xac\xfa\x7c\x7b\x38\x1c\xf5\xf2\x9d\x4a\xfe\xf7\x9d\xd7\x6e\xee\x77\x1f\xf6\x49\xf7\x9e\x3d\xf5\xbb\xcf\x77\x21\xd3\x8f\x3b\xc3\x61\x7f\x24\xc3\xc1\xf7\x1c\xcb\x2f\xf7\x86\x3f\x0f\xc7\xfe\x65\x28\xa4\xce\xdf\x1b\x96\xa2\x7b\xba\x89\x46\xdb\x46\x57\xc3\x46\xc6\x44\xa3\x46\x86\xa3\x61\x23\x63\xac\x51\x23\xc3\xd6\xb0\x91\x31\xd2\xb0\x91\x61\x69\xd0\xc8\x48\x7f\x50\x23\x23\xf5\x71\xda\xc8\xe0\xfe\xac\xc0\x9f\xb5\x86\xe7\xe0\x95\x46\xe7\x60\x4f\xc3\x73\xf0\x52\xa3\x73\xf0\x42\xc3\x73\xf0\x5c\xc3\x73\xf0\x4c\x83\x73\xf0\x14\x3a\xbe\xae\x76\x7a\x0e\xce\x84\xfb\x92\xac\x46\xdb\xd8\x9c\x86\xad\xc3\xac\x46\xad\xc3\x8c\x86\xad\xc3\x83\x46\xad\xc3\xbd\x86\xad\xc3\x9d\x86\xad\xc3\xad\x06\xad\xc3\x8d\x46\xad\xc3\xb5\x46\xad\x43\x95\x49\xc5\xe4\x6d\xec\x36\xba\x37\x2c\xbe\xde\x1e\x29\xeb\xed\xf2\x93\xe7\xa1\x0e\xbd\xb1\x3a\x9e\x44\x0e\x75\x3a\x89\xec\xeb\x78\x12\xd9\xd5\xe9\x24\xb2\xad\xe3\x49\x64\x53\xc7\x93\xc8\xba\x0e\x27\x91\x55\x9d\x4e\x22\x5e\xfd\xf4\x24\xc2\xfd\x29\x83\x3f\x95\x3a\x22\x5e\xae\x13\xe2\xa5\x3a\x22\x5e\xac\x13\xe2\x85\x3a\x22\x9e\xaf\x23\xe2\xb9\x3a\x20\x9e\xad\x13\xe2\x99\xfa\x29\xe2\xd1\xd9\x78\xdc\x80\xde\x58\x23\xd6\x8d\x6e\x40\x37\xba\x11\xeb\x46\x37\xa0\x1b\xdd\x88\x75\xa3\xeb\xb1\x6e\x74\x1d\xbb\xd1\x75\xe8\x46\xd7\x2f\x41\x8a\x1f\xb6\xa7\x5f\x5e\xe7\xfc\x3c\x52\xeb\xf7\x61\x70\x56\x3b\x83\x94\x1b\xa5\x70\xd4\xa4\x14\xda\x4d\x4c\xe1\xa8\x49\x29\xb4\x9a\x98\xc2\x74\x83\x52\x98\x6a\x60\x0a\xab\x0d\x4c\x61\xa5\x01\x29\x2c\x37\x28\x85\xa5\xc6\x69\x0a\xb9\x3f\x73\xf0\x67\xd1\x44\x95\x9a\x37\x49\xa5\x66\x4d\x54\xa9\x69\x93\x54\xca\x8d\x5d\x4a\x4d\x9a\xa8\x52\x4e\x13\x54\x6a\xdc\x24\x95\xb2\x9b\xa7\x2a\x65\x85\x65\x6e\xd7\x24\xa4\xf6\x4d\x44\x6a\xd7\x24\xa4\xb6\x4d\x44\x6a\xd3\x24\xa4\xd6\x4d\x44\x6a\xd5\x44\xa4\xbc\x26\x20\xb5\x6c\x12\x52\x8b\xe6\x25\x48\x65\x22\xa4\xb2\x5f\x1e\xd4\xfd\x3c\x52\x95\xdf\xc3\xc0\xa3\x33\x48\xad\xa3\x14\x6e\x74\x4a\xe1\x56\x47\x95\xda\xe8\xa4\x52\x6b\x1d\x55\x6a\xa5\x93\x4a\x79\x3a\xaa\xd4\x52\x47\x95\x5a\xe8\xa0\x52\x73\x9d\x54\x6a\xa6\x9f\xaa\x14\xf7\x27\x0f\xfe\x14\x74\x44\x3c\xaf\x13\xe2\x39\x1d\x11\xcf\xea\x84\x78\x46\x47\xc4\x0f\x3a\x22\xbe\xd7\x01\xf1\x9d\x4e\x88\x6f\xf5\xa4\x0b\x72\x1f\xa9\xb4\x4e\x48\x59\x9f\x88\x54\x5a\x27\xa4\x52\x3a\x22\x55\xd5\x09\xa9\x8a\x8e\x48\x95\x75\x44\xaa\xa4\x03\x52\x45\x9d\x90\x2a\xe8\x97\x20\xe5\x45\x7b\xa9\xf1\x97\x37\xb0\x3f\x8f\xd4\x42\xbb\xbc\xf0\x55\xe1\x51\x41\xea\x13\x55\xa1\x0a\xcf\x0a\x2a\xb1\x8b\xea\xf2\x27\xa9\x42\xe9\x13\x55\xa1\xf8\x89\xaa\x50\xf8\x04\x55\xc8\x7f\x92\x2a\xe4\x3e\x93\x5e\x17\x1c\xf7\x76\x70\x6b\xee\xb6\xb0\x87\x3f\x69\x51\x0f\xdf\x69\x61\x0f\x7f\xdc\xa2\x1e\xbe\xdd\xc2\x1e\xfe\xa8\x85\x3d\x7c\xab\x05\x3d\xfc\xf4\x27\xf5\xf0\x53\x9f\xa7\x3d\xfc\xa8\xf0\xad\x5a\x84\xd4\xba\x85\x48\xad\x5a\x84\x94\xd7\x42\xa4\x96\x2d\x42\x6a\xd1\x42\xa4\xe6\x2d\x44\x6a\xd6\x02\xa4\xa6\x2d\x42\xca\x6d\x5d\x55\xf8\x76\x5f\x9e\xad\xff\x3c\x52\x85\xfa\x30\xf8\x65\xc3\x19\xa4\x16\xfc\x1a\xc6\x80\x6b\x18\x03\x55\x61\x69\x90\x2a\x2c\x0c\x54\x85\xb9\x41\xaa\x30\x33\x50\x15\xa6\x06\xaa\x82\x6b\x80\x2a\x4c\x0c\x52\x05\xc7\x38\x55\x05\xee\xcf\x01\xfc\xc9\xc4\x5e\x62\x1c\x0c\x42\x7c\x6f\x20\xe2\x3b\x78\x3b\xb3\x35\x10\xf1\x8d\x81\x88\xaf\x0d\x40\x7c\x65\x10\xe2\x9e\x71\x8a\xb8\x13\xaa\x54\xd9\x20\xa4\x2a\x06\x22\x55\x36\x08\xa9\x92\x81\x48\x15\x0d\x42\xaa\x60\x20\x52\x79\x03\x91\xca\x19\x80\x54\xd6\x20\xa4\x32\xc6\x25\x48\x59\x91\x4a\xa5\x1b\xb7\x47\xca\x6d\x5e\x5e\xf8\x8a\x6d\x4a\x61\xa9\x8d\x29\x2c\xb6\x29\x85\x85\x36\xa6\x30\xdf\xa6\x14\xe6\x62\xef\x9f\xb2\x6d\x4c\x61\xa6\x0d\x29\x3c\xb4\x29\x85\xfb\xf6\x69\x0a\xb9\x3f\xa3\x0e\xec\xed\x3a\xa8\x52\xa3\x0e\xa9\x94\xd5\x41\x95\x4a\xb7\x49\xa5\x52\x6d\x54\xa9\x6a\x1b\x55\xaa\xd2\x06\x95\x2a\xc3\xeb\x9a\x52\xfb\x54\xa5\xa2\xc2\x37\xef\x10\x52\x8b\x0e\x22\x35\xef\x10\x52\xb3\x0e\x22\x35\xed\x10\x52\x6e\x07\x91\x9a\x74\x10\x29\xa7\x03\x48\x8d\x3b\x84\x94\xdd\xb9\xaa\xf0\xad\xf4\xdb\x23\x95\xd1\x87\x81\x06\x9c\x7b\x7c\xcc\xef\x9e\x4c\xb8\x7b\x32\x51\xa5\xa6\x26\xa9\x94\x6b\xa2\x4a\x4d\x4c\x52\x29\xc7\x44\x95\x1a\x9b\xa8\x52\xb6\x09\x2a\x35\x32\x49\xa5\x2c\xf3\x54\xa5\xb8\x3f\x1b\xf0\x67\x6b\x22\xe2\x1b\x93\x10\x5f\x9b\x88\xf8\xca\x24\xc4\x3d\x13\x11\x5f\x9a\x88\xf8\x02\x1f\xf9\xcd\x4d\x42\x7c\x66\x9e\x22\xbe\x0d\x91\xca\x9b\x84\x54\xc1\x44\xa4\xf2\x26\x21\x95\x33\x11\xa9\xac\x49\x48\x65\x4c\x44\xea\x60\x22\x52\x7b\x13\x90\xda\x99\x84\xd4\xd6\xbc\x04\x29\x27\xba\xbc\x2e\x7f\xde\x1e\x29\xbb\x75\xf9\x7b\xf6\x62\xff\xca\xeb\xeb\x54\x3f\x0b\xbf\xd7\xaa\x76\x61\x2b\xd6\xb5\xc5\xa0\x1f\x96\x3a\x5a\xaa\x32\xdf\x8a\x75\x5d\xb0\x94\x7d\x4b\xb4\x15\xeb\x3a\x60\x29\xfa\x16\xda\x8a\x75\x7b\x64\xca\xfb\xa6\x68\x2b\xd6\xf5\xf8\x20\x85\x3d\xad\x64\xfe\xfc\x7c\x3e\xb8\xf2\x3e\x7c\x31\xf8\xe6\x3e\x9c\xbf\x16\x9f\x1b\xb7\x4f\xd8\xd6\xb8\xfc\x07\x60\x63\x78\xdb\xeb\xf4\x30\xe2\xe3\x1e\x45\xdc\xee\x61\xc4\x47\x3d\x8a\xb8\xd5\xc3\x88\xa7\xff\x60\xc4\x53\x7f\x20\xe2\xd5\x3f\x14\xf1\xca\x9f\xd3\x88\x73\x7f\x36\xc3\x2b\x1f\x30\x64\x86\x59\xfa\x45\x59\x7e\x78\x65\xc2\x0a\xc3\x6f\x12\xc6\x7f\x51\x96\xff\xf2\x3e\xe4\xe7\x13\x96\x6a\x0f\x85\xdc\xf9\x15\xb6\x8f\x02\xb4\xeb\xc7\x1a\xf3\x83\xa4\xd6\xf8\x20\xa9\x33\x3e\x48\x6e\x8c\x0f\x92\xfa\xe2\x83\xf0\xc2\xa6\xdf\xa7\x89\x8b\xf1\x89\x7b\x49\x13\xf7\x92\x26\xee\x25\x4f\xdc\x4b\x9a\xb8\x77\x9c\xb8\xd0\xef\x07\x97\x68\x7e\x4e\x47\x83\xd8\x65\x40\x27\x69\xde\x4e\xd2\xbc\x9d\xe4\x79\x3b\x49\xf3\x1e\x3f\xca\x95\x7e\xf2\x3d\x40\x21\xfa\xfd\xf1\xc4\xbc\xc3\x73\x16\xf3\xf2\x7b\x80\xf4\xe0\x7e\x49\x49\x0d\xfa\x34\xf1\x74\x78\x3f\x0c\xdd\x61\x9f\x77\xf6\x37\xc3\xfb\xd1\xb0\x18\x26\xd3\xc0\x5b\xf8\x87\x2f\x8f\x11\x6f\xf0\x12\xa5\x3b\x14\xf6\xe7\x69\x58\xf3\x5b\x18\x4b\x84\x6b\x18\x4b\x8c\x1d\xd6\x2d\x11\x4e\xeb\x96\x18\x3b\xae\x07\xb6\xe8\xbc\x6e\x89\xb1\x03\xbb\x15\x7b\x38\x3d\xb3\xf0\xe5\xf4\xd4\x82\xa7\xd3\xae\xf5\xe5\xed\x34\xf7\x2c\x8b\x9e\xe5\x02\xcf\xf8\x73\xe7\xac\x25\xd2\xf3\xe9\x4c\xe0\x19\xb7\x1d\x02\x5b\x74\x2b\x13\x78\xc6\x6d\xbb\xc0\x46\xf7\x32\xbe\x67\xdc\xb8\x09\x8c\xd1\xcd\x4c\xe0\x59\xec\x15\xf5\x2c\x7a\xc1\x62\xc1\xbb\xfc\x94\x15\x7b\x98\x5f\xb5\xe0\x65\x7e\xc5\x8a\x3d\xcd\x2f\x5b\xf0\x36\xbf\x64\xc5\x1e\xe7\x17\xad\xd8\xeb\xfc\x42\xec\x79\x7e\x3e\x30\x46\x5b\x8f\xc0\xb1\x7f\x7d\xfa\xe4\x45\xf2\x33\xea\xdd\x18\xb8\x07\xe1\xd7\x83\x37\x5b\x2f\x7b\x03\xef\xe1\x9f\xbf\xfe\xf7\xe1\xef\xbf\xd3\x5e\xd7\xf3\xd2\x13\xeb\x7f\x56\xcb\xee\xd4\xb3\x57\xf6\x6c\xea\xfd\xed\xf5\x3c\xef\x41\x40\xab\x6b\xef\xec\xc8\xf0\x7f\xc2\xaf\x87\x69\xd7\x3d\xfe\x09\xff\x3f\x86\xf6\x64\xf0\xf0\xcf\x5f\x0f\x27\x7f\xc5\xff\x7f\x7f\xfd\xe7\xd7\x7f\x03\x00\x00\xff\xff\x4a\xcb\xd8\x61\xee\x42\x00\x00"),
-		},
-		"/css/lg-transitions.min.css": &vfsgen۰CompressedFileInfo{
-			name:             "lg-transitions.min.css",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 39108,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x61\x4f\xe3\x48\x0c\xfd\x2b\xfd\x72\x12\x2b\x65\xa2\xa4\x88\xbd\x3b\xf6\xd7\x94\x12\xb8\x88\xd2\xa0\x24\x85\x5b\x56\xf7\xdf\x4f\x4d\xbb\xb4\x49\x66\xec\x67\x27\x53\x21\x64\xa9\x2c\x0b\xe3\x97\x49\xde\xf8\xbd\x71\x4c\x09\xe9\xe6\xd1\xad\x9b\xe6\x7a\xff\xf9\xbd\xaa\x9e\x5d\xb9\x5d\xec\xff\x5f\xb6\xc5\xf3\xaf\xea\x65\xb5\x2e\xdb\x9f\xb7\xd9\x7f\x54\xd8\xfe\xf3\xb6\xf8\xb7\x75\xcd\xa6\xbc\x2f\x12\x2e\xf4\xa5\x2e\x5e\x0f\xa1\xbf\xdc\x5b\x71\xf7\x54\xb6\xae\xad\x57\xdb\xe6\xa1\xaa\x9f\x6f\x9b\xf5\x6a\x53\x5c\xdf\x5f\xe5\xe9\x75\x72\xfc\xf8\xf6\x83\x1e\x66\xcf\x6d\xbd\xab\xeb\x62\xdb\x52\xb3\x25\x79\x92\x7b\xe7\x39\x0c\xfc\xe6\x21\x47\xe7\x62\x49\x98\x81\xaf\xb2\x2d\xab\xed\xed\xe8\xa2\x16\x79\xb3\x58\xef\xee\xca\xb5\xbb\x2b\xde\xcb\xa2\xbe\xca\x92\x2c\x49\x97\x37\x49\xfe\x6d\x91\x35\xc9\xf1\x52\xf6\x51\xc5\xaa\x29\x16\x59\xf3\xc3\x3d\x57\xef\xbd\x43\x7e\x7c\xad\x3c\x5e\xd5\x3b\x5a\x35\xe5\x58\x67\x07\xd2\x1f\xc5\xb7\x6a\xee\xae\x7c\x04\x13\xbd\x17\xca\x2f\xde\x28\x1c\x4a\xf8\x65\xb2\x4c\x96\xbe\x14\x3c\x0c\x40\xe7\x15\x39\xd1\x43\xf3\x41\x24\xcc\xc4\x99\x25\xbd\x3e\xe9\xab\x5d\x0b\x65\xfc\x79\x1c\xb7\x74\xc3\x58\x28\xd7\xd3\x3f\x93\xee\xe5\x4b\xc4\x8f\x31\xfe\xbc\xa2\xa6\x7b\x60\x32\x9e\x81\x39\xd8\xb2\x2c\x9f\x94\xe5\xb0\xb7\x0f\x63\x81\xf5\xd3\xb9\xfb\xfe\x32\x32\x5f\x22\x1e\x06\xb0\x33\x8b\x9d\xef\xb8\xbf\xc7\xe4\xcd\x72\x7f\x5a\xee\x83\xf5\xfb\x20\x34\x6e\x26\x07\x8b\xdf\x39\x0b\x22\xcf\x44\xd1\x15\x83\x56\xff\x24\x09\x50\xb8\xc9\x25\xca\x5d\x00\x5a\x13\x0d\x42\xa3\x96\xf5\xe1\x8a\x62\x4e\x5d\x7a\x26\x8a\x7d\xff\x00\xd7\x54\x24\x09\x50\xb8\xc9\x65\x56\xb9\x34\xd5\x43\xdb\x71\xcd\x89\x65\x1c\x08\x4b\x25\x4f\xf3\xe4\xf8\xe1\x6f\xf9\x9c\x86\x81\x29\x21\xd1\xa4\x7f\x27\xdd\xcb\x7b\x23\xf2\x7b\x0c\x98\x2c\x96\x70\xc8\xd9\x12\x09\x09\x40\xb0\x49\x66\x5e\xc9\xec\x17\xd8\xed\x5e\x58\xc5\x0c\xe3\xa8\x85\xf3\xc5\x9e\xaf\x5b\x8f\xd1\x53\x2a\xff\x95\x74\xaf\x6f\x8b\x6e\x6c\xb3\x6a\x0f\x1b\x43\x9e\xfd\xb1\xdf\x33\xce\x99\x83\x31\xcf\x8d\x02\x14\x16\x22\x0d\x94\x02\x78\x82\x3f\x14\xeb\x67\xec\xa0\xca\xc1\xb1\xb3\x20\x59\x44\xb8\x97\x27\x22\x9e\x71\x10\x1f\x46\x12\x1b\xf0\x19\x82\x22\x3e\x01\xb5\xc9\x6a\x26\x33\xdd\x64\xf6\x4c\xba\x75\x59\xaf\x77\x9b\x55\xcd\x5a\x8d\x3f\x1a\x33\x91\x43\x02\xf5\x72\xca\xe5\xd9\x5e\x6e\x41\x59\x30\x10\xaf\x34\x18\x0c\x53\xff\x86\x70\xc2\x78\x94\xb5\xf3\x22\x03\x66\x4d\x4e\x9a\x82\x33\x25\x65\x42\xc6\xc4\x84\x99\xed\xca\x88\x4a\xc4\x89\x88\x22\xcc\x88\x23\x1a\x31\x52\xf6\x05\x01\x13\xed\xf8\xe8\x62\x52\x4b\x3e\xc1\x64\xb6\x7c\xc2\xc9\xad\xf9\x03\xab\xc0\x08\xc8\xd4\xbb\xb4\x98\x4b\x25\x95\x13\x98\x54\x10\xa9\xe5\xd1\xcc\x5b\xcc\x15\xe1\xc6\x68\x15\x0d\xfb\x84\xb9\xf8\xdc\x2e\x7e\x5f\xbd\xb1\x3f\x4c\xa1\x20\x13\x9d\x5c\x67\xe4\x4a\x1f\x9f\x60\xe3\x0a\x17\x07\xcc\x67\xc4\xa4\xde\xc6\x55\x2e\xae\x33\x71\xbd\x87\xcb\x2d\x5c\x43\xa2\x79\xb8\x82\x2d\xca\x90\x89\x34\x15\xc1\xcc\xc9\x63\x3a\xf9\x6b\x51\xb7\xe5\x7a\xb5\x11\xba\xf9\x08\xa6\x76\xf4\x4c\x5e\x4b\x66\x8a\x42\x32\x53\x56\x91\x99\xb0\x84\xcc\xa0\xfa\xd1\x4b\x9f\xca\xc6\x33\xb1\x87\x67\x72\x03\xcf\x74\xee\x9d\xc9\xac\x3b\x43\x7c\xdb\xcb\x9c\x79\xb7\x92\x31\xca\x88\x99\x1c\x15\x43\xcd\xc7\x2f\xe1\xe3\x6e\x53\x3c\xb0\x6f\xdc\x80\xb0\xd6\x6d\x99\xda\x6d\x09\xf3\xaa\xb2\x7a\xbb\xf7\xa1\x28\xb5\x3d\x60\x0a\x6d\x88\x9b\x53\x69\xac\xc3\xdb\x96\x70\x91\x2d\x41\xd1\xad\xf1\x63\xd5\x5b\x82\xf5\x8c\x29\x4a\xad\x7f\x23\xef\xdf\x84\xd9\xb4\x8d\x60\x0a\x6d\x90\x91\x2b\x5b\x3b\x98\xa9\xd8\x46\x30\xd3\x46\x20\x6b\xed\x88\xee\xd9\xba\x94\x64\xdb\x24\x70\x5f\x84\x6d\x87\x30\x73\x73\x53\x0b\x67\x0e\xbf\x69\x95\x94\xa8\x50\x93\xc2\xfb\x72\xf9\xed\xf8\x05\xef\xc2\xd3\xb9\xa5\x96\xce\xa9\xb5\x74\x1e\xb1\xa5\x90\xda\xdc\x63\x5d\xbd\xb5\xff\xc8\x44\x37\x04\x61\x85\x56\x7a\x93\x74\xaf\x71\x07\xf2\x86\x7a\xff\x2a\x8b\xf2\xbf\x83\x95\x85\x85\xdf\xc3\xca\x41\xe5\x10\x21\x97\x7c\x85\x15\x9a\x50\x45\xa5\x8e\x49\x3d\x91\x72\x1e\x75\x34\x5a\x69\xa5\xe2\x8b\xf0\x66\x3a\x59\x85\x40\xf3\xf6\x48\xde\xde\x3c\x15\x6f\xae\xda\x6e\x7e\x62\xae\x3e\x0e\x67\x97\xd5\x0f\x21\xb6\x80\xa7\xe2\xed\x2a\xcf\xee\x8b\xc7\x64\xff\xcf\x50\x61\xa3\xd1\xbe\xa0\x46\xc3\x63\xfd\x0c\x43\xc2\x43\x30\x01\x21\xf7\xd8\x1f\x8f\xbc\x12\xfa\x42\xf8\xeb\x08\x5f\xc6\xd9\x08\xa5\x71\xf2\x7a\xe0\xd5\x9c\x98\x00\xa6\xe8\x18\x8a\x76\x75\xf1\x2a\x54\x75\x0f\x82\x2f\xec\x08\xc6\xa8\xdb\xd1\xf2\x76\x8c\xbe\x1d\x20\x70\x47\x28\xdc\x81\x12\x1f\x5d\xd6\x17\x90\x79\xe8\x9a\x44\xcb\x3b\x43\x56\x98\xe4\xa3\x48\x5e\xba\x8d\x3b\x8d\x8f\x3b\xd1\x56\xde\x65\x68\x4e\x2a\x23\xa7\xa5\x91\xf3\xda\xc8\xc3\xe2\xc8\x79\x9d\x7f\xb9\xcd\xdc\x7f\x45\x82\x35\x9d\x9c\x08\xa6\xef\x38\xfa\xd6\x6c\xea\x6a\x03\x17\x6f\xec\x5d\x9e\x3a\x5a\xec\x8e\x51\xbb\x03\xe4\xee\x08\xbd\x3b\x44\xf0\x5f\x72\x6b\xd7\x6d\xee\x91\xb2\xc3\x0c\x60\x66\x03\xc0\x55\x2f\x51\xec\xf2\x94\x65\xd8\xaf\xf9\xa2\xa0\xb1\x16\x10\x94\x5f\x23\x00\x52\x8c\x40\xb8\x23\x7a\xab\xd4\x24\x1a\xea\x54\xcc\xa9\x89\x13\xf3\x26\xa2\x0d\xf3\x52\xbe\x99\x0a\xc4\x53\x8e\x0b\xb6\x53\x79\x90\x28\x98\xb3\x6a\x81\x3f\xcb\x4c\xd9\x9c\xf8\x62\xa5\x98\xac\x06\x93\x36\x47\x54\x96\x0c\xa1\x3c\xad\x14\xbd\x29\x23\x50\x39\x04\x25\x91\xb1\xe6\xe0\x4c\x2a\x0e\x75\x14\xea\x19\x94\x13\x28\xe6\xcf\x3c\x9a\x2a\xa7\x85\x75\xb4\xbc\x80\xb6\xca\xf9\x92\x7e\xbd\xae\xab\xa6\x11\x38\x76\x3f\x1e\xbd\xef\xfd\x2e\xb4\x6c\x04\x14\xd0\x0e\x83\x22\x04\x44\x23\xc5\x08\x9c\x41\xc6\xb0\x43\x53\x69\x08\x54\xf1\xa7\xa6\x4f\xcc\x9e\x82\x3c\x73\x6b\xca\xad\x83\x7c\x85\xdc\x97\xca\x4e\x1c\x63\x9e\x1d\xdd\xb3\x85\x95\xf6\x18\x03\xf7\x2c\x55\xe6\xcd\xa1\x42\x1d\x4e\xbd\x7d\x33\x50\x39\x44\x46\x25\x62\xe2\xbe\xf9\x54\x4c\xea\x88\xd4\xf3\x28\xa7\x51\xc9\xa2\xb9\x39\xeb\xe6\xd2\x0a\x9c\x4b\x57\x19\xce\x9c\x3d\xa6\xb3\xbf\x16\xe0\x43\x2b\x47\xd1\x80\x9b\x7f\x0f\x66\x5f\xe0\x77\xb4\x50\xd0\x58\x49\x08\xca\x2f\x27\x00\x29\x46\xa0\xfc\x31\x16\x1e\x9e\x48\x43\x9f\x8a\x3d\x35\x79\x62\xee\x84\xd4\x99\x6f\x53\xbe\x1d\x60\x2b\xe4\xbc\xe1\xbc\x44\x11\xe6\xd2\x91\x5d\x5a\x58\x7d\x0f\x11\x48\xaf\x5b\x65\xd7\x10\xca\xd3\xa8\xd5\x1b\x36\x02\x95\x43\x24\x44\x72\xfd\x6e\x8d\x6f\x23\x20\x11\x8d\x7a\x16\xe5\x24\x2a\x38\x34\xff\x66\xfc\x5b\x5a\x75\xd3\x89\x2a\x41\x99\x97\xc7\xf6\x72\x69\x0f\x7c\x8c\x41\x7b\x29\x4b\xa1\x9d\x23\xa0\x80\x9e\x18\x14\x21\x2a\x1a\x29\x46\xc8\x58\x44\xda\x28\xde\xe9\x34\x24\xaa\x38\x54\x53\x28\x66\x50\x45\xa0\x39\x39\xe7\xe4\xe2\x9e\x38\x97\xa9\x32\x9c\xf9\xf9\x45\xfc\x5c\x51\xa1\x4f\xe8\x91\xab\x8c\x9d\x43\x85\x5a\xbb\x7a\x6b\x67\xa0\x72\x88\x9c\x4e\xa8\x4f\xae\x71\x78\x06\x24\x22\x53\xcf\xa5\x9c\x4a\x35\x93\xe6\xf4\x90\xd3\x6b\x2a\x77\x6d\xcf\x1c\x73\x11\x73\xfd\x59\x5c\x7f\x53\x6d\x36\xe5\x4b\xc5\xfe\x69\x91\x51\x1c\xb5\x1e\x5d\xfe\x9d\xe7\x9b\xf7\x47\x88\xfe\x00\x7e\xd6\xb0\xf9\x8d\x53\x7c\xd1\x3d\xa1\xe0\x2a\xbd\x19\xe8\x95\x8e\xec\x29\x95\x0e\x05\x9e\x73\x73\x16\x8e\x85\xf1\x14\xc4\x7e\xcc\x0e\x35\x69\x22\x58\x20\x3e\xd6\x24\x1d\x4d\xd2\x48\xf1\xe6\x8d\xa5\x0b\xb6\xaf\x2c\x32\xb2\xd0\x22\xcf\x82\x33\x38\xda\xdf\xc2\x65\x49\x64\x81\x43\xfb\x3a\xc0\x0e\x16\x6f\x62\x9f\x5d\xec\x75\xd5\xae\xda\x82\x93\xf9\x20\x8a\x16\xf8\x21\xf8\xca\x5d\x1f\xde\x91\xd2\xd7\xf4\x68\xb0\x27\xe3\xd1\xe8\x28\x7d\x87\x11\xc1\x11\xee\xfc\xc3\x55\xc0\xf1\x40\xd4\xe9\x93\x67\xcf\x9e\x7c\xf0\xdc\xc1\x53\x0f\xdc\x73\x1c\x0f\x32\xbc\xb5\x38\xfb\xb6\xef\x6c\xbd\x37\x0a\xa7\x31\xdf\xf7\xfc\x96\x10\x3e\xd1\x04\x5e\x0a\x2e\xd2\x0c\x20\x92\x01\x20\x7b\xbd\x27\x12\x32\x82\x4f\x25\x24\x41\x2b\xe4\xd3\xfa\x18\xda\x84\xf8\x14\x86\x00\x55\x08\xec\xda\x20\xd1\x66\x0e\xb3\x9b\x43\xbb\xbb\x63\x6b\x83\x5e\x0c\x6d\x08\xa7\xc7\xfd\x65\xc3\xc7\xfd\x71\x7f\xb6\x99\x81\x04\x9e\x5a\x48\x62\x88\x27\x17\x52\x38\x61\x3c\xcd\x15\xff\x74\x4c\xcf\xc1\xe5\x54\x29\x98\x52\x12\x25\xe4\x09\xa4\xc9\x9e\x7e\x89\xd0\x93\x80\xa9\x46\xc7\x99\x8d\xce\x62\xa3\xff\x07\x00\x00\xff\xff\xdf\x55\xff\x9e\xc4\x98\x00\x00"),
-		},
-		"/css/lightgallery.css": &vfsgen۰CompressedFileInfo{
-			name:             "lightgallery.css",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 24604,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3c\xdf\x73\xdb\x38\x73\xef\xfe\x2b\x50\x65\x6e\xbe\x38\x23\x2a\x94\x64\xc6\xb6\x3c\xed\xe5\x3a\xbd\x4c\x9f\xda\x99\xf6\xb5\x2f\x10\x09\x51\x68\x48\x82\x43\x82\xb2\xec\x8e\xff\xf7\x0e\x40\x80\x04\x40\x00\x84\x6c\xc7\x93\xef\xbb\xbb\x84\xe4\x62\xb1\x58\xec\x2e\xf6\x17\xf4\xfd\x40\x2a\x1a\x1d\x60\x8a\xc0\xff\x5d\x01\x20\x9e\x4a\x5c\x3c\xed\xc0\x3f\x8a\xfc\x1f\x0f\x57\x00\xb4\x4d\xba\x03\x5d\x53\x7c\x5e\xac\x56\x5f\x19\x44\xfb\xb5\xc8\x57\x88\xd0\x3f\xab\xf5\xf3\xf6\x76\xbb\xb8\xf6\x43\x7d\xc2\xe8\x80\xcf\x12\x16\x1c\x48\x53\x42\xfa\x79\x81\xca\x3d\xca\x32\x94\x45\xa4\x46\x15\x7d\xaa\xd1\xe2\x7a\x39\x45\xf0\x48\x0e\x87\x3f\x27\x63\xd9\x5b\x2b\x38\xa5\x16\x68\xda\x74\xc8\x39\x41\x7b\xca\xc5\x88\x4f\x45\xae\x0c\x6a\x4f\x79\xbf\x30\xce\x93\x47\x84\xf3\x23\xdd\x81\x8a\x7d\x2d\x86\xd7\x2d\x7d\x2a\xd0\xf8\xf6\xe5\x6a\x55\xe4\x11\x4e\x49\xe5\xe1\x66\x8d\xe0\x4f\x36\xa4\x42\x2e\x34\xfe\x39\x4f\xb0\xc1\xb0\xd2\xde\x53\x74\xa6\x11\x6d\x60\xd5\x32\xea\x47\xe4\x05\xae\x50\x74\x14\x68\xd6\xec\xcd\xd7\x2f\xe0\x5f\x11\xa5\xa8\x01\x3f\x48\x45\xc1\x7f\xa1\x2a\x43\x0d\xae\x72\xf0\xcf\xe3\xff\xc0\x97\xaf\x57\x00\x44\x8f\x68\xff\x13\xd3\xa8\x27\xb0\x24\x84\x1e\x71\x95\xef\x00\xac\x28\x86\x05\x86\x2d\xca\x18\xc2\xa8\x24\xcf\x11\x69\xcf\x13\xb8\xbc\x81\x4f\x6d\x0a\x0b\xc4\xd8\xc2\xf9\x02\x53\x8a\x49\xd5\x02\xf6\xf7\x0a\x9d\xe9\x12\x98\x6f\xeb\x06\x9d\x38\xe7\xf6\x30\xfd\x99\x37\xa4\xab\xb2\x28\x25\x05\x69\x76\xa0\xc9\xf7\xf0\x73\xbc\x04\xe2\x9f\xd5\x4d\xc2\x37\x67\x4f\x9a\x0c\x35\x51\x03\x33\xdc\xb5\x3b\xb0\xa9\xcf\xec\xad\x18\xf3\xe9\xfe\xfe\x9e\x3f\x76\x4d\xcb\x9e\x6b\x82\x2b\x8a\x1a\xf6\x2a\xc3\x6d\x5d\xc0\xa7\x1d\xd8\x17\x24\xfd\x39\x6e\x04\x7e\x46\x3b\xb0\x11\x68\x4a\xd8\xe4\xb8\x8a\x28\xa9\x77\x20\x5a\xc7\xfd\xcb\x1a\x66\x19\x5f\xe0\x5d\x7d\x06\xec\x25\xb8\x17\x1f\x48\x8b\xd9\x52\x76\x00\xee\x5b\x52\x74\x94\x6f\x01\x1f\x9c\xc4\x7f\xb0\xbf\x3f\x47\xb8\xca\xd0\x79\x07\xd6\xf1\x5d\x2c\xa5\xc5\xe4\xca\x2a\xc3\x2d\xdc\x17\x28\xb3\xb3\x67\xf8\xcc\xf9\x24\x56\x14\xa1\x13\xaa\x68\x3b\x6e\x3c\xa9\x61\x8a\xe9\xd3\x0e\xc4\xab\xc4\x35\xd1\xee\x48\x4e\xa8\xb1\xcf\xd2\x7f\xe3\x53\x48\x5e\xfe\xf8\xf1\xc3\x85\x89\xc3\x35\xbd\x94\x6d\x38\x9b\x1c\x33\xee\xd1\x81\x34\x48\xa0\xad\x28\x62\x52\xbc\xf8\x1f\x14\xdf\x27\x0b\xdb\x98\x41\x1c\x0a\x74\xf0\xa0\xe6\x04\xc3\x03\x1d\x08\x56\x31\xdf\x70\xcc\x57\xdf\xa5\x38\xff\x44\x4f\x87\x06\x96\xa8\x05\x45\x1e\x71\x9a\x23\x54\xf5\xcc\x8c\xff\xe0\x7f\xc8\xe9\x62\xc6\xc8\x97\x2b\xc0\x76\x4f\xfb\x10\x6d\x85\x28\xb0\x8f\xeb\xd8\x3e\xec\xe5\xea\x3b\x57\x8d\x0f\x9d\xaf\xfd\xc8\xe9\x3e\x72\x65\xb6\xcd\x63\x40\x17\xcf\xf8\xea\xad\xfb\xb5\xb3\xb5\x1f\x37\xd9\x47\x4d\xc4\x34\x93\x74\x14\x35\x2b\x4d\x3c\xf8\xeb\xfd\xff\xa2\xb4\xb7\x1a\x72\x67\x61\x85\x4b\xd8\x5b\x4f\x0d\x3c\x5e\x6d\x5b\x7e\xcc\x90\x59\x90\xb9\xef\xa3\x7d\x6e\x50\x01\x29\x3e\xa1\x07\x93\xcc\x81\x1d\x41\x54\x0e\xd0\x4e\x22\x27\x10\x33\x9f\xed\x24\x72\x1a\x29\x21\xc5\x1e\xf6\x16\x4e\x3d\x47\x36\x0f\x57\x1a\xeb\x3d\xa7\x10\xff\xfe\x88\x33\x7a\xdc\xf1\x7d\x7b\x08\x3f\x68\x5f\x34\x1a\x34\x27\x67\xfe\xac\x3d\x14\x04\xd2\x5d\x7f\x40\x98\x27\xed\x4d\x2f\x4a\xd2\x47\xb9\xb9\xed\x9f\x35\xc7\x65\x73\x6b\x9c\xbc\xfc\xd4\x8d\x07\xc7\x07\x16\x38\xaf\x76\x20\x45\x72\x42\xb1\xc6\x44\xc8\x29\x07\xca\x50\x4a\x1a\xc1\x7a\x76\x4a\x82\x7f\xc2\x65\x4d\x1a\x0a\x2b\x4e\x13\xe9\x28\x9b\x73\x07\x4a\x94\xe1\xae\x1c\x0e\x52\xb9\xf3\xdc\xb9\x12\x8c\xe5\x2b\x06\xf1\x6a\xd3\x72\x3a\x61\x23\xf6\x7e\x16\x66\x06\xc0\xce\x64\xff\x51\xac\x42\xa7\x05\x69\x91\xeb\x20\xbc\x8d\x17\xb6\x31\x19\x79\xac\x0a\x02\x33\xd7\xb0\xc3\x66\x31\x88\x60\xdb\xed\xa3\x23\x2d\x8b\x4b\x3d\x34\x4a\x49\x29\xa4\x4f\xae\xe0\xef\xbf\xff\x36\x24\x61\xfd\x4d\xec\xbc\x22\xca\xda\x7e\xdf\xc4\xa6\x9b\x75\xc0\xe7\xde\x07\x15\xae\x87\x47\x22\xac\x9e\xd7\xb0\x9e\xe3\x0d\x5f\x52\xef\xee\x09\x3c\x2a\x69\xdb\x7e\x66\xcd\x29\xdf\x93\x22\x9b\x20\xaa\x47\xb7\x5f\x0c\xd5\x3c\xc9\x1d\x48\x98\xe0\x02\x4e\xc1\xd5\x27\xb6\x65\xa4\xab\xa8\xb1\xbb\x42\x91\x06\x0f\x15\x57\x5c\x1b\x6c\x8e\xaa\x64\x9a\xe0\x54\xa4\xfa\x49\xe3\x5b\xae\xfc\x92\x92\x13\x6a\x28\x4e\x61\x21\x79\x54\xe2\x2c\x2b\x26\x66\x66\x39\x38\x56\x4b\xdd\xcb\x1b\x1c\xcb\xb5\x4b\x3b\xb4\x77\x2c\x1c\x61\xd6\x2d\x69\x41\xda\xed\x71\x1a\xed\xd1\x33\x46\x8d\x14\x93\xd5\x26\x59\x82\xf5\x35\x88\xdb\xa5\xc4\x1c\x08\x6d\xd7\x41\x76\x6a\x6b\xb4\x0c\x2f\x3e\x98\x10\xdd\x18\xc8\xc7\x8f\x25\x42\xa5\xe0\xc3\xa7\x17\xe2\x74\xc4\x19\x8a\x30\x45\xa5\xe1\xd0\x8f\xf1\xc9\x44\x8c\xfa\x08\x96\xff\xb5\x80\x14\x6d\xb3\xcf\x3c\xf4\xea\x67\xbd\x1e\x16\x36\x07\xf6\x62\x9b\x7f\x2a\xc6\x21\xf3\x87\x4d\x3f\x3f\xbb\x7a\x84\x5f\x44\x40\xbc\x04\x72\x71\x3e\x0a\x74\xb0\x97\xab\xab\x3d\xc9\x9e\x76\x15\xa1\x9f\xd9\xec\x87\x86\x94\xd1\x11\xb6\xc7\x6b\xa0\xf9\x3c\x2d\x85\x0d\x8d\x9e\x09\x29\x5d\x5e\x8f\x32\x1d\x0f\xe8\xd9\x54\xab\x84\xc9\x40\xff\x1f\x93\x26\x37\x90\x67\xd5\x4e\xeb\xb1\x49\xe2\x32\x5c\x60\xe7\xa1\xf5\x73\x7f\xce\x64\xfc\xe2\xd9\x3d\x76\xe2\xd7\xce\x6c\x37\x0e\xbf\x78\xb5\xe6\xde\x46\xa4\xc1\xfd\xb1\x18\xff\x21\x73\x24\x3a\xff\xed\x10\xad\x1f\xc0\xf3\xf1\xe5\x55\x3a\xc1\x54\x98\x3b\x58\xa4\xac\x0b\x44\xd1\x05\x8a\xb2\x5e\x02\xf6\x8f\x4b\x45\xd4\xcf\xea\xd1\x2a\xcc\x27\x27\xa8\xb7\x1d\xc7\xae\xdc\x8b\x67\xbb\xfb\xf5\x29\xfe\xb7\xf8\xaf\xf8\xaf\x89\xcb\x65\x0f\x08\x8c\x30\xc0\xf0\x8f\x98\xc3\x72\x1e\xfc\xef\xad\x74\xa4\xe7\xcd\x14\xc3\x37\x6f\xa5\x54\xa8\x50\x17\x62\x33\x7b\x0e\x85\x79\x00\x81\x78\xbc\x07\x78\x18\x0e\xd7\xf9\x1b\x32\xfa\xc5\xbd\xfd\xec\x31\x6f\xe0\x5e\x79\xcf\xe4\xb3\x77\x21\x45\xf0\x25\x19\xc8\xe0\xd4\xa0\x8c\x73\x63\xf2\x92\x58\xe0\xda\xc9\xbb\xfe\x39\x80\xb0\x3d\xae\x72\x1f\x71\x25\x39\xa1\x07\x07\xb1\x6c\xac\x95\xe0\xc9\x07\xe2\x80\x6f\xad\xef\xc7\x77\x33\x0b\xc8\x1a\x98\xe7\xda\x02\xa6\x1a\xce\xf7\x34\xca\x3a\x19\x4e\xc6\xad\xd3\xb8\xba\xa1\x8c\xcc\x83\x20\xa3\x46\x95\x55\xdd\xe7\x35\x2f\x44\xef\x24\x8c\x95\x07\x7d\x3e\x79\x1a\x62\x0f\xe5\x03\x61\x2a\x44\x52\x5c\xda\x98\x28\x19\x93\xb2\x26\x57\x87\xad\x37\xb2\xf4\x89\xc8\xd2\x3b\x53\x05\x2c\x8e\xe1\x26\xf1\x84\x9a\x43\x41\x1e\x77\xe0\x88\xb3\x0c\x55\x36\x7a\x7a\xdc\x3c\xf5\x0f\x5a\x52\xe0\x4c\xc4\xc7\x93\x59\x6f\xb4\xa4\xbe\xa4\x5f\x90\xff\x9d\xc5\xfd\x10\x7c\x2e\x71\x15\x0d\xb6\x71\x93\xd4\xe7\x6b\xbe\x00\xef\xea\xec\x46\x4c\xcc\x3e\xf8\xc6\x49\x0b\x10\x6c\xb9\xe4\x4f\xec\x8b\x17\x36\x10\xf0\xc5\xb3\x09\x2b\x98\x52\x7c\x42\x4b\xe7\x3a\x94\x24\x83\x3a\xc5\x0e\x7c\x82\xf7\xf1\x6d\x7c\xeb\xdd\x61\x5c\xe6\x7c\xa4\x71\xa6\x98\x1b\xd5\x1f\x98\xd1\x01\xd3\x1d\x48\xd9\x74\x13\x25\x38\xc2\x56\xc8\xa2\x3c\x75\x55\xa1\x1c\xb6\x6c\xad\x16\x02\x86\xc1\x29\xac\x22\x4a\xf2\xbc\x40\xfe\xd1\xf1\x64\x64\xdd\x15\x45\x94\xc2\x9a\x2b\x6c\x57\x83\x49\xae\xc3\xbe\xbd\x0c\x9d\xb1\x07\x93\x6d\xb5\xc0\xcc\x00\xf8\x69\xb3\x98\x0a\x3d\x2b\x23\x59\x14\x9b\x2c\x12\x11\x08\xe3\x8f\xa2\xef\x33\x6e\x84\x59\x5b\xe3\xff\xc6\x7a\x2a\xc7\x93\xf7\x73\xe7\xf9\xb6\xf7\x21\x79\xbe\x44\xda\x20\xbb\x0f\xa3\x55\x9c\x5c\x99\x9f\xbe\x78\x27\xe7\xd3\x32\x83\x3e\xee\x38\xf2\x61\xeb\xc3\x61\x31\x33\xd0\x99\xae\x33\x06\x9d\x70\x86\x48\xc4\x90\x73\x60\x67\xba\xc7\x95\xb0\xe9\x7d\x34\xa9\x73\x6b\x99\x1e\x53\x1d\x37\xa9\x79\x86\x62\x0e\xfc\x8d\x81\xd5\x78\x73\xca\x7c\x1a\xad\x66\xe7\x46\x43\xfa\x6d\xb5\x49\xfe\x70\x59\xed\x99\xe4\xbb\x32\xaf\xe1\x5b\x3b\xf9\x32\x97\xe8\x56\x12\x89\xca\x32\x8c\x93\x5a\xe5\x94\xeb\x78\x36\x68\xeb\xf7\x8d\xd1\xa4\xb2\xe8\xce\x10\xf0\xc4\x5b\x14\xee\x69\x93\xe1\x8a\x52\x21\x16\x87\x93\xa8\x8e\xdd\xd8\xea\xd0\xb2\xfa\x32\x71\xd9\x27\x1a\x68\xae\x81\x99\xd7\x13\x2e\xed\xeb\x18\x2d\xc1\xd8\xc5\x81\xcb\xfc\x2b\x1f\xc0\xc1\x56\x75\x95\x2f\xae\x41\x45\xa2\x06\xd5\x08\x52\xd0\xa6\x0d\x29\x0a\x66\x0f\x7a\xa3\x56\xc3\x06\xd9\x78\x37\xcc\x2b\x74\xe3\x9d\x67\x8f\x92\xbb\xfa\x3c\x4f\x01\xb3\x91\xc9\xdc\xdc\x0a\x16\x83\x8e\x6c\x9e\x0b\xea\xfe\x7f\xd3\xbd\x0d\x59\xee\xb4\x6e\xe8\x46\xb3\x4d\x72\xa4\x52\xc2\xbf\xf3\xac\xc8\xcd\x53\x3d\x9e\x9c\x0e\x7f\x22\x1d\xed\xf6\xe8\x92\xed\x10\x43\xde\x2e\x0e\x02\xd1\xe5\x02\x11\x46\x41\xf4\x2d\x9e\x13\x89\x7e\x3e\xc5\xd0\xbc\xc2\x48\x5c\x60\x84\xec\x3a\xa1\xdb\x14\x85\x98\x13\x6e\xf1\x1e\x17\x7c\xfb\xa4\x11\x75\xa2\xd0\xd9\x27\x83\x97\x1e\x9b\xe9\xf2\xcd\x0c\x32\x76\x61\x30\xbe\x7d\x39\xec\x42\x0a\xbc\xab\xe2\x7f\x57\x0a\x0f\x75\x43\xf2\x06\xb5\x6d\x24\x33\xa4\x16\xd7\x64\xbb\xdd\x6a\x36\x76\x5a\x31\xba\xac\xf8\xa9\x9a\xd0\x6d\x58\x7e\x72\x4c\x8c\xc7\x77\xbd\xcb\xe6\xca\x3c\xb8\x21\x49\x18\x5c\x00\xd0\xcb\x94\x75\xea\x0b\x17\x1f\xa5\x4f\x3f\x61\xa5\xe0\x4f\x6c\xc3\x3c\xe4\xc5\xa6\x53\x68\x6c\x15\x25\xb1\x23\x79\x8c\x60\x47\x09\x97\x25\xeb\x06\x5b\xf2\x5d\x72\x40\xb4\xef\x28\x25\x95\xab\x28\xb9\xce\x16\xee\x79\x42\x71\xc0\x85\xee\x94\xf1\x18\xa2\x6d\xb7\xec\xcf\x67\x42\x4a\x3d\x15\x60\xa6\x00\x25\x14\xdc\xcb\x78\xa3\xcc\xa3\xc7\x06\xd6\x4b\xf0\x7e\x18\x61\x8e\x42\xb2\x0f\x9e\x94\xc3\x24\x9e\xe8\x5a\xa4\xa2\x39\x90\xc6\x9d\xe6\x74\xae\xd1\x19\x14\x59\x6b\x7f\xef\x93\xb7\x0b\x43\xe3\xaf\xbb\xbd\x25\x6b\x17\x30\xd8\xc2\x6a\xde\xe1\xf1\xce\x4c\x66\x38\x43\x08\x5a\x32\xc3\xf7\xea\x0d\xf8\x35\xb3\x90\x5f\x3f\xc7\x2f\x9e\xc0\x3c\x03\xdf\xb0\x9f\xf6\x1c\x5d\x40\x1a\xcf\xcc\x9d\x33\x13\x7f\x80\x29\x8a\x6c\x6e\x83\xd8\xdb\x19\x18\xef\xe7\x57\xac\xd9\x6a\xba\x5e\x5d\x1a\x79\x37\x5b\xa3\x56\xb6\xd7\x89\x99\xb7\x7d\x07\x2b\x34\x33\xc1\xdb\xec\x93\x1f\xf9\xab\x2d\xd7\x0c\xcd\xce\xaa\x9d\x08\x79\x1c\x15\xbb\xe1\xab\xad\x5a\x27\x3e\xba\x47\x7d\x98\x64\xf3\x36\x1c\x7e\x3a\x63\x97\xaf\xb0\x5d\xaf\x17\x03\x28\x4c\x69\x07\x0b\x9e\xd6\x32\x9b\x7d\x64\x5a\xd2\x00\x73\x79\x20\xdb\xed\x42\x27\x80\x74\x66\x73\xc2\x2a\x79\x70\x36\x79\xbf\x68\x03\x9d\xa4\x6f\x06\x57\x89\x81\xa2\x0c\xb8\xa7\x5b\xdb\x26\x63\xee\x94\x25\x7b\x55\xc3\x1c\x35\x6a\x3d\x52\x64\x81\xbe\xc5\x81\x4e\xf9\xc5\x3d\x5b\x6a\x1c\x66\xcb\x5a\x29\x14\x8d\x8f\x63\x7c\xd9\x3f\x0f\xe9\xb6\x31\x47\xa5\x04\x22\x36\x84\xc3\x08\xdf\x25\x02\x33\x37\x15\x9a\x01\x9b\x66\xf7\x28\xa9\x1f\xd4\x56\x34\x7b\x86\x6e\x24\x6d\x67\xae\xb0\xcf\x0e\x8f\xeb\x74\xf7\x6b\xbd\xf1\xf8\x71\xd3\x34\x3e\xf5\x65\x87\xf1\xb3\x10\x95\x73\xd4\x1e\x61\xc6\xd8\x13\xf3\xff\x6f\xea\x33\x78\x3c\x62\x8a\x00\xae\x5a\x64\x09\xd6\xad\x4b\xb3\x04\x36\x87\xc3\xe1\xc1\x4c\xbc\x6a\x49\x71\x2d\x8d\x79\xb7\x9d\xc8\xaa\x51\x1c\xda\xc4\xb6\x2c\x8e\x94\x71\x3d\x5a\x54\xb3\xd6\x6e\xb1\x97\xa1\x92\xc4\x6c\xe4\xd8\xb7\x96\x42\xfb\x24\x04\x5c\x27\x43\x08\xb8\xb4\x1e\x83\x0a\xc0\x4c\x68\xaa\xa3\x32\x4f\x3b\x13\x8f\x2b\x70\xd5\xb1\x10\x1f\x8e\x00\x04\x3e\x0a\x66\xe5\x37\x09\xe9\x92\x4a\xc6\x1e\xa9\x19\x39\x0b\x2a\x6d\x59\xb1\xf8\x9a\x64\x37\x49\xb2\x04\xe3\x7f\x64\x77\x94\x59\x25\x95\xf5\x4d\x53\x5d\xee\xea\xb3\x03\xcf\xed\xb5\xd4\x20\xdb\x0d\xa7\x81\xea\x8d\x5b\xca\xc6\xd9\x7a\xcf\xc1\xbd\xfd\x1e\xc8\x40\xb0\x41\x17\x9c\x26\x4e\xbd\xa2\x64\x7e\x3a\x90\xb4\x6b\x1d\x06\xe5\x6e\xce\xa0\xa4\xb0\x41\x54\x2d\x77\xf6\xaa\xcd\xab\xde\x7d\x11\x59\x4b\x1e\x8e\x7b\x33\x9e\x40\x5e\xb8\xbe\xe5\x96\x41\x65\xb0\x3d\xf6\x5d\xca\x43\xc5\x5c\xde\x24\x73\x1e\x1f\x5a\x9d\x45\xab\x15\xe8\x86\xc8\x6b\x69\xdc\x75\x23\x35\xcb\xc3\xf9\x72\xe8\x8a\xa2\x4d\x1b\x84\x5c\x1e\xd0\x26\x4e\x07\x37\x62\x04\x8e\x48\x5f\x81\x0c\x18\x9e\x19\xc9\x16\xee\x85\x64\x0d\xa9\x33\xf2\x58\x45\x6c\x93\xa7\x89\x5f\x57\x5f\xf9\xc6\xd6\x57\x2e\x0e\xe7\x0c\x1d\x60\x57\x50\xa7\x07\x62\x6f\x19\x1f\xf2\x83\xaa\xcb\xb1\x9e\x9a\x78\x87\x13\x6a\x51\xa3\x11\x12\xc4\xb2\x29\x96\x99\xb3\x3b\xdd\xd9\xbe\x1b\xbf\xd9\x54\xec\x95\x58\xde\x8c\xc2\x48\x64\x0c\xfb\xa4\x1c\xe7\xf2\x9d\x91\xee\x32\x41\x9d\xdb\x6c\x4b\x69\x21\xae\x0c\xd3\x7c\x96\xfa\x5e\x3f\xa3\xa6\xbe\xc9\x68\xfe\xd5\x73\xc8\x0b\xa7\x06\x28\x5e\xc0\x79\xe7\x29\xe4\xf0\x51\x80\x74\xe7\xcc\x91\x1d\x9f\xe5\x6f\x7b\x84\xc3\x9d\xca\xc9\xfd\x90\xd1\xe4\xc9\xa1\x33\xbe\x93\xfd\x42\xad\x7a\xe7\xe0\x46\x96\xee\x5b\x71\x71\x39\xa2\x4f\x35\x1a\xef\x9e\x6a\xd7\x28\x2c\x0d\x45\x97\xc5\x03\xb2\x15\x48\x54\x49\x6d\x9e\x97\x27\x36\x0c\x72\x14\xbc\x92\x92\x84\x4a\x4a\x72\x89\xa4\x5c\xe6\xa6\x84\x27\x40\x34\x45\x5e\xba\xb4\x3f\x99\xb1\x42\xb3\x69\x90\xf7\x99\xc6\x9b\x0c\x79\x97\x29\x5c\x29\x91\x77\x40\xee\x52\x2f\xeb\x11\xb8\xf0\x3b\x64\xb1\x7e\x2a\xbb\x54\x44\x76\xba\xdd\xcd\x38\x1f\xfd\xc9\x18\x19\x51\x90\x74\x5d\xc4\x95\x21\x71\xa9\xfd\x9b\xcd\xf7\x1a\x4c\xc5\xbf\x80\x02\xef\x0a\xd8\xd2\x28\x3d\xe2\x22\x53\x6e\x49\x8d\xed\x54\xf3\x08\xfa\x10\x15\x9a\x6e\x9c\x0d\x68\x65\xbb\x59\xc8\xcb\x7e\xce\x29\xe0\x14\xd6\xc6\x6b\xee\x0e\x46\x6d\x0d\x53\xb4\x03\x75\x83\x34\xe3\x74\x53\x9f\x07\xaf\x58\xfb\xd1\x86\xc5\x7f\xd6\xa8\x02\xff\x0d\xab\x76\xb1\x5c\xfc\x3b\x2a\x4e\x88\xf9\x54\xe0\x3f\x50\x87\x16\xcb\xe1\x79\xf9\x57\x83\x61\xb1\x6c\x61\xd5\x46\x2d\x6a\xf0\xc1\x34\x99\x56\x0f\x77\x5c\x81\xda\x7d\x37\xeb\xfe\xc4\xb7\x96\xc0\x65\xc0\xa5\x1d\x12\x54\x5e\x77\x71\x7a\x9a\xd3\x1f\x88\xd0\x5b\x13\xe6\xee\x8c\x79\xa8\xb0\x6f\xa3\xef\x5e\x5b\xdf\xfb\xa9\xff\x30\x86\x92\x58\x1b\xf5\xa5\xcf\x49\x79\xa8\x17\xb2\x7e\x67\xf4\x93\xc5\x41\x7e\xb1\xcc\x78\xe9\xce\xea\x78\xca\xce\xb5\x32\x0d\xd0\x0e\x6f\xf8\x2e\xce\x16\x8e\x11\xfc\x87\x58\xf6\x84\xfc\x74\x70\x70\x9f\xdc\xdf\xdf\x05\x8f\x75\xcc\x7f\x1f\xaf\x9d\xf3\xd3\x47\x4c\xa9\x4b\x0f\xe3\x18\xa6\x28\x0b\x1d\xea\x9c\xfd\xc6\x39\x7b\x4e\x48\x5e\xa0\xba\xe8\x5a\x3b\x01\x59\x76\xb3\xdf\xde\x5f\x30\xda\x49\xc3\xc6\x49\x43\xcd\xb3\x7b\xa8\xa5\x76\x12\xd2\xfd\x26\xde\xdc\x86\x0f\x76\x52\x20\x92\xbf\x2b\xa6\xec\x7d\x6d\xe8\xcb\x33\xe1\x59\x2a\xe5\xbd\xf8\xc1\x8c\x25\x10\x8f\x23\xb2\x41\x8b\x28\xdc\xf7\xd2\x6b\x9c\x36\x9a\x6e\xc4\x2a\x4e\x85\xa2\x02\xc1\x86\x77\xa1\x1e\x8d\xf0\x2c\xa0\x9d\xd7\x12\x54\x59\x9b\xef\x94\x98\x2a\x89\xa7\xce\x5b\x70\xaa\x2b\x34\x03\x15\x94\x65\xd2\x37\xf0\x8b\x16\x92\xf0\x74\x02\x7e\xe6\x16\x63\x38\x51\xcf\x83\x63\xe4\xfe\xec\xfa\x62\xf8\xf0\xc2\xb7\xf7\xf5\x68\xc9\xce\x01\xad\xda\x56\x37\xe8\x14\xb5\x05\xce\x90\xa5\xc7\x40\x83\xac\x98\xff\x1c\x04\x99\x76\x0d\xef\x75\x9b\xed\x32\xc0\xd5\x11\x35\x98\x86\x5c\x74\xb0\x83\x5a\xb0\x53\x5c\xe2\x2a\x8f\x0e\x5d\x95\x06\x4e\x12\x34\xc2\xc2\xc7\x95\x79\xb3\x23\x88\xa7\xce\x51\x5e\xfe\x3a\x47\x05\xf3\xfa\x55\xf7\x49\xbc\xb2\xc4\xef\x0b\xe1\x32\x5f\xe9\xbd\xb5\x1f\x7a\x5d\x68\xa5\x5e\x0f\x72\xd3\xf2\x5b\xdd\x0e\xe2\x94\xcd\x75\x53\x5b\xeb\x3a\xb6\x0a\x90\x96\xbc\x93\x3e\x8c\xee\xb1\x8c\x6f\xc7\xd6\xee\xe1\xde\x8d\xd9\xd8\x3d\x29\xcb\x57\xd5\xa5\xa6\x7b\xda\x94\x6c\x64\xc4\x34\xb7\xb9\x22\x8f\x0d\xac\xed\xfd\x00\xde\xae\xcd\x82\x40\xe6\x81\xad\x72\x7c\xb0\x35\x6c\xf6\x35\x3f\xf9\x87\x11\xd4\x68\x3d\x88\x73\x8a\x0e\xc2\x0c\xa5\x50\x45\xd7\xe7\x51\xbf\xdd\xde\xf3\x0c\x25\xc0\xd4\xf8\x8b\x90\x0c\x5c\x35\x63\x26\xad\x99\xc4\xe9\x49\xdb\xeb\xa9\x41\x17\x41\x67\x24\x8c\xa2\x72\xf4\x49\xac\x94\xd9\x7f\xe3\x6b\x11\x94\xef\x4e\x74\x05\xd3\x6a\x6f\x42\x41\xa2\xb5\x2d\x94\xd2\xd8\x62\x5f\xa7\x79\xe9\x41\x93\x77\x7b\x36\xd8\x7e\x63\xc8\xda\x01\x63\xc6\x6e\xbc\x01\xc6\xd5\x36\x6b\x3b\x10\x14\x2f\xe9\x9b\x6d\xbe\xa1\xa1\xe6\x8d\xf7\x44\x9c\xc6\x44\x0b\x7f\x1c\x0d\xd0\x93\x4f\x86\xdc\xf7\xcd\x98\xcc\xbd\x8c\x98\xc6\x8f\xb6\x61\x65\xeb\x47\x9e\x1d\xe1\xea\x67\xff\x5d\xfc\x46\xdf\x02\x56\xf6\xeb\xe2\x81\xcb\x9f\x8c\xbe\xa0\xb5\x1f\x95\x35\x7d\x1a\x6f\x84\xb9\x5b\xb8\x57\xf2\x17\x2a\xe4\x6f\x00\xf5\xd9\x7a\xf9\x60\x1d\xcc\x47\x33\x39\x67\xa1\xbe\xa1\x6d\xfe\x30\x40\x55\x31\xad\x74\xa3\x48\xfe\x4d\xff\xd5\x72\x27\x2d\xfe\xed\x22\x07\xc9\x84\x15\xae\x5c\xdd\xcc\xd2\x1b\xac\xc4\xdc\xd6\xc3\xc9\x0a\x64\x3a\x98\x56\x20\xbf\x3f\x29\xce\xcb\x78\x20\x7c\xae\xd7\x6d\x06\x9c\x5c\x00\x1c\x0a\xf9\xa2\xb1\xa9\x6b\x91\x1e\xa0\xe8\x51\xd9\x87\xf4\x38\xaa\xb4\xf0\xe6\xd1\xdf\x80\x96\x03\xcc\x8c\x2b\xad\xaa\x1e\x78\x60\x4d\x21\x99\x5a\x0d\xe7\x30\x97\x94\x4e\x00\x5d\x92\xea\x25\x64\x46\x73\x83\x9b\x57\x02\x35\x3c\x44\xc1\x27\xfa\x2d\x17\xc2\xd7\xe6\x16\x4f\xf7\x56\xb8\x07\xea\x0c\x0e\xe9\x0b\x8e\xc4\x2f\x66\xcc\xff\x02\x92\x02\x76\x09\x39\x86\xc7\x3b\xfb\x83\x48\x21\xd4\xbc\x9a\x18\xa7\xa8\xbc\xb9\x61\xda\xad\x01\xa1\xbb\xa5\x4b\x79\x28\x53\x2f\x19\x35\xa7\x28\xd3\xb2\xdf\xfa\xa2\x06\x5f\xbf\x76\x19\xa5\xbe\xb7\xa0\xf6\x94\xf7\xde\x80\xd6\x5e\xd2\x7b\x35\x42\x8f\x14\x4c\xed\xbf\xd1\xf0\xe7\x8f\xa2\x43\x31\x5b\xac\x81\x68\xef\x51\x63\xb0\x30\x3c\x86\x1a\xcb\xa6\xa6\x4b\xd1\xa8\x22\xa8\xb8\x70\x81\xda\xe3\x59\x9d\x47\x0f\x3c\x6b\xb9\x64\xd4\x9c\xf6\xf0\x01\xef\xa9\x30\x6f\x46\x68\xb9\x12\xf3\x4e\xea\xf1\x36\x5c\x2f\x57\xff\x1f\x00\x00\xff\xff\x6d\xf6\xe3\x1e\x1c\x60\x00\x00"),
-		},
-		"/css/lightgallery.css.map": &vfsgen۰CompressedFileInfo{
-			name:             "lightgallery.css.map",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 13247,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5a\x5b\x57\xe3\xba\xaf\x7f\x9f\x4f\x31\x8b\x57\xfc\xdf\x14\x5a\x60\x98\x3c\xc9\x97\xa4\x29\x85\x96\x3b\xc3\x59\xe7\xa1\x40\xef\xe9\x35\x6d\x69\x39\xeb\x7c\xf7\xff\xf2\xdd\x49\x9d\x02\x7b\xcf\xec\xb7\x56\xb6\x25\x5b\x92\xa5\x9f\xe4\xfc\xdf\xb7\xbd\x55\x7b\x9e\xf6\x27\xe3\xbd\x9f\xdf\xcb\xe8\xdb\xde\xa8\x35\x9d\xf6\xc7\xdd\x74\xef\xe7\xf7\x3d\x00\x02\xe8\x0e\x2e\x48\xc0\xa0\x1e\xa1\x07\x80\x07\xc4\x00\x18\x8a\x01\xe2\x80\x01\x19\x60\x14\x01\x44\x92\x78\x82\x01\x52\x3e\x95\x94\x89\x43\xee\x5e\x02\x4c\x9a\x9c\xfc\xde\x74\x58\x5c\x00\x5c\x70\xe2\x18\xa3\x3b\x80\x3b\x87\x18\x00\xc4\x0b\x8c\xae\x00\x6e\xf8\x84\xba\x5f\xee\x39\xc0\x79\x96\xf8\x98\x67\xc4\x80\x24\xd8\x2f\xf2\x17\xc0\xaf\x2c\x71\x8a\xd1\x0b\xc0\x4b\x96\xe5\xd4\x5d\x4e\x00\xc4\xe9\x5e\xd1\x92\x00\x2c\xc5\xef\x15\x41\x29\x3f\x36\x96\x53\xf8\x64\x21\x81\xa0\x25\x06\x58\x2a\xf2\x0d\x3f\x4a\x10\xf0\xed\x24\x04\x95\x08\xc0\x08\xf3\x69\x1d\xd4\xc5\x00\x5d\x8c\x18\x69\x5d\xa2\x11\x16\xab\xe9\xd3\x1c\xa3\x16\x40\x0b\x31\x12\x46\x42\x91\x01\xa3\xd1\x58\x1f\x99\xcc\xc8\x03\xdf\xe0\x4b\xc0\xe8\x94\x9c\x61\x71\x02\x29\xa7\x01\xd0\x90\x7c\xf9\x2f\x49\xe3\x8b\xe4\xae\xf9\x2e\xb2\xc7\xeb\x38\x1a\xd3\xf3\x06\xd8\x59\xcc\xd5\xc4\x89\x73\x61\x8e\x2b\x49\xe4\xbf\x94\x6e\xad\x95\xe5\x36\x81\x5c\xab\xd5\x64\x42\x22\x29\x27\x00\x9a\x90\x57\x54\xa1\x4a\x37\x4d\xbf\x9e\xad\x50\xc1\x2a\x00\x88\x7a\x18\xa5\x54\x09\xab\xe9\xd3\xa7\xb8\xc5\x97\x4d\xb0\x60\x8c\xcf\x08\x9a\x60\xe3\x29\x39\xa7\x08\x00\xd8\x23\x3a\xb2\xe3\x56\x88\x50\x15\xf7\xb4\x35\x76\x19\xf0\x65\x39\x06\xf7\xa8\x84\xcd\x26\xf2\x0c\x02\x60\x4b\xbc\xc6\x68\x1f\x03\x23\x01\x0b\xc7\x98\xf2\xe1\x28\x88\x81\x10\x87\x9b\xf0\x9d\x80\x41\xf4\xc8\x8f\x17\xf3\x61\x70\x86\x85\xf2\xf9\x70\x87\x13\xcf\xf9\x30\xdd\x5a\x1d\x00\xfb\xf5\x88\x2a\x4a\xd2\xea\xcf\x4a\xea\x62\xa1\x38\x21\xaa\xfc\x67\x45\x4d\xf0\x23\xbf\x2d\x42\xd4\xc1\x9f\x15\x75\xf5\x88\x7e\x28\x49\xb5\xbf\x25\x48\x7a\x05\x83\xe8\x75\xb7\xa0\xaa\xd5\xde\xf5\x1f\x15\x44\x1e\xb9\x7b\x0a\x41\x4f\x7f\x54\xd0\xf9\x23\x8f\x74\x42\x50\xef\xef\x19\xe9\x93\x92\xa0\xd1\x44\x7d\x22\x82\x05\x5b\xd1\x0e\xea\x63\x80\x3e\x46\x2c\x7c\xa3\x77\xea\x8f\x18\x39\x26\x2a\x8a\x87\x4b\xda\xb6\x03\x0b\x3e\x20\x43\x5d\x98\xd2\x21\x36\x23\x40\xa6\x24\x1f\xc5\x02\x80\xf3\x05\x46\x5d\x25\xee\xcc\x15\xb7\x4f\xef\x54\x78\x16\x23\x65\x23\xee\x07\x6d\xdb\x81\x53\x3e\xa0\xc4\x9d\x70\x71\x7a\x44\x24\x81\xbc\xb8\x00\xe0\xe2\x4c\xe4\x94\x5f\x99\x60\xb9\x26\x17\x2a\x16\xd2\x0d\x8f\xc0\x39\xad\x88\xa8\xf9\x41\x00\xd6\x13\x2f\xbd\x99\xd1\xe4\x99\xb3\xb0\x26\x12\xcd\x48\xc4\xcf\x6e\x74\x80\xb7\xe3\x27\x59\x53\x93\x61\x36\xb4\x20\xc3\x58\x29\x3a\x73\x3c\x79\x33\x8c\x5d\xab\x69\x4f\xfe\x84\x6e\x43\xab\x4e\xc9\x1d\x7f\x42\xf7\x9d\xaf\x0d\xd0\x96\x34\xfe\x8b\xd3\x7a\xc4\xe1\x28\xf3\x32\xeb\x57\xd7\x18\x0d\x31\xc0\x50\x25\xe6\x3e\x56\x49\xe9\x40\xa7\xdc\x0c\xf5\xd0\xc5\x26\x92\x1a\x40\xd8\xab\x8e\xb1\x9b\x13\x94\xd2\xe6\xcc\x49\x4c\x25\x76\x48\x44\xe2\x29\xc8\x1b\x00\xe7\x53\xcc\x63\x51\x71\x62\x81\x8b\x63\x81\x53\x5a\x7c\xc2\xad\x6b\xc1\xaa\xb6\x20\xa3\xfb\x61\x8f\x38\x2a\xd6\x4e\x70\xab\x37\x75\x10\x3e\x68\xc7\xea\x46\x53\xec\xb5\x90\xcf\xd9\xec\x76\x6e\x94\x73\x24\x2e\x04\xd0\x26\x77\xdd\x40\x2f\xbe\xf6\x5b\x4d\x79\xfa\x7b\x78\x61\x60\xc1\x51\x38\xc0\xdc\x5e\x02\x38\xf8\x8f\xe1\xdb\x6f\xce\x7b\x38\x52\x98\x0b\xf0\xc6\xf8\x38\xfb\xc0\x0b\x8d\x7a\xcf\x57\xee\x45\x54\xfa\x4a\xaa\x6d\xed\xf9\xa3\x6a\x42\xfc\x50\x48\x0a\x20\x83\xda\x9d\xd6\xed\xb0\xb6\x72\x31\xa5\x96\x9b\x41\x9f\x8e\xab\x5b\x00\x24\x01\x2f\xc7\x21\x87\x02\x44\x40\x57\x1c\xfc\xd8\xbd\x0e\x52\x1b\x6c\x18\xdf\x5b\xdf\x9d\xe3\x2a\x5a\x56\x01\x52\x1a\x30\x48\xf1\x9c\xe9\x1b\xb0\xc0\x80\x26\x7a\x60\xc9\x07\x94\x5f\xaf\x30\x43\x43\x3d\xf0\xc6\x07\xac\x99\xf6\x23\xa5\x94\xb0\x17\x8f\xb1\x80\xae\x5b\x80\x49\xed\xe2\xf1\xd5\x44\x48\x4e\x7d\x13\x28\x57\xdc\x15\xe2\x28\x5e\x91\x03\x08\x5f\x12\xb2\x93\x5f\x0f\x67\x19\x2e\xfd\x0c\x97\x86\xe1\x48\x40\x68\x9c\x8b\xa0\x0e\xc7\x39\xfe\xd2\x16\x03\x08\x4f\xf0\x21\x46\x25\x0a\x70\x17\x30\xf6\x8e\x13\x9c\x59\x9f\x62\x0d\xd7\x17\xee\x7a\x4d\x0e\x4b\x38\xf1\x58\x6b\x19\x2d\x70\xd6\x5c\x95\xa8\xc0\x5c\x9b\xa8\xc0\x5c\xcb\xc8\x6f\xae\x49\x24\xa3\xf0\x41\x38\xc6\xe2\x1c\x6f\x3c\x5f\xdd\xbe\xa8\xe8\xcc\xee\x4e\x09\x9a\x61\x80\x19\x27\xdf\xf7\xb1\xa6\x3f\x9c\x4a\xb0\x3c\xe1\xf4\xc7\xa1\xa1\xff\x3a\x25\x26\xbc\x84\x4f\x29\x36\x31\x2a\x1a\x10\xb4\xcf\xa4\x5e\x7a\x64\x91\xd5\x4b\xd7\xaf\x17\x4d\x0e\xfb\xe4\x35\xaf\x96\x20\x80\x68\x75\x71\x88\xd1\xc6\x24\xc9\x27\x2b\xb9\x44\xee\xf5\x8e\xde\xc9\xb3\x37\x1c\x78\x73\xa0\x2f\x1f\x48\xc1\xe1\x0f\x12\x2b\x22\x3b\xf5\x54\x3b\xb4\x54\x9b\xe3\x2f\xb8\x1f\x83\x34\x4e\x48\xd6\xac\xbc\x42\xf1\x9a\x75\x44\x0b\xcc\xda\xa7\x7e\xb3\xfe\x20\x52\xed\xe4\xe0\xfa\x11\x75\xa9\xc8\x63\x74\xff\x66\xe5\xe6\x5f\x1d\x85\xa6\x2e\x51\x87\xe8\x81\x3f\x53\x5b\x9a\x56\x5a\x0f\xe7\xd3\x72\x00\xe4\xf4\xa6\x86\x26\x4a\x6a\xff\x76\xb5\x35\x45\x18\xcb\xd2\x2c\xce\x59\xbb\x53\x5b\x2a\x61\xcd\x5d\xa2\x29\x8b\x3f\x3c\x8a\x42\x65\xa4\x77\x7b\x8d\xf6\x89\xf0\x3c\x3a\xbb\x4c\xb0\xc8\xa5\x47\xd8\xc8\x98\x08\xc9\x03\xaa\x32\xa1\x4b\xe7\xab\x2f\xe7\x44\x14\xe9\x7c\x83\x74\x14\x4f\xb3\x46\x9e\xf9\x8d\x3c\xd3\x31\x86\x1c\xf0\xd0\xcf\x39\xdf\x49\x44\xe5\x83\x26\x3e\xed\x58\x08\x61\x32\xd3\x4a\xde\xd0\xb6\x74\x4b\x3b\x41\xd7\xcb\xc9\x47\xe8\xca\xfa\xb4\x75\x7e\xbd\x8b\x6d\x3b\xe6\x8c\xf4\x22\x52\x19\xf0\xab\xeb\x15\x9d\x23\x06\x20\xa8\x25\xa1\xeb\x80\xc1\xed\x81\xde\x7d\x4c\x27\xf5\x4e\x06\x3a\x2d\x05\x1c\x8a\x73\xd0\xc9\x50\x33\xd0\x49\x52\xb9\x66\xdf\xcf\xc7\x18\xed\x53\xce\x46\x36\x25\x14\xa6\x3e\xc5\x31\x3f\xbd\x40\xa5\xac\x4b\x56\x84\xd7\xb9\xf2\x3c\xe0\x55\x86\xef\xdc\xb9\xeb\xcd\x73\xfc\x5c\x66\x55\x95\x7d\x6c\xde\x35\x13\xde\x25\xfa\xdf\x9a\x20\xe3\x15\x40\x3c\xc7\x68\x43\x84\x53\xd3\x6e\x6d\xf0\xcf\xe1\x23\x39\x8b\x3b\x68\x49\x0d\xae\xb3\x07\x71\xf7\x54\x32\x45\xcb\xb3\x0d\x90\x07\xa4\xaa\x03\x64\x97\x4e\x5d\x91\x0f\x00\x7d\x2a\x3c\x82\x29\x6d\x85\x3d\x83\xe1\x59\xbf\x08\xc3\xff\x26\xbc\x6e\x5b\x4c\x9f\x8e\xcf\x5e\x88\x68\x8b\x19\xcd\xf1\xde\xdf\xd1\xe1\x16\x2b\x04\xd6\x1c\xe6\x3a\x13\xb4\x3a\x2c\x3a\x0f\x20\x3a\x24\x67\xc4\xbd\x97\x5e\x98\x97\x43\x69\xf2\x1a\x59\x95\x69\xe2\xc0\xb5\xb2\x3e\x61\x6f\x57\x5a\x72\x8d\x1d\x25\xd8\x8d\x34\xbb\x5d\xdd\x26\x42\xef\xde\xbc\x01\x62\xbb\xe2\x65\x89\xac\x3c\x1e\xf2\x50\xff\xf3\xdd\x3e\x5b\x6c\xfa\x8a\x07\x7b\x08\x5d\x8c\x2d\x5c\xff\x13\x44\x7e\xf4\x32\x46\x43\x62\x6c\xf0\xd9\x5b\xee\xdd\x9a\xdd\x86\x8e\x6e\xb7\xde\xe6\xa4\xf5\x64\xed\x62\x09\xde\xd5\x04\xad\x4e\x30\x51\xb2\xa3\x31\x7e\xda\xaa\x23\x00\x78\x40\x4b\x89\x8a\xb3\xf7\x0e\xaf\x13\x0a\xb0\x61\xb2\x3c\x09\x51\xc5\x37\xa5\xcf\x00\x4e\xc4\x94\xc6\x20\xda\xc9\x85\x5b\x25\xfc\xe0\x6e\x7e\x74\xa2\xdd\x0e\x69\xba\xae\x4f\xee\x5e\xf3\x00\x0e\xa0\x91\x60\xf4\xe6\xdb\x29\x8f\xea\x47\xf2\xbc\xab\x50\x80\x99\xad\x29\x23\x06\xb0\xcf\x64\xf1\x15\x65\x62\xdc\x67\x5c\x86\x01\x59\xfd\x7d\xcf\xe4\xb1\xbc\x29\x76\xae\x65\x7a\x9e\x1d\x12\x2c\x60\xba\x48\x7e\x5c\x98\x3d\xbe\x49\xe8\x03\x8c\x36\xd4\xc7\x44\x17\x96\x24\x89\xc6\x12\x4f\xe7\x03\x78\xed\xcd\x38\x53\xbc\xc2\x06\x21\xd5\x36\xb8\xad\x5c\x34\x5e\xe3\xf5\x1f\xe8\xfe\xc8\x63\xd4\x36\x44\x17\xac\xf1\x9a\x5f\xf2\x7c\xd1\x52\xed\xde\xe4\x4a\x4c\x0e\x89\xbc\xe0\xd6\x0c\xe4\xc1\xad\x19\xc8\x81\x5b\x4e\xe7\xf7\x25\xea\xdd\x8c\xb1\x78\x2a\xca\xf5\x35\x6a\x2f\x1a\x01\xb0\xf8\x79\xa5\xbb\x01\xb5\x97\x07\xad\x9a\xe7\x29\xce\x77\x1b\xb8\xc9\xae\xc5\xbd\x69\xb9\x5d\x0f\xd7\x5e\xbc\x9e\xe5\x41\x46\x02\xc6\xde\xd6\xa1\x85\x33\xbe\x8a\x82\x84\x6b\x35\xf6\x65\x94\x6a\x0f\xa3\x23\x3f\x0f\x63\xf4\x32\xee\x61\xb4\x5f\xe3\xb5\x58\xc0\x6a\x9b\x46\x8f\x64\x20\x2b\x2b\x80\xac\x4c\x41\xd6\x38\x69\x70\xc8\x2a\x61\x41\xad\x72\x3d\xc8\x15\x8f\xfd\xb0\xc0\x10\x66\x60\xab\xca\x08\xfd\x86\xe0\x74\x9e\xfe\x0f\xb9\x66\x5c\x54\x3c\xf1\xa3\x62\x43\xee\x85\x89\x3d\x53\x3c\x66\x77\x2a\xcb\xd4\x26\xac\x4c\x4c\x19\x1a\x4f\x59\x4b\x0f\xcc\xf8\x80\x3e\x6d\x3c\x67\x43\x6c\x6e\x5a\xb4\x22\xa2\xd8\xb8\xe3\xeb\x6b\xaf\x5f\x29\x2c\xa1\x1f\x9f\xe5\xb4\xc3\x6b\x54\xaf\x76\x2a\xac\x40\x3b\x25\xe6\xd7\xce\x92\x79\x4a\xeb\x78\x51\xbf\x53\x5e\x58\x5b\xd6\xe7\xb6\xb8\x8e\x57\xf5\x96\x1e\x78\xe3\x03\xba\xba\x8e\xd7\xf5\x17\x3d\xb0\xe1\x03\xda\xcb\xe3\xf7\xfa\x10\xeb\x91\x0a\x9d\x3b\x1a\x2d\x53\xa3\xd1\x0a\x75\x35\x7a\x4c\x8d\x46\x4f\xa8\xab\xd1\x53\x6a\x34\x1a\x00\x5c\x95\x89\x50\x63\x71\x43\x31\x5e\x8b\x76\x9b\x2e\xbc\x73\xe8\x8f\x83\xff\x8e\x38\xda\x6e\x0e\xb6\x25\x99\x4b\x1e\x82\x69\xee\x6d\x8f\xc3\x8d\xa9\xb4\xd6\x4e\xc0\xb6\xc4\x1e\xae\x3a\xa8\xdd\x6f\x71\x0d\x80\x4c\xe9\x3a\xdb\x47\xf0\x25\xc7\x4f\x47\xd2\x2f\xb6\x31\x2f\x7f\xa8\x97\x8d\x80\x5d\x9c\x6e\xe3\x14\x7e\xa8\x6b\x71\x9b\xdb\xf9\xd2\xcd\xc0\x86\xd3\x4c\x61\xb8\xfb\x0d\xd7\x0b\xcc\x8a\x31\x9e\xdc\xa9\xd5\x99\xb6\x4e\xa6\xfc\x36\x00\xf4\x56\x74\x2a\x66\x78\x0b\xd6\x4b\x4d\xd4\xd3\x78\xf1\x95\x40\x11\xc0\xf9\x20\x3e\xc4\x68\x48\x0d\xa8\xcd\x66\x81\x19\x96\xe7\x27\x02\x84\x1a\xb9\xfa\x86\xb8\x06\x5c\xf8\x41\xcb\xee\x9a\x77\xb7\xf5\x73\x25\xba\xd4\x8a\x17\x43\xf9\xfc\xd0\xe7\xf1\x1f\xf8\x93\x7d\x1d\xc9\x57\xe0\xf5\x59\x33\xdf\x23\xfc\xdd\xcd\x24\x4f\x98\x4f\xfd\xd6\x4b\x8d\xf5\xca\xd1\x99\x4c\x99\x9f\xaf\xbe\x79\x64\x98\x66\xea\x18\xd7\x9a\x32\x90\x29\x89\xde\x36\x84\xdd\x36\xc7\x67\x6f\x42\xd9\x53\xea\xad\x30\xf3\x76\xae\x1f\xd5\x7a\xd8\xd3\x98\xc8\x17\xe5\x4b\x6f\x51\xae\xa8\xe7\xe5\xda\x78\xcb\xd5\x64\xa9\x39\xf1\x62\x3d\xeb\xc7\xf1\x7b\xa6\x53\x64\x3d\x49\x7e\x16\x12\xc8\x77\x40\xfb\x34\x60\xc9\x53\xe2\x30\xd4\x1d\xb2\xd5\xd6\x15\x15\x92\xbd\xa1\xc0\x57\x17\xfa\xea\x20\x6f\xf5\xfe\x41\xc8\x70\xaa\xca\x3c\xe6\x0a\x78\x29\xf2\x24\xae\x7b\x61\x48\x67\x3d\x59\x14\xed\x42\x4c\x57\xc7\xe2\x08\x22\xd8\x55\x3d\x52\x78\x25\xc2\x93\x63\x45\x37\x19\x7d\xee\x60\x69\x4c\xbd\xff\x3c\xe6\xbf\xd8\xd1\x55\xf6\xaf\x42\x94\xc8\xcd\x28\x9a\x21\x9f\x2a\xc2\x7d\x95\xa8\x7d\x0f\xfb\x6c\x8d\x2c\x37\x7e\xdd\xd2\xfd\xe9\xab\xa7\xce\x56\xc4\x69\x96\xea\xf7\x19\xd7\x9e\x79\x5d\x7b\xe6\x75\xed\x99\xea\x37\x35\xd6\x75\xf9\xd0\xc5\x02\xd6\xac\xd0\xd0\x30\x6c\x94\xe9\x85\x98\x2f\xe8\x09\x51\xe1\xa6\x71\x4c\x6f\x34\xf9\x84\x93\x25\xcb\xc6\x29\x7d\x91\x64\xa1\x4e\x09\x75\xfc\x19\x3b\x00\xb8\xb8\x44\x9b\x2a\xc0\x3e\x3f\xc4\x51\x7d\x45\x33\x58\x78\x88\x0b\xc0\xb0\x19\xe0\x97\x8a\x47\xa0\x21\x41\xac\x71\x56\x7f\x51\x7b\x0e\x58\x73\xbf\x9e\x30\x51\x38\x96\xf8\x09\x0e\xea\xa9\xbe\xfb\x02\x01\x1d\x52\x74\x52\x53\x62\xfb\x79\xb1\xbb\xbb\xc6\xac\xd1\xab\x9f\x6e\x57\x07\xb2\xde\xdd\xc7\xa2\x98\x6d\xa6\xcd\xe4\xdf\x69\xc6\x37\xa6\xcd\x6b\x34\x92\x25\x74\xf3\x2d\x2b\xf5\x5f\x6f\xc6\x37\xd6\xcd\x9e\xf0\x37\x95\x10\x7c\x5b\xd9\xdd\xaf\x76\x42\x8c\x17\xbf\x78\x23\x94\xf7\x39\x75\xf7\x37\x6c\x06\x7c\x4d\xbf\xd0\x54\xfb\x74\x67\xc9\x5e\x5e\xdf\x25\xcf\x7d\x66\xa8\x7a\xff\x43\xdb\x7c\x76\xde\xef\x18\x40\x37\xe4\xd4\xe3\xd0\xf1\x38\xd3\x17\x5b\x63\xd4\x8f\x00\x8e\xf4\x17\x86\x76\x8a\x79\x06\x85\x78\x2a\x5b\xe4\x5b\x4d\x3c\x67\xca\x5a\x7e\x6b\x28\xb3\xfd\xb8\xe0\xbb\x3e\x2f\xca\xfd\x74\x1f\x77\x1b\x08\xb0\x27\xf4\x46\x3d\xef\xbd\x36\x3e\x7f\x90\xcc\x74\xe2\x72\x3b\x82\x9a\x76\x9f\xf7\x0a\xf5\x72\x9e\xda\x60\xe4\xdd\xba\x2f\x75\xec\xee\x5c\xda\x32\xc0\x67\xec\x5c\xea\xe5\xbb\xb8\x15\x70\x56\xb5\xbc\xb6\xc0\x2c\x37\xeb\x61\xa6\xef\xa1\x12\x40\x39\xac\xeb\x19\x57\xfb\xe1\xab\xff\xd5\xe9\xf3\x4d\xe9\xc2\x5b\x91\xef\x49\xef\xec\xcc\x8a\x9e\xa6\xbf\x0f\xa6\x32\xd3\x11\x5d\xe0\xdf\x90\x9a\xe8\x23\xfa\x51\x2d\x96\xc3\xc3\xef\x21\xf6\x37\xea\x8d\xf9\x9f\xc5\x4b\xdf\xd6\xd7\x00\x36\xb5\xbf\x63\xa3\xc0\xeb\x7f\x94\xb3\x7d\xde\xe1\x03\x61\xca\xb4\xab\xb8\xa1\x93\xfb\x5b\x9c\x14\x56\x38\x5b\xba\xad\xe0\xdf\x90\xf5\x8f\xf1\x4a\x14\xfb\x2a\x66\xab\x2d\x4d\x2f\x62\x03\x8a\xae\x4e\x2e\x9e\x50\x29\x02\xa8\x08\x83\x86\x3d\x9a\xad\x45\x4a\x45\xbd\xbf\x52\x51\xef\xaf\x54\xd0\xfb\x2b\xa9\xde\x5f\x63\x1d\x76\xc4\x15\xb8\x09\x58\x73\x10\x3f\x9b\xf4\xdc\x18\xc6\xba\xf7\xd1\x1c\xc4\x4e\xef\xa3\xd1\x8f\x75\xef\xa3\xd9\x8b\x9d\xde\x47\xa3\x1b\x3b\xdd\xa4\xf3\x15\x31\x7c\x57\x2e\xdf\x37\xcb\x77\x95\xe1\xbb\xb4\x7c\x17\x19\xbe\xa9\xcb\xf7\x62\x45\x44\x8b\xc1\xff\xf1\x8a\xfc\x38\x78\x42\x76\xe0\xa0\xf8\x15\xa5\x1a\x07\x75\xd9\x2a\xa7\xe1\x49\x91\x86\x27\x45\x1a\x9e\x14\x68\x78\xa2\x35\x5c\xa6\x8f\x02\x78\xef\xdc\x31\x95\x60\xa1\x99\x84\xc7\xf8\x4b\x5f\xf3\x34\x66\xe1\x02\xdb\xf5\x8b\xfc\xfa\x8f\x3e\xde\x69\x6c\xf8\xfa\xbe\xec\x72\x36\x0f\xc3\xf7\x2f\x75\x20\x1b\x47\xe1\x76\x59\x2e\x95\x6c\x50\xdf\x92\x94\x73\x4a\xde\x14\xf5\x06\xd3\xa2\xde\xe0\xa4\xa0\x37\xd8\x67\x4a\xc9\x63\xb2\x4b\xc9\x22\x01\x7c\x06\x4a\x70\x6b\x34\x8d\x36\xb3\x0d\x0b\xd3\x9d\xe9\x14\x4c\x30\x59\xe5\x55\xeb\x33\x5b\xfe\xf9\x3e\xe5\xf3\x29\xac\x95\xd7\x57\xa5\xa8\x05\x51\x29\x6a\x41\x54\x0a\x5a\x10\x9c\xde\xd8\x43\xdf\xf6\xd2\xc9\x72\xfe\xd2\x4e\xf7\x7e\x7e\xff\x9f\xbd\xbf\xfe\x3a\x48\x5b\x69\x7a\x90\x74\xff\xd3\x99\x8c\x17\xe9\x5f\xe9\x4b\x9a\xee\x21\x97\xbe\xe8\xb5\x47\xed\xff\xbc\xb6\x3b\xad\x65\xb2\xf0\x8c\xaf\x5a\xf3\x7e\xeb\x39\x69\xfb\xd6\x8e\xfa\xeb\xfe\xd8\xcf\x74\x39\x7a\x1e\xb7\xfa\x89\x8f\x61\xff\xb5\x3d\xf1\xd0\x5b\xcb\xc5\x64\x9a\xb4\x36\x9e\xa1\xf7\xc9\x64\xe4\x21\x4f\x5b\xdd\xf6\xdc\x43\xef\x2c\x93\x24\x7d\x99\xb7\xdb\xe3\xad\xc1\x7e\xb7\xb7\xe8\xb6\x92\xa4\x3d\x57\x72\xfe\x17\x7d\xdb\x1b\xb7\x46\x52\x5f\xfc\x4f\xa7\x9f\xb4\xf7\x7e\x7e\xdf\xcb\x4c\xe5\x33\xbf\xfd\xff\xb7\xff\x06\x00\x00\xff\xff\xe4\x67\x5f\x1e\xbf\x33\x00\x00"),
-		},
-		"/css/lightgallery.min.css": &vfsgen۰CompressedFileInfo{
-			name:             "lightgallery.min.css",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 20370,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x3c\xdb\x6f\xab\xb8\x9b\xff\x0a\x9b\xea\x48\xa7\xab\x90\x21\x49\x73\xda\x12\x69\x67\x46\xda\x19\xed\xd3\xee\xc3\xbe\xee\x8b\x01\x43\xbc\x07\x30\xc2\xa6\x4d\x1b\xf5\x7f\xff\xc9\x06\x83\xef\x90\xb4\x47\xa3\x99\x51\x03\xfe\x2e\xbe\x7c\xfe\xee\xcc\xa6\x2c\x42\xd2\x25\xe1\x89\x56\xe5\x9a\x3d\x50\x8c\xcb\x04\xb4\x97\x04\xa4\x3f\x8b\x16\x77\x75\x16\xa6\xb8\xc4\x6d\xdc\x16\x09\xf8\x1e\xad\xd9\x3f\x9b\x87\xc3\xfd\xc7\x5d\x59\x84\x29\xee\x6a\x0a\x5b\x8e\x88\x3b\x0a\xdb\x80\xfd\x7a\x41\x19\xc4\x61\x8a\x6b\x7a\x79\x81\x2d\x45\x29\x28\x43\x50\xa2\xa2\x8e\x2b\x94\x65\x25\x3c\x66\x88\x34\x25\x78\x8b\x51\x5d\xa2\x1a\x86\x49\x89\xd3\x9f\x1f\x7f\xe4\xb8\xa6\x61\x0e\x52\x78\x19\x7e\x55\xa8\x7c\x8b\xcb\xe2\x48\xda\x34\xee\xda\xf2\xfb\x66\xf3\x1b\x1b\x21\xbf\x95\xc5\x06\x62\xfa\x7b\xbd\x7d\xdf\x3f\xee\xef\x9d\xe3\x77\x08\xe6\xe8\x3c\x40\x05\x39\x6e\x2b\x40\xbf\xaf\x60\x95\xc0\x2c\x83\x59\x88\x1b\x58\xd3\xb7\x06\xae\xee\xd7\x3a\xf6\x2b\xce\xf3\xdf\x75\x44\xf6\xd2\x02\x4b\xa9\x09\x4a\xdb\x0e\x3a\x48\x93\x97\x62\x00\xbf\x2b\x8b\x09\x83\xbc\x14\xab\xfb\x23\x5f\xf8\x2b\x44\xc5\x89\xc6\x0f\x51\xd4\x3f\x13\xfa\x56\xc2\xb8\x66\x80\xe5\x07\xdb\x5f\x94\xe2\xda\xd8\xa3\x06\x82\x9f\x71\x8d\x6b\x68\x22\xd9\xc9\xbe\x80\x16\x81\x9a\x0a\x18\x0a\xcf\x34\xa4\x2d\xa8\x09\x9b\x52\x4f\x89\x9f\xce\xa9\xc7\xdb\x1e\xc3\x57\x98\xfc\x44\x34\xec\xe9\x57\x18\xd3\x13\xaa\x8b\x18\xd4\x14\x81\x12\x01\x02\xb3\x63\x58\xe1\xf7\x10\x93\xb3\x0e\x53\xb4\xe0\x8d\xa4\xa0\x84\x7c\xfe\x20\xa5\x08\xd7\x84\xcb\x4a\x0d\xcf\x74\xad\xbf\x6c\x5a\xf8\x32\x2b\x80\xc7\x04\xb7\x19\x6c\xc3\x16\x64\xa8\x23\xf1\xae\x39\x1f\x7b\xb8\xbb\xe7\xe7\xe7\x63\xda\xb5\x04\xb7\x71\x83\x11\x13\xd0\x51\xe2\xb8\xa8\x0d\x3b\x84\xde\x61\xbc\x63\x68\x15\x68\x0b\x54\x87\x14\x37\x71\xb8\x8d\x9a\xf3\xb1\x01\x59\xc6\xa6\xfd\xd4\x9c\x03\xf6\x22\x78\x66\x2f\x31\x41\x6c\x8a\x31\x48\x08\x2e\x3b\x0a\x8f\x0c\xe1\x10\x7d\x3b\xbe\x87\xa8\xce\xe0\x39\xde\x46\x4f\x91\x75\x81\x9b\x0c\x11\x90\x94\x30\xb3\xae\x74\x1c\xbd\x0c\xb3\x0d\xe1\x0b\xac\x29\xe9\xcf\x00\x37\x20\x45\xf4\x2d\xde\x1c\xac\xa4\xe3\x13\x7e\x19\x2e\xa0\x4e\xb7\x1f\xba\x0c\x9b\xf2\xf7\xdf\x7f\x5b\x09\x5c\x5a\x7e\xbc\xbb\xa8\x39\xdb\x19\x24\x30\xc7\x2d\xbc\xb0\xeb\x0c\x6b\x1a\xaf\xfe\x0f\x46\xcf\x87\x95\x01\xcb\xcf\xac\x84\xb9\x83\x14\x9f\x10\xc8\x29\x9f\x90\x44\xe9\x61\xf5\xf1\x87\x10\xac\x9f\xf0\x2d\x6f\x41\x05\x49\x50\x16\x21\x9f\x56\x08\xeb\xec\x12\x7d\x5b\x6f\xa3\xe8\x5b\x4f\x3c\xfa\x38\x88\x9f\xe1\x9e\x31\xfa\xf8\x83\x0b\xdd\xcd\xb8\xe4\x56\xd4\x5b\x39\xda\x16\xcb\x20\xbc\xd8\xce\xa5\x2e\xc7\x24\xb7\x21\xde\x82\x34\x9a\x83\x8d\xbc\x35\x5c\x0e\x70\xf2\xff\x30\xa5\x17\xb1\x0b\xa0\x46\x15\xe0\xb7\x4a\x85\xdc\x93\x63\x88\x7d\xa3\x9e\xa1\xf1\xa2\xb6\xb0\x04\x14\xbd\x40\x75\x3e\x62\x0d\xb3\xd3\x99\x00\x2d\xb3\x51\x06\xdd\x23\xf6\xb9\x08\x1b\x2b\x29\x8e\xdd\xb1\xdf\x4a\x87\x9a\x89\x8e\xaf\x28\xa3\xa7\x98\x6d\xba\x4c\x22\x18\xed\x81\x5b\xf7\xe5\x25\x06\x34\xe6\x7b\x24\x6b\xbe\x87\xe6\x7c\x1c\x74\xfb\xc3\x63\x73\x56\x94\xfd\xee\x51\xd2\x82\x5c\x03\x46\xbd\x81\xe8\xcd\x78\x0a\x39\xe1\x7e\x4a\x07\xa6\x31\xf9\x60\x06\x53\xdc\xf6\xfb\xc0\x54\xd7\xbf\xa1\xaa\xc1\x2d\x05\x35\x3d\xe2\x8e\x32\xf2\x71\x34\x1a\x11\x6e\x69\xfa\x85\xf2\x99\x07\x9b\x1d\x09\x18\x0c\x68\xd9\x5e\xfb\x86\x3d\x63\xd6\xad\xb1\xeb\x41\x19\x2a\x2d\x31\x81\x16\xed\xf4\x18\xad\x0c\xd8\x0c\xbf\xd6\x25\x06\x99\x05\x3c\xdf\xf5\xe0\xc2\x9f\xba\x24\x98\x52\x5c\xc5\x91\x30\x4c\x7f\xfd\xf5\x97\x74\x02\xdb\x1f\x6c\xd7\x87\x53\x97\xf7\xfa\x21\x92\xcd\x4d\x8e\xce\x30\x3b\xf6\x5a\xda\x76\x0a\x86\xf5\x11\xec\x83\xd3\xc3\xa5\x37\x6d\x71\x24\xb3\xdd\x37\x67\xc5\x23\x78\x8c\x34\xbc\xe6\x22\x41\x4f\x06\x32\x3e\x30\x39\x08\x22\xd9\xf3\x93\xc5\x4e\x5b\xd9\xb0\xa4\x70\xb4\x09\xe3\x1b\x26\xd0\x8c\xee\x87\xe2\x00\x30\x0b\xa1\x78\xa0\xc2\xf2\x6d\x6d\x42\xa3\xbc\x62\x1e\x4b\xb0\xd9\x1f\x48\x90\x76\x09\x4a\xc3\x04\xbe\x23\xd8\x72\x5f\x61\xb3\x3b\xac\xb7\xf7\x41\x44\xd6\x03\xb9\x59\x38\x53\x1c\x99\xd2\x95\x59\x8f\xcf\xbf\x98\xaf\x72\x0d\xc4\xd3\xaf\xe5\x29\x31\xfc\xf5\xdc\xf8\xf9\x9f\x50\x06\x43\x44\x61\x25\xb9\x11\xe2\xe4\x35\x75\xc1\x1d\x53\xfe\xab\x04\x14\xee\xb3\xef\xdc\x5b\x63\x0e\xe1\xfd\x71\x0e\xc0\xc6\x8b\xbb\x3f\x4b\x79\xcd\xb1\xf2\x72\xd2\x45\x7a\x8e\x59\xb4\x1e\x66\xee\xe2\x36\x01\x7c\x24\x38\x7b\x8b\x6b\x4c\xbf\x33\x46\x79\x8b\xab\xf0\x04\xc8\xe9\x3e\x50\x8c\x1e\xa1\xa0\xa5\xe1\x3b\xc6\x95\xcd\xec\x4d\x3c\xb8\x93\xbe\xcf\xbe\x6f\x0e\x6b\xfe\xaf\xcc\xdf\x1c\x73\x2c\xc7\x75\x43\x77\x87\xa8\x5a\x24\x48\x5e\x40\xc9\xac\xcc\xdc\xcc\xaf\xe7\xe7\xbe\x91\x5f\xce\xcb\x7a\x13\xbf\x7e\x45\xfa\x21\x85\xb8\x45\x5c\xd7\x47\xdf\x02\x16\xdb\xa8\x5b\x6a\x8e\x12\xf7\xa0\x6b\xe0\x26\x89\x65\x77\x89\x9b\x6a\x5c\x35\x25\xa4\x70\x99\x18\x6f\xd7\xdb\xf5\xd6\x26\xc3\xc3\xc0\x68\x62\x3e\xd4\x0c\x06\x3d\x75\x55\xd2\x3f\x9b\x71\xe8\x5d\xf4\x9f\xd1\x9f\xd1\x9f\xc7\xd1\xba\x9b\x2e\xdb\xe4\xac\x29\xe6\xf9\x58\x81\xb3\x70\xb1\xf6\xdc\x6f\x9a\x53\x03\x8c\x84\x4f\x0b\x88\xf1\x65\x16\x72\xe7\xd5\xcf\xb3\x86\x6e\x0e\xdd\x63\xaf\x66\x50\xed\x66\xc7\x8f\xe4\x3e\x32\xf6\x58\xb4\x20\x91\xde\x33\xf1\xb9\x0c\x7e\xb1\xd8\x18\x06\x22\x7c\x65\xbe\x54\xe5\x05\xd6\xc6\x89\xf2\xcc\x7e\xcf\x4f\x20\x41\x75\xe1\x98\x44\x85\x5f\xe0\xd1\x32\x21\x86\x62\x4c\x4a\x79\x89\x2d\x70\xc4\x78\x27\x9e\xfd\x93\xcc\x5a\x50\x14\xca\x24\x2f\xa6\x24\x85\x59\x37\x78\xf5\x11\xb1\x2a\x28\x3b\x80\x1a\x75\x0d\x8c\x1b\x58\x1b\x17\x6c\xee\x0e\x78\x8c\x6e\x34\x59\x5c\x6d\x8d\x17\x2d\x7a\x11\x59\x2c\x76\x25\x87\x7c\xcf\x70\x7f\xc3\xc3\xe0\x89\xea\xbb\x24\x1f\x97\x1a\x4b\x31\x97\xf6\xc8\x82\x8a\xbc\xc4\xaf\xf1\x09\x65\x19\xac\x15\x0e\x7d\x6a\x2a\xde\x35\xe7\x80\xe0\x12\x65\x01\x0b\x3b\xb4\x84\xd5\xc3\x94\x79\x1a\x66\xc2\x26\xf2\x47\x05\x33\x04\x82\xef\x15\xaa\x43\xa1\x45\x76\x87\xe6\x7c\x7f\x71\x4e\xd1\x72\xf7\x07\x4e\xc2\xe1\x3a\x90\x00\x02\x02\xb5\x1b\xea\x00\x9a\x85\xf8\x70\xee\xd6\x06\xa4\x2c\xc6\x5d\xbb\xc6\x87\x48\x4c\x26\x1b\xdf\x81\xe7\xe8\x31\x7a\x74\xd2\x0c\x50\x55\x5c\x24\x7d\x2a\x6f\x73\xaf\xff\xc3\x1c\xd1\x38\x65\x94\x55\x91\x3b\x01\xd2\xd3\x19\x0d\x88\x10\x0a\xb1\xe1\xdb\x31\x55\x35\x22\xa5\xa0\x0e\x29\x2e\x8a\x12\x3a\xb1\x22\x15\xa3\xe9\xca\x32\x4c\x41\xc3\x6f\x42\xd7\x04\x4a\x04\x68\x3d\x1b\x46\xc5\x73\x2a\xda\xb0\x67\xcc\x3b\x11\xcb\xad\xd3\x03\xd3\x6d\x14\x99\xb2\xcf\x17\x3f\x5c\x22\x8f\xed\xd3\x72\xaf\xc1\xae\x0f\x0e\x3d\x39\x58\x7b\xee\x61\xff\xec\xcb\x3d\xf0\x90\xd3\x62\x60\xa7\xa4\xa5\x25\x22\xe6\x89\x5c\x4e\x77\xca\x50\x78\x56\x29\xa5\x50\x25\xc5\x88\x1b\x16\xe9\x87\xbd\x44\x07\x2c\xe4\x25\x27\xc0\xf3\xa0\x4a\x1a\xc1\x41\xd2\x48\x0e\x6c\xf3\x7c\xf5\xe1\x2c\x93\x30\xbf\x60\x90\xf1\x2d\x8f\xfe\x25\x47\x81\x4b\xba\x74\x01\xc4\xd6\x44\x81\xa9\xb8\x38\x49\xcb\x6d\x19\x13\x0c\xa3\xaa\xf9\xb1\xd9\x1d\xbe\x19\x3a\xcc\x93\x3a\x9b\xe8\xcb\xae\x97\xad\x92\xe3\x4c\x61\x0d\xb9\x8e\x69\x7a\x92\x35\x91\x16\x6b\x33\x21\x1a\xf7\x7e\xeb\x18\xe7\x61\xb1\x4f\x92\x44\x1d\xec\xd9\x7a\xce\x9d\xfb\xa5\x43\xda\x7e\x50\xbe\x7d\x76\xf6\x41\x2f\x04\xec\xd9\x31\x28\xce\x9b\x2a\xce\xda\xdc\x98\xb2\x79\x41\x95\x39\xbf\xe9\x0a\x89\x1a\x15\xaa\x8a\xdf\x38\x28\x07\xd8\x34\x75\x71\x1f\xd4\x38\x6c\x61\x03\x81\xbe\xe4\x91\x6c\x2f\xa4\xb7\x11\x8f\x82\xf0\xf0\xd4\x9c\xbd\x4c\x98\x5a\x38\x2c\x25\x9f\xd9\xe7\x2e\x0e\xe0\x87\x64\xda\x86\xdc\xb7\xb1\xbb\xbb\xf1\x76\x72\xe8\xb1\xca\xf1\xe4\x9a\x9b\x7d\x03\x5c\x0e\x3c\x43\x7b\xc3\x1d\xed\x12\xb8\x6c\x51\x03\xf0\xc2\x23\x19\xa0\xaf\x39\x14\x93\x41\x14\x84\x3f\x22\xcf\xb1\xf4\x24\x87\x8b\xb6\xfc\xd2\xcc\x5c\x3f\xab\x80\xa9\xf7\x6a\x60\xf9\x82\x08\x4a\x50\xc9\xb6\xb7\x57\x0f\x2e\x54\x75\xfd\xc2\x9d\xec\xa9\xac\xaf\xc2\x91\x76\x51\x68\x96\x1a\xd7\xba\x16\x5a\x44\xc4\x5c\x04\xff\x39\x94\x20\x9b\x16\x17\x2d\x24\x24\xb4\x96\xba\xef\xf6\xfb\xfd\xa8\x4d\xa4\x24\xed\x6c\x6a\x5e\x56\x18\x7b\x7f\x7a\x44\x44\xea\x4f\x2c\x50\x67\xc6\xdc\x16\x81\xd9\x81\xf0\x2c\xc8\xcc\xb8\xb1\x05\x81\xfc\xc2\xb2\x1f\xbd\x8f\x26\x6f\x49\xbf\xe6\xc8\xa0\x34\x86\xee\x2a\x49\xad\x7a\x41\x4e\xf8\x35\x04\x1d\xc5\xec\xdc\x02\xe3\x40\xd4\x5b\x2d\xe0\xc2\xa4\xa3\x94\xed\xbe\x91\x78\xdf\x66\x2b\x07\xd9\x05\xb8\x60\xa5\x79\x80\x84\xec\xd9\xdf\x77\x8c\x2b\x35\x40\xd2\x73\x10\x02\x0a\x24\xc2\x5b\xac\x40\x01\xd7\x5f\x47\xad\x08\x5f\x5b\xd0\xcc\x84\x64\x8e\x40\x4c\x5d\x54\x47\xa0\x8c\x9e\xe3\xd6\x9d\x5a\xb9\x66\x22\xd6\x3c\xfc\xa7\x92\x0c\x33\xd8\xbe\x9c\xf8\x0d\x29\x06\x2f\x8e\xb9\x85\xbc\xb6\xf7\x75\x9b\xc7\xc8\xcd\xcc\x61\x4d\x71\x73\xed\x96\x7e\x15\x59\xfc\x0b\x88\x7e\x39\x45\xcd\x36\xdc\x78\x1e\xb7\xa4\x1d\x46\xad\xce\xf4\x65\x0e\x52\x18\x1a\x16\xb3\x3f\x1a\xcf\xb8\x7b\xe8\xfa\x75\x81\x02\x7e\x22\x15\xfa\x05\x77\x7b\x2a\x04\x6d\x0f\x64\x79\x9a\xfe\x46\xa2\xb7\x6a\x02\x17\xc1\x1b\x14\x84\x73\x6e\xae\xac\x3a\x0b\x90\xed\x19\xf5\x7e\xc4\x92\x4d\x67\x03\x76\xe8\x5f\x2a\x7d\x77\xc2\x66\x21\x8b\xd1\xdc\x6f\xb7\x2b\x0e\x01\x52\xda\x81\x92\x07\xf5\x52\xcd\x98\x67\x55\xb4\x61\x8b\xe5\xdd\xef\x57\x13\x1b\xdc\x4d\xa5\xb8\xcd\xe1\x68\xe9\x84\x52\x60\x6d\x73\x1a\xaa\xef\x0c\x04\x66\x81\x95\xf2\x56\x27\xcc\xfc\x03\xed\xa6\x35\xa0\x80\xad\xc8\xfd\xf7\xd1\x32\x73\xd3\xdd\x4e\xe0\xb2\xd2\xfc\xe4\xac\x1b\x51\xbb\xc4\x71\x7a\x9c\x42\x8b\xfe\x99\xe7\x09\xc6\x78\x5d\xf6\x65\x75\x42\x1c\xd2\xd1\xff\xa6\xc4\xe9\xb3\xd1\xff\x51\x6b\xdf\xa4\xb8\x11\xbd\x00\xb6\xec\xc3\xc4\x3e\xd6\x67\xdf\x27\xa2\xfa\x35\xd8\xcb\xfa\x5f\x90\xf8\x9d\xf8\x4f\x4f\x43\xfe\x66\x7c\x71\x49\xf0\x39\x24\x27\x90\xe1\x57\x76\x8b\x82\x88\x27\xae\xee\xf2\x3c\x0f\x50\x4d\xa0\x1e\x83\x19\xb3\x37\x7d\xe3\x3c\xcf\x8f\x53\x52\xe8\x38\xa5\xd6\xc6\xec\xcb\xd3\x7e\x12\x1f\x35\xe3\xbb\x8b\xf4\x48\x99\x8b\xda\x14\x38\x48\x79\x30\x77\x99\x89\x53\x51\x33\x72\x7b\xbd\xb6\xa4\x06\x03\x4c\x5f\x89\x60\x60\x6d\x51\xf3\xd2\xb0\x33\x26\x51\x69\x68\x2a\x5d\x21\x60\x8d\x57\x54\x74\xec\x42\x9e\xc3\x74\xf1\x9c\x11\xad\x83\xbf\xb8\xde\x0f\xcf\xc8\x82\x27\x33\x6d\xc3\x74\x34\xb4\xee\x0e\x87\xb5\xf8\x6f\x63\x74\xb5\x1e\x78\x31\x41\x93\xd8\xa7\xe6\x1c\x98\xb8\x8f\xf7\xbd\x04\x6b\xbd\xae\x62\x56\x3b\xbb\x44\x4c\xc4\xb9\xad\xb3\x9f\x99\x03\x68\x1e\x42\x08\xa8\x5d\x55\xc4\x39\x4e\x3b\xb2\xb6\x8d\x88\x42\x81\x65\xe5\xce\xbb\x9a\x82\x16\x52\x51\x5c\xe0\xb7\x89\xd7\x7e\xfa\x02\x0c\x9f\x6b\x03\x5a\x58\xd3\x71\x8b\x47\x7d\xec\x86\xe1\xad\x4a\x0c\x22\x03\xe4\x04\x33\x71\xbb\xfb\x36\x61\xab\x52\x1d\x33\xae\x63\xbe\x51\xb9\xdf\xf6\x8b\x6c\x6f\x93\x97\x63\xec\xbc\x2b\x4b\x92\xb6\x10\x5a\xcc\xf1\x2e\x4a\x57\x1a\x50\x88\xfb\x8c\xbf\x1f\x2d\x93\x53\xd2\x77\x72\xda\x9b\x6d\xbf\x9a\xcb\xb2\x34\x61\xef\xb8\xb8\x8a\x16\xb7\xde\xe0\x64\x30\x07\x5d\x49\x0d\x6b\xa9\x75\xb1\xf1\xbc\x89\x64\x1e\xb7\x92\xce\xb3\xf8\x31\xa6\xdc\x4e\x40\x41\x24\xfa\x89\x82\xcd\xf6\x49\xf6\xc9\x9e\xc6\x01\x43\xa2\xaf\x46\xff\x04\xee\xc7\x6c\x65\x41\xdf\x76\x7f\x2d\x42\x7e\x67\xcd\x0d\x40\x26\x94\x5a\x62\x40\xbc\x53\x15\xb5\x35\xaa\xc1\x33\xe3\xb2\x97\xea\x0b\x8b\x6e\x0f\xac\x26\x17\xc1\x91\xbe\x9b\x2e\xfd\xb8\x11\x76\xc3\x6c\x7e\x23\x20\x75\x29\x3e\xf0\xca\x13\x19\x3e\x96\x08\xe9\x5b\x03\xfb\x9e\xfb\xb1\x6b\x52\x2b\x22\x2f\xf2\xfd\x78\x71\xb8\xaf\x31\x28\xa6\xdc\xe1\x9e\xcf\x58\x28\xcf\x69\x08\x08\xcf\x79\x08\x90\xaf\xb0\x86\x0b\x23\x44\x45\xf4\xd7\xf6\xbb\x72\x70\xdf\xd2\x99\x50\xf1\x93\xd4\x3d\x31\xe3\xe7\x28\xdb\x83\xc7\x4f\xd1\x74\x08\xba\xae\xc7\x57\x76\x7b\x2f\xb2\xd2\x36\xa1\x1d\xba\x13\x9e\x7c\x86\xaf\xd7\xeb\xa1\xe4\xd8\x0e\xe6\xf2\x47\x73\xee\x0b\xac\xec\x97\x63\x8e\xff\x51\xa2\xb8\x04\x84\x86\xe9\x09\x95\xd9\x45\xf5\x79\xf5\x0a\x84\x8c\x34\x85\x0d\x28\xc5\xf5\x7a\x0e\x10\x5c\xa6\x7c\xbd\x83\xaa\x02\xa3\x6d\xd5\xeb\x09\x51\x18\x92\x06\xa4\x30\x6e\x5a\x38\xde\xf6\x87\xe6\x1c\x6c\x47\x5d\x31\x7c\x63\xb5\xfa\x9f\x06\xd6\xc1\xff\x82\x9a\xac\xd6\xab\xff\x82\xe5\x0b\x64\x16\x3b\xf8\x6f\xd8\xc1\xd5\x7a\x7c\x5e\xff\xd9\x22\x50\xae\x09\xa8\x49\x48\x60\x8b\xf2\xa3\xda\x4a\xed\x9c\xa5\xf0\x78\x7c\xf6\x36\x7a\xd4\x5d\xd2\x11\x5f\xb1\x13\x4c\x19\xd9\x2b\xb3\xea\x07\x5d\x4a\x41\xae\x39\xdb\xbd\x10\x1f\x47\xf9\xc3\x03\x79\x7b\x15\x9e\x7d\xcb\x8c\xf4\x65\x9a\x48\x11\x08\x51\x65\x31\xb8\x7d\x66\xbd\xc8\x3d\x49\xfd\x00\x91\xd7\x55\x52\xa3\x79\x55\x7c\x34\x61\x42\x75\x0d\xdb\x79\xef\x7d\xaa\xfb\xfb\x4a\xe3\x23\x94\xe9\x64\x3d\x99\x4e\x16\x87\xe4\x1f\x3a\x26\x18\xff\x34\x37\x32\x39\x3c\x3f\x3f\x2d\xc3\x31\xf9\x3d\x47\x5b\x3b\x3f\xfa\x8a\x28\x85\xe6\x07\x23\x51\x04\x52\x98\x2d\x42\xb1\x71\x7b\xb0\x73\x2b\x30\x2e\x4a\xd8\x94\x1d\x31\x18\x66\xd9\x43\xb2\x7f\x5e\x8a\x65\xe3\xb9\xb3\xf3\x6c\x78\xaa\x03\x12\x6a\xb0\x4c\x93\x5d\xb4\x7b\x5c\x88\x64\xe3\xb8\x5f\x7d\x6c\xd8\xb5\x6c\xfa\xc1\xf5\xf0\x30\x7c\x1a\x27\xc4\x9e\x82\xa4\x84\x47\x49\x33\xcb\x42\x1d\x29\x14\x2e\x69\x09\x41\x1b\x27\x98\x9e\xa6\x69\x5d\x34\x6f\x59\xe9\x9a\x98\x9c\xe5\x43\xb4\xac\xb2\x78\x65\x10\x3e\x17\x6a\x4b\xdb\xf7\xef\xa3\xdb\xc9\x23\x34\xf4\xce\xae\xe6\x68\x38\xce\x43\xde\xd1\x3a\x64\x7d\xab\x7a\xc8\x83\xa3\x67\xab\xed\x8b\x8a\x9a\x9a\x12\xef\x5a\x66\xb9\xcc\xba\x9b\x02\x55\x33\x0f\x8d\x94\x28\xb3\x14\xe8\x14\xc0\xa6\x85\x2f\x3d\xa0\xb7\xf0\x86\xea\x13\x6c\x11\x9d\x69\x88\x34\xa1\x2c\x34\x29\xaa\x50\xcd\x62\xb5\x3a\x9d\x27\x3d\x0b\x6c\xaf\x66\xda\x4b\x8f\xae\x9d\x73\x62\xf8\x76\xd1\x89\xb4\x70\x47\xaf\xea\x2e\xb5\xb5\x6e\x6f\x44\xdf\x2f\xaa\x8a\xcd\xd4\xa3\xf4\xcb\xda\x7e\x37\x72\x9b\xaf\x95\xe7\x3f\xd3\xe5\x6b\xda\x2a\x23\xd5\x2b\xe7\x24\xb8\xfd\x55\x2c\xee\xf0\x66\xec\x49\xeb\x9b\x66\x95\x8e\x34\xbd\x3c\xc5\xcd\xa9\xa3\xe9\x4a\xc4\xfb\xb2\xbf\x55\xe3\xd7\x16\x34\x96\x22\x97\xa3\x7d\xa6\xc4\x80\x39\x00\x9b\x02\xe5\xf7\x41\x9f\x5e\x17\x7f\xa6\xfe\x23\xb9\x67\xc4\x73\x21\x82\x79\xad\xe1\x1f\x9d\xd5\x25\x92\xc4\xdb\x7c\x22\x67\x87\x9b\x28\x4e\xea\xce\x0a\xdb\x17\xab\x73\x65\xd6\x1c\xbc\x1f\x23\x78\x0e\x70\xe0\x3c\xd8\x34\xcb\x04\x8c\x0f\xc1\x57\xde\x04\xd8\x61\xea\x5a\x9c\x72\xdc\xbd\x78\x85\x5b\xc3\x11\x1e\xab\xb2\xe6\xf4\x95\x7e\x47\x21\x50\x6a\x22\xc9\x11\x59\xe8\x35\x53\xd9\xbb\xe6\xe5\x52\x5b\x73\x91\xa6\x1b\xa5\x6f\x74\x7f\x18\xf4\x79\xc9\xd5\xba\x09\x76\x2f\xd5\x7f\xa5\x24\x27\xd6\xec\xf1\x52\xdf\xaa\x22\xdd\xb7\xbd\x30\xb7\x22\x64\xd7\x64\x5c\x86\xb5\x0d\x6b\x16\xc1\xd2\x5d\xf7\xcf\xb8\x19\xbe\x99\x6e\xec\x9f\x02\x2d\x5b\xa6\x81\xbc\xa0\xa1\x10\x56\x0d\x7d\x9b\xfe\x2f\x29\x53\x93\x39\xca\xe0\xf8\x4d\x70\x9f\xcc\x1b\x1e\xcc\x0e\x36\x26\x7e\x2c\x7e\xf2\xfa\x79\x42\xb2\xc7\xc4\xea\x24\x80\x0f\xd1\xd1\xd2\x90\x1d\xfd\x83\xee\xa0\x58\xd1\x06\xd5\xda\xce\x09\x87\xa0\x1e\x18\x19\x5a\xd5\x0a\xa0\x29\x56\x2b\x8c\xd7\x9b\xe0\x9b\x1d\x44\xe3\x2c\x3d\x5d\x08\x1e\x48\xbc\x0c\x6e\x09\x90\xb2\x19\x1d\x81\xaa\xbf\xb9\xd6\x47\xfb\x9e\x18\xa1\xf2\x7f\x7d\x83\x89\xe0\x9d\x83\x4c\xfa\xe0\x61\x94\x27\x37\x8c\xac\x22\xed\x27\xef\x02\x5f\x7b\x81\x1c\x12\x60\xc0\xf9\xa5\x60\x12\xd8\x25\x75\xcc\xf9\x6b\x31\x73\x2b\xd4\x4b\x21\xe6\xcc\x67\x67\x3d\x76\xc7\x06\xbb\x11\x7c\x2b\xb6\x7d\xf4\x1c\x7d\x9b\xf9\x2a\x7a\x00\xb8\x82\xfd\x74\x30\x33\xec\xe7\xb8\xdf\xc2\x5c\x48\xda\x17\x96\x16\xae\xe7\xbe\xbe\x65\xb3\xae\x40\xf2\x8b\xb4\x99\x6a\xdf\x2e\x6c\x4d\x72\x5f\x01\x2d\xbb\x7e\x03\x41\x77\x42\xfd\x7a\x62\xd6\x1c\xfa\xd5\x64\xdc\xe7\xaa\xea\x56\xa9\xc7\xc2\x11\xb5\x2c\xa4\xa4\x1d\x5d\x5f\xe6\x1d\x3d\xec\x65\xf8\xd2\xe5\x1a\x0a\xd7\x57\xa1\x8b\xeb\x31\xb8\x10\x0b\x64\xdc\x45\xc2\x2d\xae\x9e\x39\x5f\x81\xe4\x97\x71\x0e\xfe\x79\xb1\xbe\x95\x8c\xd9\xef\xfa\x29\x21\xbe\x8d\xc2\xc7\xbf\x02\x00\x00\xff\xff\x90\xd1\x24\xe0\x92\x4f\x00\x00"),
-		},
-		"/favicon.ico": &vfsgen۰CompressedFileInfo{
-			name:             "favicon.ico",
-			modTime:          time.Date(2019, 3, 26, 11, 30, 9, 93352233, time.UTC),
-			uncompressedSize: 16958,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\x3b\x4f\xdb\x6e\x14\xc6\x9f\x70\x09\x4a\x10\xfa\x6f\xff\x39\x23\x0b\x0b\xcc\x88\x10\x71\x5b\x81\x11\xf1\x09\xa8\xb8\x2f\x95\x00\xb5\x02\xd6\x4a\x55\xc5\xc2\xc4\xd0\x89\x0f\xc2\x5d\x7c\x04\xae\x1b\x48\x2d\x11\x85\x05\xc2\xa9\x1e\xd7\xaf\xe5\x14\xbb\x49\x5c\xaa\xd7\x8e\xcf\x91\x1e\x59\x75\xed\xf0\xfc\xde\x73\xec\x37\xf8\x3d\x06\xc8\x20\x83\x62\x91\xdb\x02\xba\x4b\xc0\xff\x00\xba\x01\x14\x01\x7c\xc0\xaf\xfd\x61\x21\x22\x2a\x95\x4a\xa5\x52\xa5\x4e\x00\xde\x01\xb8\xe5\x54\xd8\x80\xca\x00\x3e\x02\xc8\x39\xd3\xab\x5d\xe5\x5c\x2f\xe5\x06\x19\x6e\x5d\xf6\x9b\x06\xcf\x33\xfa\x06\x60\x07\xc0\x67\xcb\xda\x71\xbd\x44\x61\xa8\x62\xcf\x64\x32\xd2\xda\xda\xfa\x4a\x2d\x2d\x2d\x61\xe7\x57\x62\xa2\x57\xde\xe8\x39\x88\x85\x8c\x41\xc7\x73\xff\xc0\xc0\x80\xac\xae\xae\xca\xda\xda\x9a\xa7\x95\x95\x15\x99\x98\x98\x90\x8e\x8e\x8e\x28\xe3\x6b\x45\xf4\x4a\xcf\xf4\xee\x67\x21\x1b\x19\x83\xc6\xa0\xad\xad\x4d\x36\x36\x36\xe4\xe9\xe9\x49\x2a\x95\x8a\xa7\xf3\xf3\x73\x99\x99\x99\xf1\xce\xe1\xb6\x50\x28\x48\x4f\x4f\x4f\xac\x44\x4f\xa6\x4e\xe9\x91\x9e\xcf\xce\xce\xaa\x58\xc8\x46\x46\xb2\x06\xf1\x6f\x6e\x6e\x3a\xc7\x31\x5e\x5e\x5e\xe4\xea\xea\x4a\x16\x17\x17\x25\x9f\xcf\x7b\x9f\x3b\x32\x32\x22\x47\x47\x47\x72\x77\x77\x17\x2b\x9d\x9c\x9c\xc8\xd8\xd8\x98\x97\xa7\x5c\x2e\x27\x73\x73\x73\x72\x79\x79\xe9\xb0\x30\xc8\x46\xc6\x5a\xfc\x86\x7d\x69\x69\x49\xda\xdb\xdb\xbd\xeb\xa9\x54\x2a\x39\x3f\xc7\x7c\x5e\x9c\x82\x9e\x4e\x4f\x4f\x65\x78\x78\xd8\xab\x03\x7a\x9f\x9f\x9f\x97\x8b\x8b\x0b\xe7\xff\xeb\xe5\xbf\xbe\xbe\x96\xe5\xe5\x65\xe9\xec\xec\xf4\xf2\x3e\x34\x34\x24\xc7\xc7\xc7\x5e\x7d\xc4\x31\xe8\x8d\xf9\x61\x8d\x9a\x3a\x60\xed\x2e\x2c\x2c\x38\xf9\xac\xc5\xbf\xbe\xbe\xee\xd4\x0b\xd9\xb3\xd9\xec\xab\xbc\xc7\x99\xdd\x44\x58\x1d\x70\x0c\x78\x2f\x23\x63\x10\x3f\xe7\x86\xe9\xe9\x69\xe7\x7a\xf7\xe7\x7d\x70\x70\x50\x0e\x0f\x0f\x13\xc1\x6e\x82\x5e\x59\xab\xcc\x9b\xbf\x0e\x78\x3f\x98\x9a\x9a\x72\x58\x83\xe6\x8d\xae\xae\xae\xaa\xfb\x7c\xb1\x58\x74\xee\x75\x49\x62\x37\x11\x34\x06\xdc\x92\xb1\xd6\xfc\xc9\xe3\xfa\xfb\xfb\x65\x7f\x7f\x3f\x91\xec\x26\xe8\xfd\xe0\xe0\x20\x74\xce\x0f\x63\x67\xde\x79\xde\xf3\xf3\xb3\x6d\x84\xbf\x0e\x8e\x01\xaf\x5f\x5e\xc7\xf5\x8c\x01\xbf\x47\xec\xed\xed\x25\x3a\xef\xbf\x07\x59\x58\xcb\x64\x0b\x60\xfe\xe1\xff\x77\x6f\x6f\xaf\xf3\x7d\xa2\xd9\x82\x4c\x64\x0b\x60\xff\x04\xe0\xd1\xec\xeb\xeb\xeb\x93\x72\xb9\x6c\xdb\xee\x9b\x07\x99\xc8\xe6\x63\x7f\x74\xd9\xf3\x00\xbe\x9a\xdf\xa3\x52\xc2\x5f\x71\x99\xf3\xee\x33\x84\xad\x14\xf2\x6f\xf9\x9e\xa1\x28\xbf\xf2\x2b\xbf\xf2\x2b\xbf\xf2\x2b\xbf\xf2\xdb\xb6\xfb\xe6\xa1\xfc\xca\xaf\xfc\xca\xaf\xfc\xca\xaf\xfc\x55\xfc\xff\x01\xd8\x4d\x21\xff\xae\xcb\xfe\x05\xc0\x7d\x0a\x9f\x7f\xde\xbb\xec\x0f\x69\x78\xfe\x4d\xa6\x80\xe7\xdf\x0f\x41\xeb\x1f\x49\x5c\xf3\x0b\x8b\x46\xd7\x3f\xe0\xeb\x85\x69\x86\xf5\x2f\xb2\xd7\xbb\xfe\x95\xe6\xf5\xcf\xb4\xaf\x7f\xa7\xbd\xff\xa1\x99\xfa\x5f\x46\x47\x47\x23\xf5\xbf\x68\xff\x53\x7d\xfd\x6f\xac\x03\xdb\xfd\x6e\xff\xa2\xff\x2d\xac\xff\x71\x76\x76\xb6\xe9\xfb\x1f\xff\xd4\xff\x3a\x39\x39\x99\xb8\xfe\xd7\xf1\xf1\xf1\x86\xfa\x5f\x11\xad\xff\x59\xe2\xd8\xfb\x8c\xc6\xfa\x9f\xa3\xf6\xbf\x53\xdf\x01\x6c\x5b\xec\x7d\xdf\x76\x3d\x44\xf5\x7f\x13\xf1\xfd\x07\xc3\xfe\x1e\x40\xd6\xe2\xbb\x0f\x59\xd7\x43\x94\x31\x70\xde\x7f\xb0\xfd\xfe\x89\x4a\xa5\x52\xa9\x54\x96\x14\x29\x42\xff\xa0\x80\x86\x95\x88\x9a\xc7\x9f\x01\x00\x00\xff\xff\xb6\x8d\xc8\xfb\x3e\x42\x00\x00"),
-		},
-		"/fonts": &vfsgen۰DirInfo{
-			name:    "fonts",
-			modTime: time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-		},
-		"/fonts/lg.eot": &vfsgen۰CompressedFileInfo{
-			name:             "lg.eot",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 4024,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x4b\x6c\x5b\xc7\xd5\xfe\xce\x0c\xc9\x2b\x91\xf7\xf2\xfd\x90\xac\x07\x5f\x26\x69\x53\x32\x29\xe9\x92\xa2\xe3\xd7\xaf\xd8\xf9\x93\xaa\x12\x6d\xc9\x25\xd9\xc0\x2d\x9a\xd2\x0a\x4d\x2a\xa5\x45\x41\xa2\x9a\x78\x61\x80\x01\x62\x34\x40\x12\xd4\x40\x5f\xf1\x2a\x45\x51\x14\x85\xcc\x06\x46\xdc\x45\x16\x05\xea\x55\x11\x04\x5d\x35\xb4\xb2\x28\x0a\x38\x4a\x16\x4e\x13\x64\x51\xa0\x5d\x04\x15\x55\xcc\xbd\x57\xaa\x6c\x2b\x4e\x07\x9a\x39\xdf\x9d\x33\xf7\x9c\x6f\xbe\x99\x4b\xcd\xbc\xeb\x00\x0e\x39\x00\x02\xc3\x83\x85\x70\x9d\x84\x9d\x9d\xc3\x23\x1e\xbd\x7c\xf8\xc6\xcc\x6b\x0f\xfb\x00\x13\xea\xa8\x02\xb0\xe3\x3c\x2a\xa8\x62\x0d\x75\x94\xb1\x02\xc0\x8f\x12\x2a\x58\xc1\x2a\x16\xd1\xc0\x12\x42\x18\x47\x0a\x63\x7b\xde\x31\x82\xdb\xd0\x02\xc7\xd8\xb9\x6f\xa5\x27\x1c\x6e\x8b\x1b\xc0\x1f\x00\x7c\x7f\xe1\x72\x79\xf9\xd5\xcf\xd4\x06\x40\x83\x00\x7e\x5d\x2d\xaf\x2e\x03\x70\x02\x74\x07\x40\x4f\xb5\x7e\xe5\xd2\x85\xde\x91\xf7\x01\x7a\x0f\xe8\xbd\x5e\xab\x94\x9f\xef\x6d\xfe\xfb\x14\x60\x7b\x16\xc0\x64\xad\x56\x29\x4b\x6f\xf3\x0d\xc0\x76\x1d\xc0\xc1\xda\xe5\xe6\x4b\xdf\x84\x34\x0f\xd8\x7e\x0f\xa0\x50\x6f\x2c\x94\x5d\x3f\xf4\x3e\x0b\xc8\x3d\x00\x46\x2f\x97\x5f\x5a\x46\x10\x3f\x02\xe4\x0c\x80\xd0\x52\xf9\x72\xe5\xcb\xdb\x6f\xe5\x01\xb9\x00\xb0\x77\x96\x1b\xab\x4d\x70\x41\xda\x21\xc6\x87\xc0\xf9\x27\x74\x1d\x66\x80\xdd\x60\x7f\x06\xf0\x63\xdd\xd2\x67\x50\xa9\xf7\x61\x11\x9d\x0f\x0b\x37\x05\x7c\x6a\xe2\x77\xb6\xef\x60\x8a\xdf\x11\x4f\xbb\x42\x43\x8b\x2e\x0a\x37\xea\x20\xc8\xb0\x5c\x1b\x35\x08\x13\x5e\x05\x30\x0c\x27\x38\xec\x20\x84\xee\x1d\xb8\x37\x74\x4f\xbd\xb7\x7c\xef\x67\xf7\xfe\xf1\xd1\xf6\xa6\xf2\xb1\xfb\xbe\xf2\xa9\x69\x7b\x6b\x7b\x5b\x8f\xb7\xeb\xff\xa9\xe6\x97\x3f\x76\xdd\x57\x3e\x25\xcd\x4f\xdb\x1f\x07\xff\x1e\xbc\x1f\xfc\x20\xf8\x8b\x60\x33\xd8\x37\x2c\x0f\x63\x70\xab\x8f\x07\x9c\x46\xb6\xc7\x15\x02\x6d\x6f\xc3\xb1\x67\x1c\x03\x72\x4f\x10\x1e\x98\xcf\xe3\xfb\x4c\x28\x81\xf8\x3a\x2f\x82\xc3\x83\x28\x12\x00\xb9\x54\x97\x34\x91\x9b\xcc\x66\x12\xf1\x68\x44\xb2\xf8\xbc\x01\xbf\x67\x22\xe0\xf7\x79\x25\x4b\x34\x92\x88\x67\x33\xb9\x49\x8f\x4b\x75\xb1\x64\xa9\xf5\x7a\xa5\x52\xa9\xbc\xae\xb7\xb7\xae\x5e\xbd\x7a\xf5\x96\xd6\x8e\x95\x88\xa8\xd0\x6d\xb7\x77\x9d\xaf\x57\x2a\x1c\x3b\x6e\xd1\x6e\xb5\xc5\x00\xf0\xdd\xfc\x0e\x04\x11\x01\x62\x5f\x9b\xd9\xcc\xf0\x15\x69\x2f\x10\x8a\x8f\xc9\xf8\x73\x6a\xdd\xd1\x57\xb6\x08\xf0\x9b\xbc\x08\x2f\x0e\xe1\x24\x40\x61\x91\xc4\x25\xb2\x84\x45\x1a\x57\x26\x37\xa9\x4a\x2e\x6f\xc0\xaf\x86\x2d\x7b\x3b\x63\x61\xc1\x4a\x77\x08\x9e\x2e\x41\x94\x5a\x74\x33\x1b\x8b\xc7\x63\xd9\x6e\x31\x1b\x8f\xc5\xe2\xd9\xbb\xb2\xe2\x62\x07\xed\xe1\xf0\xe1\x6e\x31\x1b\x8b\xf7\xf5\xb1\xdf\x74\x8b\x6e\x59\x51\x64\x37\xdd\x74\x29\xb2\xac\xb8\x78\x31\x16\xcf\x76\x4b\xfa\x78\x5a\xcf\xc6\x63\xef\x6c\xfd\xcd\x2d\x2b\xb1\xbe\xbe\x78\x2c\x4b\x37\x0f\x87\xc3\x2f\x28\xb2\xbb\x5b\x72\x8b\xe1\x6e\x5a\x77\xcb\x8a\xbe\x86\x1b\x78\x99\x27\xd9\x5d\xd8\x00\x92\x02\x52\x42\x4a\xe4\x12\xb9\x40\x8e\x27\xbf\xf8\xe2\xc4\xce\x1f\xbb\xb1\xe7\x01\x84\x22\x8a\xfc\x26\x87\xd0\x97\x34\x3d\x1d\xfa\x94\xb5\x19\xef\xea\x4b\x42\x60\x9a\x54\x75\x8e\x9b\xec\x86\x4e\x79\xab\xba\x29\x18\xbb\x95\xee\x86\xa2\x50\x52\x71\x73\x28\xb2\xdb\x2d\x6f\x6a\x9d\xf2\xa6\xc0\xba\xc3\xa5\xd0\x88\x8c\x47\xf2\x91\x48\x40\xc6\x0a\xfe\x1f\x85\x8d\x95\x54\xc3\x86\x65\x45\xb7\x78\x5d\xc4\x77\xbb\x65\x59\xde\xdc\xaa\xea\x99\xd9\x8d\x4d\x59\x96\xdd\x1c\x72\xf7\xae\xec\xd6\x09\x68\x99\x15\xd9\xed\xd2\x32\x7b\x64\x59\xec\xe6\x75\x8c\xf0\x12\x1f\x01\x87\x15\xc8\x85\x7d\x61\x92\x12\xaa\x4b\x75\xad\xb3\xf6\x56\x81\x61\x7d\xbd\x55\x78\xb9\x44\xa5\x76\x9b\xd6\xbb\x25\xf4\x62\x1d\x45\x5e\xe2\xe2\xbb\x96\x60\x83\x03\x1e\x04\x30\x80\x20\xa2\x40\x2c\xab\xfa\x78\x56\xf5\x51\x56\xf5\xe5\x54\x5f\x54\xd8\xb0\xd1\xa7\x1a\xfd\x6c\xbd\xdd\x6e\x77\x0b\xed\x42\xbb\xbd\x0d\x01\xda\xed\x82\x30\xc5\x76\x9b\xa0\x57\xcd\xb3\xdb\x32\xb4\xdb\xe2\x5b\xdb\x59\x3b\x33\x6c\x70\x21\x00\x90\xea\x8b\x66\xa3\x9e\xac\x30\x22\x72\xd4\x37\xa4\x75\xb1\x52\xa7\xd0\x6a\x15\x3a\xdd\x56\xa7\xd5\xea\xb0\xbb\x9d\x56\xb7\xd0\xea\x14\x68\xb4\x53\x68\xb5\x5b\x85\xce\x57\xc6\x52\xb9\x16\x45\x8b\x95\x75\x69\x2d\x5b\x6f\x75\x0a\x85\x4e\xab\xdb\x2e\x74\x3a\x05\x56\x2c\x74\xb6\xda\x9d\x42\x8b\x3a\xad\x4e\x41\xf4\xb5\xf4\x6f\xa2\x85\x27\xf9\x0b\x7c\x04\x36\x0c\x60\x02\xa0\xa8\x60\x96\x15\x53\x56\x1f\xfe\x35\x08\x07\xa4\x44\x36\xb1\xfb\x49\xaa\xfa\x56\x0a\x30\x94\x92\xa5\x52\xb2\xf4\xc2\xdc\xd1\xa3\x47\x8f\xce\x69\x2d\xcd\x75\xa6\x3a\xf2\x6c\xad\x39\x3f\x3f\x3f\xdf\x6c\xce\xcd\x9d\x96\x19\x89\x41\xa5\x8d\x9d\x21\xa2\xed\x4c\x75\x22\xf2\xe9\xb9\xb9\xa6\x3e\xae\x36\x2b\x3f\xc0\x49\xfc\x36\x25\xf5\x19\x06\xfe\x37\x2e\x94\xdc\xd8\xa8\x7f\x0d\x8f\x91\x64\xfb\x6b\x39\x90\xf8\xff\xc8\x5b\xbc\x08\x55\xfc\x36\xf9\x7d\x5e\x4b\x34\x12\xcf\x9e\xa0\x5c\xcc\x4e\x02\x65\x26\xd5\x09\x7f\x20\x96\x22\x1d\xe9\xfe\x84\x79\x98\x7c\x5e\x8b\x64\x3e\x45\x1c\xea\xf4\xb4\xaa\x4e\xb3\xee\x5f\xdc\xa3\x07\x32\xb3\x33\xd9\xfe\x23\x6e\x1a\x25\x3e\x93\x35\x1e\xba\x1d\xe2\x8c\xd1\x98\x73\x74\x6b\x5a\x55\x67\x66\x54\xc9\x61\xb9\xe5\x72\x09\xbf\xdb\xf9\xb6\xe4\xe8\xc9\xcc\xce\x66\xb2\x33\x1e\xd7\xad\x1e\x87\xd4\xe3\x90\x6e\x39\x5c\x62\xcf\x7b\xb7\x3f\xe2\x9f\xf3\x77\xe1\xc5\x61\x80\x22\x76\xe6\xf3\x0e\x33\x75\xe2\x14\xcb\x66\x52\x2c\xea\x89\x46\x2c\x43\xa4\x4a\x3b\xab\x98\x99\x3c\x4e\x3e\x86\x4b\xb7\xaf\x9d\x3d\x7b\xed\xf6\xa5\x1d\xdb\x9c\x96\xe4\xef\x2a\xe7\xbe\x7d\xee\xdc\x89\xd4\x34\x7f\x37\xbf\xc7\x75\xe9\xf6\xb5\x7c\xf7\x77\x4e\xdb\xe4\xec\x27\x9f\xcc\x1e\x19\xfb\xff\x79\x98\xf7\xe4\x3c\x8b\xef\x60\x09\xaf\xec\x93\x99\xdb\x29\x9a\xa4\xf1\xb1\x88\xae\xce\x71\x4a\xd1\x78\x52\x48\x95\xd0\x34\xca\xa9\x42\x18\x3b\x49\xba\x42\x76\xf2\x79\xfd\x01\x1d\xc7\xf6\x6c\x39\x9f\x39\x49\x51\x3b\x09\xff\x30\xe5\x4e\x51\x22\x45\xd1\x5c\x4a\x44\x96\xb4\xde\xc0\x30\xa9\xc7\x34\xc7\xa3\x93\x1a\x0c\x1c\xe9\x65\x8c\x91\x63\x71\xad\x9f\xec\x44\x26\x66\x0d\x39\x3d\x9e\x17\xe9\xc5\x41\xd9\x64\x67\x01\xd9\xa7\xf4\xda\x1c\x36\x67\x6c\x78\x98\xd8\xac\x50\x77\xb6\xfb\x47\x66\x62\xae\xa0\xcd\x66\x63\x99\x68\x74\x84\xb1\xc3\xe1\x23\xd6\xe1\x3e\x66\xe6\x56\x87\xd9\x44\x26\x29\xea\x61\x66\xee\xf3\xf7\x3e\xaa\xd1\xd6\x69\x9b\x65\xf0\xa9\x33\x43\x9e\xb0\xd9\x2a\x27\x87\xce\x07\x89\x5b\x25\x22\x62\x72\x62\x30\x14\x93\x7b\x14\xa9\xc7\xd9\xeb\x18\x89\x46\x55\xfb\x07\xf9\xbc\x9a\xcf\xab\xc7\x99\x6c\xb5\xf5\x2b\x03\x43\x8c\xc7\x06\xfa\xa3\x7f\x0a\xf5\x11\x77\x2a\xca\x01\xc7\x40\x94\x2c\xb9\xe0\x03\x6b\xfb\xe4\x7e\x6b\x9b\xa2\x84\x9d\xa4\xf8\x64\x2e\x3e\x19\xf0\xdb\x59\xc0\x7f\x8a\x84\xba\x9a\x2a\x52\x4a\xa8\x92\x9b\xf4\x0b\xcf\x3e\xe2\x44\xfa\x06\x3c\xd6\x50\x4c\x8e\xdb\x03\xc9\xf4\xa0\xd5\x67\x3a\x18\xcf\x04\xac\x81\xc8\x85\xfc\x78\x8f\x69\xd0\x3d\x1a\x64\xbc\x3e\x75\xfe\x15\x9b\xc5\x3d\x72\x66\x64\x9f\xd9\xfe\x92\x79\x6d\xd9\xe7\x06\xe6\x5f\x9e\x8a\xff\xc0\x63\x3b\x75\xe6\x98\x24\x4d\xce\x97\xfa\x22\x96\xc3\xe7\xc6\xfb\x86\xc2\xd6\xa9\x23\x33\x65\xa9\x77\x66\x76\xb4\x78\x26\xc1\x1f\xdc\xa7\x85\xfd\xe6\x32\x48\x3e\xaf\x9d\x89\xed\x31\xe1\x57\x27\x26\x73\x29\x4a\x0c\x93\x0e\x4e\xd2\x30\x05\xf4\x4d\xb3\x03\xc4\xb7\x76\x8a\x72\x76\x92\xf4\xf6\xd1\xf9\xbd\x77\xf0\xe9\x4b\x67\x0e\xcf\x84\x2d\xb2\x25\x72\xb2\x3f\x34\x6e\x35\xf7\x9a\x24\xc5\x12\x3e\x62\xf5\xcb\x9e\x3e\xc9\x72\xb0\x7a\x92\xd1\x13\x87\x7c\x07\xed\xce\xa0\xdd\xec\x77\xd8\x07\x95\xde\x7e\xfb\x3e\x5b\x7f\x89\x4b\x7c\xec\xe2\xb9\x89\x80\x97\xfc\x1e\x8a\x0f\x11\x31\x92\xb2\x51\x32\x5b\x48\x19\xe9\x57\xfa\x6d\xa3\x39\x6e\xb1\x99\x0f\x3d\xe1\xb4\x73\xb9\xc7\x35\x60\x65\x3d\x16\x47\xbf\xd5\x38\x67\x11\xf0\xda\xcc\x1b\x1f\x3e\xe7\x38\xf1\x4f\xd8\x4c\xda\xa1\xeb\x83\xcc\x5f\x3b\xff\xb5\x86\x2a\x40\xcf\xee\x75\x80\x00\xed\x4c\x0a\x7d\x3c\xff\xfc\x91\x13\xa0\xcf\xb4\xe7\x81\x69\xc7\x37\x94\x8c\x5a\x34\x01\x1b\x86\x15\x75\xdd\xa8\x1b\x46\x6d\xed\xa9\xde\xbd\x55\x0b\x66\x85\x17\xc3\xf8\x1e\xde\xc4\xbf\xc8\x49\xdf\xa0\x16\xbd\x49\x1d\xfa\x92\x45\x58\x9d\xbd\xcd\xbe\xe4\x19\x7e\xdf\x74\xd6\xf4\x9e\xc6\xc8\x87\x6b\xe8\xdd\xa5\xf0\x70\xf1\x93\x7d\x97\xb7\x05\x6f\x18\x58\xbf\xf4\x90\xf1\x96\x44\xc7\x0d\xcc\xc1\x28\x6d\x60\x13\x18\xcd\x19\xd8\x0c\xdb\x6e\x1c\x0b\x18\x65\x0d\x6c\xc5\x01\x2a\x19\xd8\x86\x23\xb0\x18\x58\x01\xc7\x5b\x06\xb6\x23\x82\xdf\x8a\xf3\xb3\x49\xb0\x94\xf1\x13\x03\x93\xc8\x60\x60\x06\x3b\x9d\x36\x30\x87\x89\xc6\x0d\x6c\x82\x89\xce\x1b\xd8\x0c\x3f\xf5\x1b\xd8\x02\x13\xe5\x0c\x6c\x45\x86\x96\x0d\x6c\xc3\x05\xa8\x06\x56\x60\xc1\xaf\x0c\x6c\xc7\x53\x78\xbf\x5e\x15\x97\xad\x5a\xb3\xb9\xbc\x7a\x2c\x9d\xae\x2e\x36\x6b\x6b\x17\x53\x0b\x8d\xcb\xe9\xd5\xf2\x42\x6d\x71\x69\xa1\xd6\x68\xd4\xd7\x56\xd2\xf5\xc5\x6a\xad\xf9\x4c\xb9\x5e\xaf\xac\x5c\x41\x0d\x4d\x34\xb1\x8c\x55\x1c\x43\x1a\x69\x54\xb1\x88\x26\x6a\x58\xc3\x45\xa4\xb0\x80\x06\x2e\x23\x8d\x55\x94\xb1\x80\x1a\x16\xb1\xa4\xd9\x06\x1a\xa8\x63\x0d\x2b\x48\xa3\x8e\x45\x54\xb5\x38\xcf\xa0\x8c\x3a\xea\xda\x4d\xf0\x8a\x9e\x73\xef\x9b\xf9\xd9\x02\xf2\x98\x45\x41\x30\x3c\x96\x4e\x37\x96\x2b\x4b\xab\x8d\xb5\x95\x85\x4a\xaa\xb1\x52\x4d\xd7\x17\x17\x2a\x4b\xab\x95\xd5\x74\x7e\xba\xb0\x4b\x4b\x27\xd5\xc0\x32\x2a\x10\xc1\x1a\x5a\xd2\x05\x54\x90\x42\x03\x2b\xa8\x1a\x04\x16\x0c\x7f\x05\xab\x48\x23\x8f\x69\x14\x4a\x95\x95\xd5\xc5\xc6\x52\x68\x3c\x35\xb6\xff\xed\x54\x97\x4b\x6f\xcf\x57\xaa\x6b\xf5\xf2\xca\x83\x17\x5b\xdd\xf5\x74\x63\xa9\x19\xaa\x56\x96\x2a\x2b\xe5\x66\xe5\xf9\xd0\xc5\x2b\xa1\xe9\x85\x46\xbe\xd1\x58\x4a\xe1\x69\x2d\x5c\x13\x21\x54\xb5\xfc\x22\x49\x19\x4d\x54\xf0\x3c\x42\xb8\x88\x2b\x08\x61\x5a\x13\x31\xaf\x49\xb6\x84\x94\x71\xcb\x7b\x4c\xf9\x4f\x00\x00\x00\xff\xff\x9d\xf1\xb7\xbc\xb8\x0f\x00\x00"),
-		},
-		"/fonts/lg.svg": &vfsgen۰CompressedFileInfo{
-			name:             "lg.svg",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 8858,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x3a\xef\x8f\x5b\xb9\x71\x9f\xb3\x7f\xc5\x54\x05\xfa\xa5\x99\x11\x67\x38\x24\x87\x8e\xd7\x41\x7a\x4e\x0e\x07\xdc\xa6\x41\xe2\x5c\x51\x04\x81\x21\x6b\xe5\x95\x2e\x5a\x69\x2d\xbd\x95\xed\x2d\xfa\xbf\x17\xc3\xf7\x24\x4b\x3a\xaf\x0e\xfd\x76\x45\x01\xef\x23\x39\x1c\x0e\xe7\xf7\x0c\x05\xbf\xfc\xed\xa7\xfb\x25\xec\x66\x9b\xed\x62\xbd\xba\x1e\x31\x85\x11\x6c\xbb\xc9\xea\x76\xb2\x5c\xaf\x66\xd7\xa3\xd5\x7a\xf4\xdb\x57\x57\x2f\xff\xe9\xf5\xbf\x7f\xf3\xe6\x3f\xff\xf4\x7b\xd8\xee\xee\xe0\x4f\x7f\xfd\xb7\xef\xbf\xfb\x06\x46\x38\x1e\xff\x47\xfc\x66\x3c\x7e\xfd\xe6\x35\xfc\xe5\x87\x6f\x81\x89\xc7\xe3\xdf\xff\x71\x04\xa3\x79\xd7\x3d\xbc\x18\x8f\x3f\x7e\xfc\x48\x1f\x23\xad\x37\x77\xe3\x6f\x37\x93\x87\xf9\x62\xba\x1d\xff\xe5\x87\x6f\xc7\x8e\xf8\xfa\xcd\xeb\xf1\x76\x77\xc7\x4c\xb7\xdd\xed\x08\x5e\x5d\xbd\x74\xd2\x9f\xee\x97\xab\xed\xf5\x57\xce\x4b\x08\xc1\xf1\x47\xaf\xae\x5e\xde\xcf\xba\xc9\xed\xa4\x9b\xbc\xba\x7a\xf9\xe3\x76\xbd\x72\xf6\xfe\xf6\xcd\xeb\xdf\xbd\xf9\xdd\xdf\xae\xfe\xeb\xea\x57\xa3\xf7\xeb\x55\xf7\x87\xc9\xfd\x62\xf9\x79\xf4\x02\x46\xcb\xbb\xd1\xaf\xaf\x7e\x35\xba\x9f\xfc\xb8\xde\xfc\xd0\x8b\x39\x7a\x01\xdc\x60\x8b\xd5\x31\x2c\xfc\x7a\x38\xfc\xd7\x3f\x7f\xef\x27\x9d\x89\xed\x8b\xf1\xf8\x6e\xd1\xcd\x1f\xdf\xd1\x74\x7d\x3f\xde\x4e\xa6\xf3\xc5\x6a\x3a\x5f\xaf\x97\x8f\x9b\xf1\x72\x71\x37\xef\xee\x26\xcb\xe5\x6c\xf3\x99\x7e\xdc\xb6\x7b\xa6\xeb\x87\xcf\x1b\x87\x3b\x85\x1e\xbd\xc1\x97\x8b\xe9\x6c\xb5\x9d\x39\xf4\xe6\xfb\x37\xc7\xa0\xa3\xdb\x5e\x8c\xc7\xeb\x87\xd9\x6a\xbb\x7e\xdc\x4c\x67\x4d\xec\x01\x67\x3b\xbe\xf9\xae\x3f\xb4\x3b\xb0\x3b\x1a\x38\x07\xb7\xd9\x9e\xf5\xef\x6e\x8f\x64\x7e\xd8\xfe\x71\x72\x3f\x3b\x02\x6c\x1f\xdf\x7d\x51\xcc\x9f\x67\x77\x8f\xcb\xc9\xa6\x3f\xfa\xb8\x5c\x9e\xe1\xde\xce\xb6\xd3\xcd\xe2\xa1\x1b\x2e\xfb\xc3\x7a\xd5\xc1\xdd\x6c\x35\xdb\x4c\xba\xd9\x2d\xbc\xfb\x0c\xdf\x4d\xd7\x37\xeb\xf5\x8a\x46\x57\xff\x7d\xf5\xf7\xbf\xbf\xba\x7a\x39\x1e\x8c\x31\x3e\xb2\xcf\xed\xec\xfd\xf6\xd5\xd5\x4b\x67\x0d\x16\xb7\xd7\x4e\x1c\xe6\xeb\xcd\xe2\x09\x27\xb7\x3b\xfc\x74\x3d\xe2\x20\x3a\x1a\x30\xf0\xfd\x64\x3a\x83\xc7\xd5\xa2\xdb\xe2\xc3\x6c\x83\xb3\xfb\x61\x1f\x26\xdb\xe9\x6c\xd5\x5d\x8f\x6a\x0e\x23\x70\xce\xda\x0a\xb3\x8e\x60\xec\x0e\xb1\xd8\x6e\x17\xab\x3b\xbc\x5b\x7e\x7e\x98\x7f\x85\x7e\x43\xea\x37\x1f\x57\x8b\xe9\xfa\x76\x76\x3d\xfa\x97\x7f\xfe\x24\xe1\x37\x67\xdc\x24\x96\x11\xdc\x5e\x8f\x9e\x3b\x31\x0b\x3c\xf9\xcd\x08\xda\x06\xae\x26\xf7\xb3\xeb\xd1\xc3\xe4\x71\x3b\x7b\x3b\x5d\x6c\xa6\xcb\xd9\xdb\xf5\x63\xb7\x5c\xac\x66\x23\x70\xf1\xb1\x9b\xdc\x6d\x9f\x45\xb8\x1e\xdd\xa4\xa4\x20\x29\x53\xce\x65\x17\x35\xcc\x2d\xef\xd0\x47\xb4\xfc\x74\x93\x58\xc0\xd4\xf7\x3e\xb0\x06\x08\x20\xca\xc0\x81\x3b\x0e\xec\x73\xdc\x8f\x03\x1c\x07\x18\x0e\xf3\x3d\x6c\x8f\x3b\x50\x4c\xd2\x93\x2c\x19\x02\x44\x47\x97\xd4\xb1\x24\x1c\xe6\x6d\x3c\x9a\x83\x8f\x2c\x09\x86\x79\x1b\x87\xf9\xd3\x4d\xb4\xe7\x25\xb8\xa0\xc3\xdb\x73\x1d\x2e\x27\x9f\x2f\xaa\xf0\xab\xfb\xae\xc1\xff\x0b\x4a\x52\xc9\x20\x51\x7b\x25\x99\x2e\x25\x65\xe4\x2a\x17\x14\x14\xe3\x99\x82\xb6\xdd\x64\xfa\x0f\x94\x13\xa5\x7c\x81\x5d\x8f\x9a\x21\x2c\x45\x8a\x51\xe7\x2a\xcd\x20\x1f\x52\x84\x00\x35\x50\xc2\x58\x28\x75\xfe\x41\x5f\xee\x70\x8f\x11\x30\x45\x3c\xc0\x3b\x3c\x20\xcf\x0f\x28\xe8\x54\xda\x06\x34\x2a\x6d\xbb\x51\xdd\x1d\xa8\x40\x8a\x70\x00\x77\x07\xdc\xa7\x1b\x2e\xc1\x31\x20\x97\xe4\x9c\xed\x30\x69\xa1\x18\xe3\x87\x80\x5c\xda\x0e\x8b\xdf\x18\x88\x73\xe9\xfa\x01\x1d\x34\xdf\x23\x22\xbb\x4c\x8d\xc9\x9c\x0b\x6a\x6e\xab\xcc\xce\x49\xd1\xb6\x90\x48\x16\xe3\xff\x92\x63\x65\x3f\x0b\xfd\x59\xe8\x29\x75\x4e\x16\xfa\x2b\x9e\x6e\x8c\x7b\xde\x4b\xb6\x23\xda\x03\xdb\x01\x8f\x98\xed\xf0\x48\x8a\x63\xe5\xfe\x8c\x8c\x7b\xc4\x3d\x4d\xe8\x37\x1b\x7e\xe7\x9f\x01\x70\xc4\x76\x8f\x8a\x47\x9b\x1d\x1e\x9d\xba\xe0\x52\x25\x9c\xb9\xd4\x74\x39\x9b\x6c\x4e\x1c\x6a\x0f\xb9\x1e\xb9\xf0\x90\x73\x73\xd9\x25\x4a\x34\xff\x83\x61\xc4\x1c\xfc\xdf\x31\xa4\x87\x42\x0e\x70\x04\x6d\xf3\x03\xf4\x00\x91\x68\x17\xd8\xac\x7a\xc6\xe6\x64\xb3\x59\x7f\xc4\xe5\xec\x7d\x77\xc2\xeb\x09\xf8\x7a\x74\x33\xa8\xc8\xad\x75\xae\x4f\x3c\xe8\x73\x50\x95\x5b\xc6\x1c\xea\xaa\x8c\xc1\xcd\xbd\x44\x91\xd4\xd6\xc3\x38\xcf\x39\xfd\x2c\xa1\x63\xbb\x1f\x83\xe6\x98\x73\x5a\x9e\x51\xfc\x30\x5c\xc8\xd2\x3c\xef\xe4\x7a\xd8\xfb\xca\x31\xc5\xee\x98\xe4\x07\x64\xeb\xbd\x6e\x38\xdc\x33\x5d\xad\x71\x38\x8c\x1f\x0e\xc4\xe3\x7e\xd6\x1f\xe8\x4e\x56\xcb\xf3\x63\x3d\x2f\x70\x82\x34\xac\x2e\x99\x2a\x7d\xd5\x54\x7d\xf3\xf3\x53\x5b\xed\xe1\x9e\xb5\x6b\x0b\xee\xde\x58\xd6\xeb\xd7\x23\x79\x10\x6b\x60\x0b\x8f\xd8\xfb\xb2\x3b\xf0\x35\x68\xa2\xc3\x93\xe5\x41\x23\x78\xa6\x91\x53\xb4\x03\xd0\x06\xe5\x47\x4f\x16\x2c\xbd\xd6\xfb\xd1\x79\x4a\x1e\x71\x06\x67\xca\xf3\x6c\x3e\x38\x89\xdb\xf9\x27\x39\x01\xbe\xe4\x84\xe3\x88\xde\xcf\x8f\x40\xee\x64\xee\x79\x07\x82\x1f\xce\x84\x3c\xb5\x88\x33\xb3\xdf\xdd\x73\xd8\x1d\xb1\x7e\xc1\x56\xef\xe5\xcc\x56\xbb\xd9\xa6\x5b\x4c\x27\xcb\xb7\x93\xe5\xe2\x6e\xf5\xf6\xdd\xba\xeb\xd6\xf7\x27\x56\x7b\x0e\xe3\x7a\xe4\x69\x1d\x58\xac\x8f\x13\xd3\x1d\x5a\x9e\xa3\x4f\xbc\x6f\xc9\x26\x10\x6d\x48\x1d\x5c\xdc\xb3\x05\xdb\x89\x22\x73\x16\xf3\x6c\x36\xf7\x43\x3e\xb2\x5c\x48\x06\xfc\xfe\xfd\xb9\x87\x3d\x3c\x6c\x4f\x5d\xab\x07\x5c\x8f\xda\xb5\xfd\xad\xbb\x76\x51\x91\x9d\xdf\x3c\xf7\xcf\xc0\x94\x86\xe7\xb6\xbd\x42\xa7\x9a\x2f\x9d\x2e\xb9\x97\xf7\x64\x6b\x77\x38\x7d\x81\xb8\xcb\xfe\x33\xdb\xcf\x33\xee\xb4\x9f\xdf\xf5\xb3\xcf\xf3\xfd\xac\x62\x25\x4c\xcf\x14\xeb\xfd\xff\x76\xba\x99\xcd\x56\x27\xea\x3d\x01\xb7\xc0\x35\x28\xd2\xd8\x99\x0b\xcb\x0e\x85\x65\x8e\xa6\x3b\x16\x9b\xa3\x1b\xd7\xf4\xe9\xa6\xb8\x43\xf7\x39\xa5\x6d\xb8\xb1\x1b\xa2\x1f\x31\x6d\x46\xbf\x11\x56\x48\x03\x92\x6f\x36\x72\xa6\x3d\x15\x6c\xf4\x9c\x96\x54\x3b\x28\xaf\x41\xdb\xb6\xe3\xf9\x01\x3f\x68\x7a\x49\xd0\xf3\x4e\xf3\x8b\x44\x6f\x67\x9f\x16\xdd\x33\xd2\xee\xf7\x06\xbf\x1a\x54\xfc\xb5\xbb\x1b\x53\x4f\x4d\x31\x43\x44\x5c\x92\x26\xaa\x7c\xb1\xd7\x25\xcd\xec\x7b\x6a\xd3\xaf\xeb\xf9\x79\x91\x23\xf3\x99\xc8\x4f\xeb\xf5\xfd\xdb\xc5\xa9\x61\xbf\xc0\x86\x26\x7a\x30\x85\x37\xee\x7d\x38\xab\xec\x7c\xb0\xbc\x53\xf1\xb6\xde\xf2\x5c\xa5\x6d\x39\x86\xba\x6b\x86\x83\xdb\x7f\x30\xef\xbd\x39\x66\x48\xb9\x4b\xd9\x67\x38\x0c\x3d\x10\x7b\x08\xa6\x63\xc0\x80\xf6\x74\x93\xf3\x21\x3a\x97\xc2\xe2\xd2\x62\x56\xff\x27\x2c\xee\x4a\xbb\xa8\x4b\x64\x01\x96\x0f\x58\x32\xe6\x8c\x6c\xa1\x0d\x9c\xbd\x76\xd6\x02\x16\x3a\x34\x06\xae\x19\xda\x50\xda\x5f\x0f\xf1\x0d\x6b\x68\x5e\xfa\x83\x0e\x04\x96\x2c\xc8\x32\x8f\x17\x1c\x28\xf2\x79\xe2\x6c\x9a\x5b\x3f\x76\x3f\x55\x67\x0f\xbc\x1e\x35\x9f\x4d\x69\x1f\x25\x2d\xd3\xb9\x85\x75\xf7\xff\x45\x6b\xf6\x93\xb0\xdb\xce\x27\x9b\xd3\x27\xdd\x1e\x72\x3d\xba\x29\xd9\x40\xfa\x27\xd7\x34\x7b\x3b\xc0\xa2\x4d\x11\xa2\xc8\xa2\xdb\x26\xbf\xf8\x9f\xee\x47\x48\xd6\x06\x96\x3c\x0d\xc0\xed\xe5\x17\x40\x40\x6c\x89\x31\x08\x70\xc9\x53\x14\x45\x11\x4c\x8a\x51\xd1\xcc\xbf\x25\xb8\xdc\x62\xfd\xf1\x16\xb2\xdb\xd4\x86\xfd\xdf\x34\x2a\x04\xc8\x7e\x11\xb4\x33\xcb\x18\xbc\x74\xe9\x14\x05\x38\xa0\x82\xf8\x27\xfa\x8d\x9e\x7c\x4f\x0e\x6f\x59\x0c\x7b\x10\xf6\x53\xdc\x4f\x9d\x34\x3a\x6d\xcc\x0a\xec\xfc\x40\xcc\xce\xab\xd7\xc7\x3c\x15\xe4\x00\x8a\xe2\x9f\x18\xb6\x28\xe8\xd7\x60\x0c\xcb\x18\xb4\xc7\x10\x97\x30\x09\x44\xaf\x73\x10\x2f\xa4\xf7\x1a\xce\x53\xc0\xfb\xc9\x74\xf6\x6e\xbd\xfe\x07\x7e\x5c\x74\x73\xec\x1f\xd2\xa7\xa9\xef\xeb\x08\x43\x72\xa8\x49\x28\xca\x14\xa5\x30\x69\x16\x08\xa8\x95\x29\x09\x8a\x04\x0a\xc9\xf6\xcb\x7e\xd8\x9e\x42\xe1\x64\x73\x58\xc1\x80\x03\xa7\x38\xa7\xd0\xd3\x23\x4f\x37\x59\x8c\x54\x2a\x64\xcf\x55\xa9\xce\xb1\x44\x32\x93\x29\x1a\x95\x94\xdc\xb2\x46\x6a\x11\xb9\x1d\x1e\x16\x92\xc9\xa4\xee\xd0\xdf\xc6\x69\x5e\x85\x94\xf3\xd2\x5f\x95\xb5\x18\x96\x4c\xc1\xe2\x1c\x8b\x91\x46\xdb\xa1\xf8\x0d\x21\xce\xd1\x0a\x71\xd5\xdd\x61\x5d\x2a\x71\xd0\xdd\x80\x3f\xac\x54\xa9\x68\x9d\xba\xbb\x90\x84\x04\xaa\x94\x54\x81\x39\x53\x2c\x06\x1c\x12\xe5\x52\xf6\xeb\x79\xcf\xed\x0e\x2d\x50\xd5\x0b\xcd\x5a\x0d\xe7\x39\xe7\x6e\xbd\xbe\x5b\xce\x9e\x35\x5e\xbf\xfd\xaf\xbf\x68\xdb\xa9\x45\xca\x96\x41\xb4\x92\x85\x34\xf5\x5e\xd9\x8a\x22\x27\x0a\xc1\xf3\x16\x71\x10\xe4\x4c\xc9\xaa\x9b\xa5\x26\xdd\xaf\x84\x34\xb5\xb7\x0f\x99\x1e\x86\x2d\x32\x71\x71\xb0\x50\xc9\x09\xc2\x14\xfd\xfe\x3e\xc0\xb9\x52\xcd\x02\x4a\x39\xd8\x61\x55\x13\xa5\x0a\x01\xac\x52\xac\x09\x38\x18\x19\xd4\x4c\xca\xc0\x2a\xc4\x91\xfb\xd5\xdc\x19\x9b\x22\x57\x92\xc4\x20\x89\x8a\x33\x96\x48\x92\x41\x62\xca\x7c\xb6\xa0\x5c\x31\x34\xee\x95\x58\x0b\x06\xf7\x05\x2c\xc4\xd9\x86\xb9\xb7\xfa\xda\x58\xcf\x54\xb2\x00\x53\xad\x05\x53\xa1\xa4\x15\x38\x91\x32\xa3\x24\x52\xef\x21\x32\x45\x53\x8c\x46\xea\xee\x24\x9e\x82\x7c\x6e\x42\x56\xdb\x0b\x20\xb8\xef\xb9\x53\x55\x62\x15\x60\x8e\x94\x18\x58\x02\xc5\x7e\x95\x39\xce\x99\x8d\xaa\xd5\x1d\x0a\x65\xe6\x69\x68\xbf\xa2\x78\x89\x88\x54\x1b\xff\x55\x22\x6a\x20\xae\x2d\x4e\x58\x12\x26\x4a\x11\x03\x19\x57\xd7\xbb\x27\x40\xb2\xaa\xae\x86\x92\x23\x46\x52\xef\xa0\x03\x71\x2a\xbe\xef\x09\x2f\x26\x0a\xc2\xa8\x4c\x2a\xbc\x5f\xf5\xa1\xe1\x5e\x26\x54\x43\x76\xdc\x52\x15\x73\xa2\x64\x05\xa3\x3a\x3f\x68\x4c\x89\x3d\x4c\x43\xaa\x58\x3d\x2a\xda\x3d\x5e\x58\x8a\xf3\xbf\x5f\x89\x51\x08\xad\x54\x05\x62\xab\xc0\x4c\x56\xcc\x0d\x9d\x4c\xf6\xa6\x89\x42\x85\x0b\x88\x50\x2a\xd5\x4f\x56\x2e\x90\x22\xa5\x94\x50\x8b\x7b\xd7\x7e\x55\x85\x8a\xf8\x73\x4f\x8b\x1b\x10\x9b\xce\xbc\xcc\x51\x48\x01\x73\xf0\xd4\x80\x1c\x02\x69\xf2\x2e\x56\xc5\x8d\x90\x03\xd9\xdc\x3d\xd2\x76\xed\x3b\xc7\xc4\x24\xbb\x7e\xda\xc0\xbe\x9e\xb7\x59\x03\xb6\xed\x1e\xb5\x3f\xe5\x00\x6f\xe4\x99\x02\x1b\x68\x60\xaa\x1e\x8f\x94\x5b\x34\x26\x8a\x92\x06\x07\x32\x0a\xd1\x9d\x26\x86\x82\x22\x54\xfc\x05\xe8\xee\xa5\x91\x8a\xa4\x41\x0b\x98\x8c\xcc\x50\x94\x82\x6b\x2a\x51\x48\x11\x9b\xdd\xeb\xfe\x90\xc7\x96\x78\x43\x40\xd9\x43\xd9\x88\x4b\x05\x21\x2f\x25\x31\x7b\x42\x6b\x3f\x7f\x35\x17\x2c\xc1\xeb\x1c\x59\xf4\x42\x49\x51\x22\x68\xa6\x5c\x15\x22\xc5\x52\xa1\x14\x32\xd1\xf6\x00\x65\x28\x89\x52\x61\xc8\x99\x0a\x2b\x0a\x71\x82\xe8\x99\x4f\x30\xba\xb3\x18\x64\x17\xa3\x62\x49\x64\x52\x86\xd5\xd3\x8d\xe6\x44\x25\xfb\x03\xcb\x43\xdf\xa6\xed\x55\x5b\x41\xa3\xa7\x89\x28\x14\x3d\x96\x32\xa9\x08\xe6\x48\xd1\xf2\x7e\x15\x29\x8a\x47\x78\xa6\x12\x3c\xae\x12\x0b\x56\xaa\x4d\x2b\xd1\x7d\x26\x92\xe6\xdc\x12\x82\xab\x83\xbd\x3f\xe9\xd5\xe1\x8e\x1a\xb2\xb3\x55\x54\x31\x53\xa8\xd1\x3d\xd1\x6a\xc6\x4c\x9a\xd8\x1d\x31\x85\x8a\x4c\x22\xd5\x05\x4f\xa5\x42\x25\x2d\x82\x31\x91\x55\x76\xc1\x6a\xc1\xcc\x54\x03\xbb\x24\xb9\xc8\x7e\xd5\x6b\x26\x40\xa6\xe2\x79\xc9\x13\x48\xe3\x0a\x1a\x57\x20\xe6\x62\x43\x21\xb3\x04\x9a\xa8\x88\x40\x0a\x54\xaa\xd3\x0c\x6a\x50\x99\x2c\x5c\x2c\xdf\xe7\xbf\xfe\x3e\x2c\x56\xdd\x6c\x33\xdb\x76\xcf\x96\x80\xe7\x30\x7e\x59\x45\x20\x69\xa2\x1c\xfd\x41\xa7\x8d\x9d\xc8\x94\xa2\x7b\xa6\x86\x8c\x7d\x35\x05\x36\x0a\xee\x09\x95\x54\x0a\x44\xa1\x5a\x9a\x7b\xa7\x6c\x58\x98\x84\x2b\xee\xd3\x50\xac\x7e\x47\xa9\x14\xdd\x94\x25\x91\x84\xe6\x01\x12\x32\x70\x28\x1e\x33\x9e\x16\x44\x18\xd8\x8c\x12\x1b\xc4\x4a\x59\x2a\x48\x51\x8a\xc6\x28\x95\xb2\x26\xd0\x4a\x55\x20\x52\x72\x23\xa6\xd0\xc2\x20\xbb\xd7\x54\x60\x49\x0d\x65\x28\x49\xd1\xbd\x30\x61\xf6\xd7\x99\xa7\xce\x44\x19\xa4\x52\xd1\x82\x12\xbc\x94\x79\x61\x09\xde\x9d\x78\x76\xe1\x12\x81\x95\xa9\xf8\x58\xb4\xc5\x95\xb3\x9b\x41\x62\x69\x0d\x48\x0d\xe4\xcf\xbd\xca\xa4\x55\x51\xb2\x50\x0a\x02\xe2\x57\xa3\x78\x90\xba\x44\x62\x64\x05\x12\xb1\x87\x0b\x44\x23\xd1\xec\x69\xb7\x78\xd7\x19\x49\x18\x2d\x51\x65\xdd\xc7\x33\x0b\x19\x16\xa5\x9a\x0a\x24\xa3\xc8\x05\x8b\x50\x09\xde\x9a\xb4\xba\x30\x88\x5a\x6b\x0b\x30\xab\x24\x5e\x49\xb2\x51\x0d\x15\x5c\x91\x9c\x12\x70\x69\x0d\x54\x2b\x94\xb9\x3d\x6f\xbd\xf7\x01\xe1\x40\xd9\xcb\x52\x6d\x0e\x2e\xa2\x7d\xcd\xf0\x12\x6a\xe2\xe5\xcc\xc4\x73\x7b\x22\x0d\x09\xd5\xcb\x01\xa3\x68\x20\xf1\x88\xcd\x89\xa4\x28\x4a\x64\x92\x8b\x2d\xd0\xf9\xcf\xc0\xdd\xc7\x45\xd7\xcd\x36\xcf\x06\xc0\xd7\xf7\x7f\x59\xee\x5f\x98\xa9\xfa\x3b\x4e\x2b\x65\x8b\xd3\xbe\x3b\x50\xe2\xea\x69\x44\x52\x46\xa3\x58\xcb\x30\x67\x21\xad\xb1\x7f\xaf\x60\xfb\xc1\x94\x51\x3c\x05\x7b\x71\x28\xbd\x7a\xf7\x6b\x7f\x6c\x9a\xb4\x42\xe9\x39\x5a\xdd\x0c\xd5\xbb\x2b\xb5\xd6\x8a\xb6\x5c\x0b\x85\x92\x64\x0c\x54\xa5\xd9\xc9\x4b\x20\x93\x18\x48\xa4\xe0\x6d\x81\xcf\x35\x51\xcc\xde\x68\x58\x71\xe0\xd0\x9e\xb4\xfe\x42\xbc\x7a\xb5\x62\xef\xc5\x3d\xaa\x40\xdf\x2e\x14\xa3\x60\xc1\xf3\x5e\x29\xea\x1e\xad\x5c\x21\x17\xe2\xa2\x90\xc8\xcc\x6f\x09\x25\x41\x93\xbe\x15\x34\x0f\x72\x2e\xb9\x9f\x1b\x99\xd7\x78\xe0\x42\x1a\x0c\xfa\xb6\x6e\x68\x87\x5a\xaa\xc5\xbe\x11\x82\xe6\x9e\x58\x0a\xe5\x02\xea\x59\x22\x0f\x8b\xaa\x54\x25\xed\xda\xc9\x29\x47\x0a\x29\x63\xf1\x4c\x01\x7d\x03\xe1\x9d\x46\x16\x71\x2d\x78\xfb\xc0\xde\xec\x79\xb0\x51\xe0\xfd\xb5\x5e\x62\x43\xaa\xd0\x37\x0c\xfb\x95\x05\xca\xda\xb3\x56\x38\x81\xb6\xee\x2d\xaa\xa7\x2b\x0f\x3c\xef\xc3\xd4\x83\x83\xfd\x5c\x29\x5e\x99\x93\x87\x3a\x57\x0a\x1a\xdc\x68\x4d\xee\x5a\x9b\xe2\x39\x78\xb9\x91\x5e\xea\x42\xcc\x05\x3d\xdc\x2b\xb0\x92\xd6\x61\x2e\x42\xdc\x7e\x12\x4f\x91\x34\x34\x9e\x23\x27\xef\x4c\x8d\xeb\xd9\x20\xc5\x0b\x89\xe3\x0a\x55\xef\xe4\x3c\xd3\x64\x28\x9e\x7f\xb2\xa7\xaa\xcc\xe6\x04\x83\x2b\xac\x2f\xfb\x42\x25\xb5\x04\x11\xfd\x19\x45\xaa\x6e\xfc\x14\xb8\xd7\x17\x0e\x4d\x94\x0f\x62\x9e\x62\x52\x51\x37\x76\xd2\xe2\x0f\xa8\xec\x72\x57\xca\xdc\xa7\xec\x21\x15\xb9\x5f\x15\x83\x94\x28\x07\xcf\x9f\x7d\x3a\x96\xbe\x85\xaf\xbd\x9f\x56\x8a\x56\xbd\xf9\x48\x9e\xe9\xad\x25\x83\x14\xbc\xf1\x1c\x92\xc0\xf8\xfd\x7a\xd5\xbd\x7a\x39\x6e\xff\x5f\xe0\xe5\x78\xbb\xbb\x7b\xf5\x3f\x01\x00\x00\xff\xff\x03\xb4\x88\xbe\x9a\x22\x00\x00"),
-		},
-		"/fonts/lg.ttf": &vfsgen۰CompressedFileInfo{
-			name:             "lg.ttf",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 3880,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x4b\x6c\x1b\xd7\xd5\xfe\xce\xbd\x24\x47\x22\x67\xf8\x7e\x48\xd6\x83\x2f\x93\x8c\x29\x99\x94\x34\xa4\xe8\xf8\xa1\x5f\xb1\xf3\x27\x55\x25\xda\x92\x4b\xb2\x81\x5b\x34\xa5\x15\x9a\x54\x4a\x93\x82\x44\x35\xf1\xc2\x00\x03\xc4\x68\x80\x26\x68\x80\xbe\xe2\x55\x8a\xa2\x28\x0a\x99\x0d\x8c\xb8\x8b\x2c\x0a\xd4\xab\x22\x08\xba\x6a\x68\x75\x51\x14\x70\x94\x2c\x9c\x26\xc8\xa2\x40\xbb\x08\x2a\xaa\xb8\x33\x23\x55\x8e\x1d\xa7\x03\xdd\x7b\xbe\xb9\xe7\xcc\x39\xdf\xfd\xee\x9d\xd1\x25\x08\x80\x0d\x6d\x70\x4c\x9c\xfb\x46\x7a\xca\xe1\xb6\xb8\x01\xfc\x1e\xc0\x77\x97\x2f\x97\x57\x5f\xf9\x44\x6d\x02\x34\x0c\xe0\x57\xd5\xf2\xfa\x2a\x00\x27\x40\xb7\x01\xf4\x55\xeb\x57\x2e\x5d\xe8\x1f\x7b\x0f\xa0\x77\x81\xfe\xd7\x6b\x95\xf2\x73\xfd\xad\x7f\xcf\x00\xb6\x67\x00\x4c\xd7\x6a\x95\xb2\xf4\x16\xdf\x02\x6c\xaf\x03\x38\x5c\xbb\xdc\x7a\xf1\xeb\x90\x96\x00\xdb\xef\x00\x14\xea\xcd\xe5\xb2\xeb\xfb\xde\x67\x00\xb9\x0f\xc0\xf8\xe5\xf2\x8b\xab\x08\xe2\x07\x80\x9c\x01\x10\x6a\x94\x2f\x57\x3e\xbf\xf5\x66\x1e\x90\x0b\x00\x7b\x7b\xb5\xb9\xde\x02\x07\x00\x87\x88\x0f\x81\xf3\x8f\xe8\x75\x98\x01\x76\x9d\xfd\x09\xc0\x8f\x74\x4b\x9f\x40\xa5\x7e\xdc\x7f\x91\xf3\x0b\x03\x98\x05\x3e\x36\xf1\xdb\xbb\xb7\x31\xcb\x6f\x8b\x3b\x4d\x8b\xbd\x2b\xa4\xf5\xdc\x68\xc3\x20\xc3\x72\x2d\x6a\x18\x26\xbc\x02\x60\x14\x4e\x70\xd8\x41\x08\xdd\x3d\x74\x77\xe4\xae\x7a\x77\xf5\xee\x4f\xef\xfe\xe3\x83\xdd\x6d\xe5\x43\xf7\x3d\xe5\x63\xd3\xee\xce\xee\xae\x9e\x6f\xdf\xff\x13\xcd\x2f\x7f\xe8\xba\xa7\x7c\x4c\x9a\x9f\x76\x3f\x0c\xfe\x3d\x78\x2f\xf8\x7e\xf0\xe7\xc1\x56\x70\x60\x54\x1e\xc5\xf0\xce\x00\x0f\x38\x8d\x6a\x8f\xba\x08\xb4\xbb\x0b\xc7\x81\x38\x06\xe4\x1e\x27\xdc\x37\x9f\x47\x8f\x99\x50\x02\xf1\x4d\x5e\x04\x87\x07\x51\x24\x00\x72\xa9\x2e\x69\x2a\x37\x9d\xcd\x24\xe2\xd1\x88\x64\xf1\x79\x03\x7e\xcf\x54\xc0\xef\xf3\x4a\x96\x68\x24\x11\xcf\x66\x72\xd3\x1e\x97\xea\x62\xc9\x52\xfb\xd5\x4a\xa5\x52\x79\x55\xef\x6f\x5e\xbd\x7a\xf5\xea\x4d\xad\x9f\x28\x11\x51\xa1\xd7\xe9\xec\x3b\x5f\xad\x54\x38\xf6\xdc\xa2\xdf\xe9\x88\x00\xf0\xfd\xfa\x0e\x04\x11\x01\x62\x5f\x59\xd9\xcc\xf0\x25\x65\x2f\x10\x8a\x8f\xa8\xf8\x33\x6a\xdf\xd6\x57\xb6\x08\xf0\x1b\xbc\x08\x2f\x1e\xc3\x29\x80\xc2\xa2\x88\x4b\x54\x09\x8b\x32\xae\x4c\x6e\x5a\x95\x5c\xde\x80\x5f\x0d\x5b\x0e\x0e\xc6\xc2\x82\x95\xee\x10\x3c\x5d\x82\x28\xb5\xe9\x46\x36\x16\x8f\xc7\xb2\xbd\x62\x36\x1e\x8b\xc5\xb3\x77\x64\xc5\xc5\x0e\xdb\xc3\xe1\x23\xbd\x62\x36\x16\x1f\x18\x60\xbf\xee\x15\xdd\xb2\xa2\xc8\x6e\xba\xe1\x52\x64\x59\x71\xf1\x62\x2c\x9e\xed\x95\xf4\x78\xda\xcc\xc6\x63\x6f\xef\xfc\xcd\x2d\x2b\xb1\x81\x81\x78\x2c\x4b\x37\x8e\x84\xc3\xcf\x2b\xb2\xbb\x57\x72\x8b\x70\x37\x6d\xba\x65\x45\x5f\xc3\x2d\xbc\xc4\x93\xec\x0e\x6c\x00\x49\x01\x29\x21\x25\x72\x89\x5c\x20\xc7\x93\x9f\x7d\x76\x72\xef\x8f\x5d\x3f\x70\x03\x42\x11\x45\x7e\x83\x43\xe8\x4b\x9a\x9e\x0e\x7d\xca\xda\x8c\xf7\xf5\x25\x21\x30\x4d\xab\x3a\xc7\x6d\x76\x5d\xa7\xbc\x53\xdd\x16\x8c\xdd\x4a\x6f\x4b\x51\x28\xa9\xb8\x39\x14\xd9\xed\x96\xb7\xb5\x41\x79\x5b\x60\xdd\xe1\x52\x68\x4c\xc6\x03\xf5\x48\x14\x20\x63\x05\xff\x8f\xc2\xc6\x4a\xaa\x61\xc3\xb2\xa2\x5b\x3c\x2e\xf2\xbb\xdd\xb2\x2c\x6f\xef\x54\xf5\xca\xec\xfa\xb6\x2c\xcb\x6e\x0e\xb9\x77\x47\x76\xeb\x04\xb4\xca\x8a\xec\x76\x69\x95\x3d\xb2\x2c\x76\xf3\x26\xc6\x78\x89\x8f\x81\xc3\x0a\xe4\xc2\xbe\x30\x49\x09\xd5\xa5\xba\x36\x59\x67\xa7\xc0\xb0\xb9\xd9\x2e\xbc\x54\xa2\x52\xa7\x43\x9b\xbd\x12\xfa\xb1\x89\x22\x2f\x71\xf1\x5e\x4b\xb0\xc1\x01\x0f\x02\x18\x42\x10\x51\x20\x96\x55\x7d\x3c\xab\xfa\x28\xab\xfa\x72\xaa\x2f\x2a\x6c\xd8\x18\x53\x8d\x71\xb6\xd9\xe9\x74\x7a\x85\x4e\xa1\xd3\xd9\x85\x00\x9d\x4e\x41\x98\x62\xa7\x43\xd0\x9b\xe6\xd9\xef\x19\x3a\x1d\xf1\xae\xed\xad\x9d\x19\x36\xb8\x10\x00\x48\xf5\x45\xb3\x51\x4f\x56\x18\x91\x39\xea\x1b\xd1\x86\x58\xa9\x5b\x68\xb7\x0b\xdd\x5e\xbb\xdb\x6e\x77\xd9\x9d\x6e\xbb\x57\x68\x77\x0b\x34\xde\x2d\xb4\x3b\xed\x42\xf7\x4b\x73\xa9\x5c\xcb\xa2\xe5\xca\xba\xb4\x9e\x6d\xb6\xbb\x85\x42\xb7\xdd\xeb\x14\xba\xdd\x02\x2b\x16\xba\x3b\x9d\x6e\xa1\x4d\xdd\x76\xb7\x20\xc6\xda\xfa\x3b\xd1\xc6\x13\xfc\x79\x3e\x06\x1b\x86\x30\x05\x50\x54\x30\xcb\x8a\x29\xab\x5f\xfc\x1a\x84\x03\x52\x22\x9b\xd8\x7f\x25\x55\x7d\x2b\x05\x18\x4a\xc9\x52\x29\x59\x7a\x7e\xf1\xd8\xb1\x63\xc7\x16\xb5\x9e\x16\xbb\xb3\x5d\x79\xa1\xd6\x5a\x5a\x5a\x5a\x6a\xb5\x16\x17\x4f\xcb\x8c\x44\x50\x69\x6b\x2f\x44\xf4\xdd\xd9\x6e\x44\x3e\xbd\xb8\xd8\xd2\xe3\x6a\x0b\xf2\x7d\x9c\xc4\xb7\x29\xa9\xcf\x30\xf0\xbf\x71\xa1\xe4\xd6\x56\xfd\x2b\x78\x8c\x25\x3b\x5f\xc9\x81\xc4\xff\x47\xde\xe6\x45\xa8\xe2\xdb\xe4\xf7\x79\x2d\xd1\x48\x3c\x7b\x92\x72\x31\x3b\x09\x94\x99\x56\xa7\xfc\x81\x58\x8a\x74\xa4\xfb\x13\xe6\x51\xf2\x79\x2d\x92\x79\x86\x38\xd4\xb9\x39\x55\x9d\x63\xbd\x3f\xbb\xc7\x0f\x65\x16\xe6\xb3\x83\x47\xdd\x34\x4e\x7c\x3e\x6b\xdc\xf4\xba\xc4\x19\xa3\x09\xe7\xf8\xce\x9c\xaa\xce\xcf\xab\x92\xc3\x72\xd3\xe5\x12\x7e\xb7\xf3\x2d\xc9\xd1\x97\x59\x58\xc8\x64\xe7\x3d\xae\x9b\x7d\x0e\xa9\xcf\x21\xdd\x74\xb8\xc4\x9e\xf7\xee\x7e\xc0\x3f\xe5\xef\xc0\x8b\x23\x00\x45\xec\xcc\xe7\x1d\x65\xea\xd4\x0c\xcb\x66\x52\x2c\xea\x89\x46\x2c\x23\xa4\x4a\x7b\xab\x98\x99\x3e\x41\x3e\x86\x4b\xb7\xae\x9d\x3d\x7b\xed\xd6\xa5\x3d\xdb\x9a\x93\xe4\x6f\x2b\xe7\xbe\x79\xee\xdc\xc9\xd4\x1c\x7f\x27\x7f\xc0\x75\xe9\xd6\xb5\x7c\xef\xb7\x4e\xdb\xf4\xc2\x47\x1f\x2d\x1c\x9d\xf8\xff\x25\x98\x0f\xd4\x3c\x8b\x6f\xa1\x81\x97\x1f\x52\x99\xdb\x29\x9a\xa4\xc9\x89\x88\xae\xce\x09\x4a\xd1\x64\x52\x48\x95\xd0\x34\xca\xa9\x42\x18\x3b\x49\xba\x42\x76\xf2\x79\xfd\x01\x1d\xc7\x0e\x6c\x39\x9f\x39\x49\x51\x3b\x09\xff\x28\xe5\x66\x28\x91\xa2\x68\x2e\x25\x32\x4b\xda\x68\x60\x94\xd4\xe3\x9a\xe3\xc1\x49\x0d\x07\x8e\xf6\x33\xc6\xc8\xb1\xb2\x31\x48\x76\x22\x13\xb3\x86\x9c\x1e\xcf\x0b\xf4\xc2\xb0\x6c\xb2\xb3\x80\xec\x53\xfa\x6d\x0e\x9b\x33\x36\x3a\x4a\x6c\x41\xa8\xbb\xd0\xfb\x03\x33\x31\x57\xd0\x66\xb3\xb1\x4c\x34\x3a\xc6\xd8\x91\xf0\x51\xeb\xe8\x00\x33\x73\xab\xc3\x6c\x22\x93\x14\xf5\x30\x33\xf7\xf9\xfb\x1f\xd4\x68\xe7\xb4\xcd\x32\xfc\xe4\x99\x11\x4f\xd8\x6c\x95\x93\x23\xe7\x83\xc4\xad\x12\x11\x31\x39\x31\x1c\x8a\xc9\x7d\x8a\xd4\xe7\xec\x77\x8c\x45\xa3\xaa\xfd\xfd\x7c\x5e\xcd\xe7\xd5\x13\x4c\xb6\xda\x06\x95\xa1\x11\xc6\x63\x43\x83\xd1\x3f\x86\x06\x88\x3b\x15\xe5\x90\x63\x28\x4a\x96\x5c\xf0\xbe\xb5\x7d\xe2\x61\x6b\x9b\xa2\x84\x9d\xa4\xf8\x74\x2e\x3e\x1d\xf0\xdb\x59\xc0\x3f\x43\x42\x5d\x4d\x15\x29\x25\x54\xc9\x4d\xfb\x85\xe7\x21\xe2\x44\x06\x86\x3c\xd6\x50\x4c\x8e\xdb\x03\xc9\xf4\xb0\xd5\x67\x3a\x1c\xcf\x04\xac\x81\xc8\x85\xfc\x64\x9f\x69\xd8\x3d\x1e\x64\xbc\x3e\x7b\xfe\x65\x9b\xc5\x3d\x76\x66\xec\x21\xb3\xfd\x05\xf3\xda\xb2\xcf\x0e\x2d\xbd\x34\x1b\xff\x9e\xc7\x36\x73\xe6\xb8\x24\x4d\x2f\x95\x06\x22\x96\x23\xe7\x26\x07\x46\xc2\xd6\xd9\xa3\xf3\x65\xa9\x7f\x7e\x61\xbc\x78\x26\xc1\xef\xdf\xa7\x85\x87\xcd\x65\x98\x7c\x5e\x3b\x13\xdb\x63\xca\xaf\x4e\x4d\xe7\x52\x94\x18\x25\x1d\x9c\xa2\x51\x0a\xe8\x9b\x66\x0f\x88\x77\x6d\x86\x72\x76\x92\xf4\xfe\xc1\xf9\xbd\x7b\xf8\xa9\x4b\x67\x8e\xcc\x87\x2d\xb2\x25\x72\x6a\x30\x34\x69\x35\xf7\x9b\x24\xc5\x12\x3e\x6a\xf5\xcb\x9e\x01\xc9\x72\xb8\x7a\x8a\xd1\xe3\x8f\xf9\x0e\xdb\x9d\x41\xbb\xd9\xef\xb0\x0f\x2b\xfd\x83\xf6\x87\x6c\xfd\x06\x97\xf8\xc4\xc5\x73\x53\x01\x2f\xf9\x3d\x14\x1f\x21\x62\x24\x65\xa3\x64\xb6\x90\x32\x36\xa8\x0c\xda\xc6\x73\xdc\x62\x33\x3f\xf6\xb8\xd3\xce\xe5\x3e\xd7\x90\x95\xf5\x59\x1c\x83\x56\xe3\x9c\x45\xc0\x0f\xe7\x5f\xfb\xcb\xb3\x8e\x93\xff\x84\xcd\xa4\x1d\xba\xde\xcf\xfc\xb5\xfb\x5f\x6b\xa8\x02\xf4\x89\xd3\x98\x71\x9e\x83\x76\x26\x85\x1e\xcf\x3f\x7d\xe0\x04\xe8\x33\x1d\xb8\x61\xda\xf1\x0d\x25\xa3\x15\x4d\xc0\x96\x61\x45\xdb\x34\xda\x96\xd1\xda\x07\x9a\xf7\x60\xd3\x92\x59\xe1\xc5\x28\xbe\x83\x37\xf0\x2f\x72\xd2\xd7\xa8\x4d\x6f\x50\x97\x3e\x67\x11\x56\x67\x6f\xb1\xcf\x79\x86\xdf\x33\x9d\x35\xbd\xab\x31\xf2\xe1\x1a\xfa\xf7\x29\x7c\xf1\xf2\x93\x7d\x9f\xb7\x05\xaf\x19\x98\xb4\x58\x32\x9e\x92\xe8\x84\x81\x39\x18\xa5\x0d\x6c\x02\xa3\x45\x03\x9b\x61\xdb\xcf\x63\x01\xa3\xac\x81\xad\x38\x44\x25\x03\xdb\x70\x14\x16\x03\x2b\xe0\x78\xd3\xc0\x76\x44\xf0\x1b\x71\x7e\x36\x09\x96\x32\x7e\x6c\x60\x12\x15\x0c\xcc\x60\xa7\xd3\x06\xe6\x30\xd1\xa4\x81\x4d\x30\xd1\x79\x03\x9b\xe1\xa7\x41\x03\x5b\x60\xa2\x9c\x81\xad\xc8\xd0\xaa\x81\x6d\xb8\x00\xd5\xc0\x0a\x2c\xf8\xa5\x81\xed\x78\x12\xef\xd5\xab\xa8\xa3\x5a\x6b\xb5\x56\xd7\x8f\xa7\xd3\xd5\x95\x56\x6d\xe3\x62\x6a\xb9\x79\x39\xbd\x5e\x5e\xae\xad\x34\x96\x6b\xcd\x66\x7d\x63\x2d\x5d\x5f\xa9\xd6\x5a\x4f\x97\xeb\xf5\xca\xda\x15\xd4\xd0\x42\x0b\xab\x58\xc7\x71\xa4\x91\x46\x15\x2b\x68\xa1\x86\x0d\x5c\x44\x0a\xcb\x68\xe2\x32\xd2\x58\x47\x19\xcb\xa8\x61\x05\x0d\xcd\x36\xd1\x44\x1d\x1b\x58\x43\x1a\x75\xac\xa0\xaa\xe5\x79\x1a\x65\xd4\x51\x47\x05\x6b\xb8\xa2\xd7\x3c\xf8\x64\x7e\xa1\x80\x3c\x16\x50\x10\x0c\x8f\xa7\xd3\xcd\xd5\x4a\x63\xbd\xb9\xb1\xb6\x5c\x49\x35\xd7\xaa\xe9\xfa\xca\x72\xa5\xb1\x5e\x59\x4f\xe7\xe7\x0a\xfb\xb4\x74\x52\x4d\xac\xa2\x02\x91\xac\xa9\x15\x5d\x46\x05\x29\x34\xb1\x86\xaa\x41\x60\xd9\xf0\x57\xb0\x8e\x34\xf2\x98\x43\xa1\x54\x59\x5b\x5f\x69\x36\x42\x93\xa9\x09\x94\x34\x4e\xeb\x58\x41\x13\x0d\x84\x30\x89\x14\x26\x74\xb9\xf4\xfe\x7c\xa5\xba\x51\x2f\xaf\xe1\x3c\x2a\xa8\x62\x03\x75\x94\xb1\xa6\xbb\x9e\x6a\x36\x5a\xa1\x6a\xa5\x51\x59\x2b\xb7\x2a\xcf\x85\x2e\x5e\x09\xcd\x2d\x37\xf3\xcd\x66\x23\x85\xa7\xb4\x74\x2d\x84\x50\xd5\xea\x8b\x22\x65\xb4\x50\xc1\x73\x08\xe1\x22\xae\x20\x84\x39\x4d\xc4\xbc\x26\x59\x03\x29\xe3\x57\xde\x23\xae\xff\x04\x00\x00\xff\xff\xaf\x16\xe6\x55\x28\x0f\x00\x00"),
-		},
-		"/fonts/lg.woff": &vfsgen۰CompressedFileInfo{
-			name:             "lg.woff",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 3956,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x4b\x6c\x5b\xc7\xd5\xfe\xce\x0c\xc9\x2b\x91\xf7\xf2\xfd\x90\xac\x07\x5f\x26\x69\x53\x32\x29\xe9\x92\xa2\xe3\x87\x7e\xc5\xce\xef\xfc\xfa\x25\x5a\x92\x4b\xb2\x85\x5b\x34\xa5\x15\x9a\x54\x4a\x8b\x82\x44\x35\xf1\xc2\x00\x03\xc4\x68\x80\x26\x68\x80\xbe\xe2\x55\x82\xa2\x28\x0a\x99\x0d\x8c\x78\x93\x5d\xbd\x2a\x82\xa0\xab\x86\x56\x17\x6d\x01\x47\xc9\xc2\x69\x82\x2c\x0a\xb4\x8b\xa0\xa2\x8a\xb9\xf7\x4a\x95\x1f\x71\x7a\xa1\x33\xe7\x9b\x39\xe7\x9e\x73\xe6\x9b\x99\xab\xe1\x8b\xf3\xe7\xce\x81\x00\xc0\xd1\x84\x4d\xd3\x47\xf4\xfe\xa3\xcf\xfc\x37\xd2\x13\x00\xf5\x00\xf8\x9e\x10\x87\xdb\xe2\x5e\xba\x52\x5e\x05\xa8\x06\xe0\x57\x42\x5e\xfd\x4c\x6d\x54\xcb\xeb\xab\x00\x93\x01\xf4\x18\xe2\xac\xd6\xaf\x5e\x06\x98\x17\xe8\x7d\x43\xc8\xc5\xde\x91\x0f\x6a\x95\xf2\xf3\x80\x4d\xbc\x37\x29\xa4\xb7\xf9\xaf\xa9\x5a\xad\x52\x06\x6c\x7f\x01\x70\x58\x88\xf4\x0e\xdf\xaa\x5d\x69\xbe\x04\x88\x70\x28\x08\xf9\x7f\x48\x8b\xf5\xc6\x52\x19\x90\x45\x7f\x54\x88\xeb\x07\xde\x6f\x5d\x29\xbf\xb4\x0a\xc8\x2d\x00\x21\x4d\x82\xf8\xe1\x4a\xf9\x4a\x05\x90\xdf\x06\xd8\xbb\x42\xbe\xbc\xfd\x56\x7e\xb5\xb1\xde\x04\x1c\x85\x7d\x3f\x0e\x80\xf3\x4f\xe8\x0d\x98\x01\x76\x83\xfd\x01\xc0\x8f\x75\x4d\x9f\x41\xa5\xde\x87\x88\x20\xe7\xc3\xd4\x4c\x03\x9f\x9a\xf8\x9d\xdd\x3b\x98\xe6\x77\x44\xef\x01\x0e\x43\x5a\xcb\x0d\x19\x04\x19\x9a\x6b\x5e\x83\x30\xe1\x55\x00\xc3\x70\x82\xc3\x0e\x42\xe8\xde\xa1\x7b\x43\xf7\xd4\x7b\xab\xf7\x7e\x76\xef\xef\x1f\xed\x6e\x2b\x1f\xbb\xef\x2b\x9f\x9a\x76\x77\x76\x77\xf5\x78\xfb\xf6\x9f\x6a\x76\xf9\x63\xd7\x7d\xe5\x53\xd2\xec\xb4\xfb\x71\xf0\x6f\xc1\xfb\xc1\x0f\x83\xbf\x08\x36\x83\x7d\xc3\xf2\x30\x06\x77\xfa\x78\xc0\x69\x64\x7b\xd2\x43\xa0\xdd\x5d\x38\x0e\xf8\x31\x20\xf7\x14\xe1\x81\xf9\x3c\x79\xcc\x84\x12\x88\x6f\xf2\x22\x38\x3c\x88\x22\x01\x90\x4b\x75\x49\x13\xb9\xc9\x6c\x26\x11\x8f\x46\x24\x8b\xcf\x1b\xf0\x7b\x26\x02\x7e\x9f\x57\xb2\x44\x23\x89\x78\x36\x93\x9b\xf4\xb8\x54\x17\x4b\x96\x5a\xaf\x55\x2a\x95\xca\x6b\x7a\x7b\xeb\xda\xb5\x6b\xd7\x6e\x69\xed\x58\x89\x88\x0a\xdd\x76\x7b\xdf\xf8\x5a\xa5\xc2\xb1\x67\x16\xed\x4e\x5b\x38\x80\xef\xe7\x77\x20\x88\x08\x10\xfb\xda\xcc\x66\x86\xaf\x48\x7b\x91\x50\x7c\x42\xc6\x9f\x53\xeb\x8e\xbe\xb2\x45\x80\xdf\xe4\x45\x78\x71\x04\xa7\x01\x0a\x8b\x24\x2e\x91\x25\x2c\xd2\xb8\x32\xb9\x49\x55\x72\x79\x03\x7e\x35\x6c\x39\x38\x18\x0b\x8b\xaa\x74\x83\xa8\xd3\x25\x0a\xa5\x16\xdd\xcc\xc6\xe2\xf1\x58\xb6\x5b\xcc\xc6\x63\xb1\x78\xf6\xae\xac\xb8\xd8\x61\x7b\x38\x7c\xb4\x5b\xcc\xc6\xe2\x7d\x7d\xec\xd7\xdd\xa2\x5b\x56\x14\xd9\x4d\x37\x5d\x8a\x2c\x2b\x2e\x5e\x8c\xc5\xb3\xdd\x92\xee\x4f\x9b\xd9\x78\xec\xdd\x9d\xbf\xba\x65\x25\xd6\xd7\x17\x8f\x65\xe9\xe6\xd1\x70\xf8\x05\x45\x76\x77\x4b\x6e\xe1\xee\xa6\x4d\xb7\xac\xe8\x6b\xb8\x85\x97\x79\x92\xdd\x15\xa7\x9f\xa4\x80\x94\x90\x12\xb9\x44\x2e\x90\xe3\xc9\x2f\xbe\x38\xb5\xf7\xc7\x6e\x1c\xe8\x80\x50\x44\x91\xdf\xe4\x10\xfc\x92\xc6\xa7\x43\x9f\xb2\x36\xe3\x7d\x7e\x49\x10\x4c\x93\xaa\x5e\xe3\x36\xbb\xa1\x97\xbc\x53\xdd\x16\x15\xbb\x95\xee\x96\xa2\x50\x52\x71\x73\x28\xb2\xdb\x2d\x6f\x6b\x83\xf2\xb6\xc0\xba\xc1\xa5\xd0\x88\x8c\x47\xf2\x91\x48\x40\xc6\x0a\xfe\x0f\x85\x8d\x95\x54\xc3\x86\x66\x45\xb7\x78\x5d\xc4\x77\xbb\x65\x59\xde\xde\xa9\xea\x99\xd9\x8d\x6d\x59\x96\xdd\x1c\x72\xf7\xae\xec\xd6\x0b\xd0\x32\x2b\xb2\xdb\xa5\x65\xf6\xc8\xb2\xd8\xcd\x9b\x18\xe1\x25\x3e\x02\x0e\x2b\x90\x0b\xfb\xc2\x24\x25\x54\x97\xea\xda\x64\xed\x9d\x02\xc3\xe6\x66\xab\xf0\x72\x89\x4a\xed\x36\x6d\x76\x4b\xe8\xc5\x26\x8a\xbc\xc4\xc5\xb9\x96\x60\x83\x03\x1e\x04\x30\x80\x20\xa2\x40\x2c\xab\xfa\x78\x56\xf5\x51\x56\xf5\xe5\x54\x5f\x54\xe8\xb0\x31\xa6\x1a\xe3\x6c\xb3\xdd\x6e\x77\x0b\xed\x42\xbb\xbd\x0b\x01\xda\xed\x82\x50\xc5\x76\x9b\xa0\x8b\x66\xd9\x6f\x19\xda\x6d\x71\xd6\xf6\xd6\xce\x0c\x1b\x5c\x08\x00\xa4\xfa\xa2\xd9\xa8\x27\x2b\x94\x88\x1c\xf5\x0d\x69\x43\xac\xd4\x29\xb4\x5a\x85\x4e\xb7\xd5\x69\xb5\x3a\xec\x6e\xa7\xd5\x2d\xb4\x3a\x05\x1a\xed\x14\x5a\xed\x56\xa1\xf3\x95\xb1\x54\xae\x45\xd1\x62\x65\x5d\x5a\xcb\x36\x5b\x9d\x42\xa1\xd3\xea\xb6\x0b\x9d\x4e\x81\x15\x0b\x9d\x9d\x76\xa7\xd0\xa2\x4e\xab\x53\x10\x63\x2d\xfd\x4c\xb4\xf0\x34\x7f\x81\x8f\xc0\x86\x01\x88\xff\x1b\x51\x51\x59\x56\x4c\x59\x7d\xf8\x6b\x10\x0e\x48\x89\x6c\x62\xff\x48\xaa\xfa\x56\x0a\x30\x94\x92\xa5\x52\xb2\xf4\xc2\xc2\xf1\xe3\xc7\x8f\x2f\x68\x2d\x2d\x74\xa6\x3b\xf2\x5c\xad\xb9\xb8\xb8\xb8\xd8\x6c\x2e\x2c\x9c\x91\x19\x09\xa7\xd2\xd6\x9e\x8b\x68\x3b\xd3\x9d\x88\x7c\x66\x61\xa1\xa9\xfb\xd5\xe6\xe4\x07\x6a\x12\xdf\xa6\xa4\x3e\xc3\xc0\x7f\x57\x0b\x25\xb7\xb6\xea\x5f\x53\xc7\x48\xb2\xfd\xb5\x35\x10\x5a\xe0\xbc\xc5\x8b\x50\xc5\xb7\xc9\xef\xf3\x5a\xa2\x91\x78\xf6\x14\xe5\x62\x76\x12\x28\x33\xa9\x4e\xf8\x03\xb1\x14\xe9\x48\xb7\x27\xcc\xc3\xe4\xf3\x5a\x24\xf3\x14\x71\xa8\x33\x33\xaa\x3a\xc3\xba\x7f\x74\x8f\x1e\xca\xcc\xcd\x66\xfb\x8f\xb9\x69\x94\xf8\x6c\xd6\xe8\x74\x3b\xc4\x19\xa3\x31\xe7\xe8\xce\x8c\xaa\xce\xce\xaa\x92\xc3\x72\xcb\xe5\x12\x76\xb7\xf3\x1d\xc9\xd1\x93\x99\x9b\xcb\x64\x67\x3d\xae\x5b\x3d\x0e\xa9\xc7\x21\xdd\x72\xb8\xc4\x9e\xf7\xee\x7e\xc4\x3f\xe7\xef\xc1\x8b\xa3\x00\x45\xec\xcc\xe7\x1d\x66\xea\xc4\x14\xcb\x66\x52\x2c\xea\x89\x46\x2c\x43\xa4\x4a\x7b\xab\x98\x99\x3c\x49\x3e\x86\xcb\xb7\xaf\x9f\x3f\x7f\xfd\xf6\xe5\x3d\xdd\x9c\x91\xe4\xef\x28\xf3\xdf\x9c\x9f\x3f\x95\x9a\xe1\xef\xe5\x0f\x98\x2e\xdf\xbe\x9e\xef\xfe\xd6\x69\x9b\x9c\xfb\xe4\x93\xb9\x63\x63\xff\xbb\x08\xf3\x81\x9c\xe7\xf1\x6d\xac\xe0\x95\xc7\x64\xe6\x76\x8a\x26\x69\x7c\x2c\xa2\xb3\x73\x92\x52\x34\x9e\x14\x54\x25\x34\x8e\x72\xaa\x20\xc6\x4e\x92\xce\x90\x9d\x7c\x5e\x7f\x40\xc7\xb1\x03\x5b\xce\x67\x4e\x52\xd4\x4e\xc2\x3e\x4c\xb9\x29\x4a\xa4\x28\x9a\x4b\x89\xc8\x92\x36\x1a\x18\x26\xf5\x84\x66\x78\x74\x52\x83\x81\x63\xbd\x8c\x31\x72\x2c\x6f\xf4\x93\x9d\xc8\xc4\xac\x21\xa7\xc7\xf3\x22\xbd\x38\x28\x9b\xec\x2c\x20\xfb\x94\x5e\x9b\xc3\xe6\x8c\x0d\x0f\x13\x9b\x13\xec\xce\x75\x7f\xc7\x4c\xcc\x15\xb4\xd9\x6c\x2c\x13\x8d\x8e\x30\x76\x34\x7c\xcc\x3a\xdc\xc7\xcc\xdc\xea\x30\x9b\xc8\x24\x45\x3d\xcc\xcc\x7d\xfe\xde\x47\x39\xda\x39\x63\xb3\x0c\x3e\x73\x76\xc8\x13\x36\x5b\xe5\xe4\xd0\x85\x20\x71\xab\x44\x44\x4c\x4e\x0c\x86\x62\x72\x8f\x22\xf5\x38\x7b\x1d\x23\xd1\xa8\x6a\xff\x30\x9f\x57\xf3\x79\xf5\x24\x93\xad\xb6\x7e\x65\x60\x88\xf1\xd8\x40\x7f\xf4\xf7\xa1\x3e\xe2\x4e\x45\x39\xe4\x18\x88\x92\x25\x17\x7c\x60\x6d\x9f\x7e\xdc\xda\xa6\x28\x61\x27\x29\x3e\x99\x8b\x4f\x06\xfc\x76\x16\xf0\x4f\x91\x60\x57\x63\x45\x4a\x09\x56\x72\x93\x7e\x61\x79\x0c\x39\x91\xbe\x01\x8f\x35\x14\x93\xe3\xf6\x40\x32\x3d\x68\xf5\x99\x0e\xc7\x33\x01\x6b\x20\x72\x31\x3f\xde\x63\x1a\x74\x8f\x06\x19\xaf\x4f\x5f\x78\xc5\x66\x71\x8f\x9c\x1d\x79\xcc\x6c\xdf\x66\x5e\x5b\xf6\xb9\x81\xc5\x97\xa7\xe3\xdf\xf7\xd8\xa6\xce\x9e\x90\xa4\xc9\xc5\x52\x5f\xc4\x72\x74\x7e\xbc\x6f\x28\x6c\x9d\x3e\x36\x5b\x96\x7a\x67\xe7\x46\x8b\x67\x13\xfc\xc1\x7d\x5a\x78\xdc\x5c\x06\xc9\xe7\xb5\x33\xb1\x3d\x26\xfc\xea\xc4\x64\x2e\x45\x89\x61\xd2\xc1\x69\x1a\xa6\x80\xbe\x69\xf6\x80\x38\x6b\x53\x94\xb3\x93\xa4\xb7\x8f\xce\xef\xfd\xc3\xe7\x2e\x9f\x3d\x3a\x1b\xb6\xc8\x96\xc8\xe9\xfe\xd0\xb8\xd5\xdc\x6b\x92\x14\x4b\xf8\x98\xd5\x2f\x7b\xfa\x24\xcb\xe1\xea\x69\x46\x4f\x1d\xf1\x1d\xb6\x3b\x83\x76\xb3\xdf\x61\x1f\x54\x7a\xfb\xed\x8f\xd9\xfa\x2b\x5c\xe2\x63\x97\xe6\x27\x02\x5e\xf2\x7b\x28\x3e\x44\xc4\x48\xca\x46\xc9\x6c\x21\x65\xa4\x5f\xe9\xb7\x8d\xe6\xb8\xc5\x66\x3e\xf2\x94\xd3\xce\xe5\x1e\xd7\x80\x95\xf5\x58\x1c\xfd\x56\xe3\x9e\x45\xc0\x8f\x66\x5f\xff\xd3\x73\x8e\x53\xff\x80\xcd\xa4\x5d\xba\x3e\xcc\xfc\xb9\xf3\x1f\x6d\xb0\x22\xee\xdb\x6c\xff\x3e\x07\xed\x4e\x0a\xdd\x9f\x7f\xfe\xc8\x0d\xd0\x67\x3a\xd0\x61\xda\xf5\x0d\x25\x43\x8a\x26\x60\xcb\xd0\x42\x36\x0d\xd9\x32\xa4\x75\x40\xbc\x07\x45\x0b\x66\x85\x17\xc3\xf8\x2e\xde\xc4\x3f\xc9\x49\xff\x47\x2d\x7a\x93\x3a\xf4\x25\x8b\xb0\x3a\x7b\x87\x7d\xc9\x33\xfc\xbe\xe9\xbc\xe9\x7d\xad\x22\x1f\xae\xa3\x77\xbf\x84\x87\x1f\x3f\xd9\xf7\xeb\xb6\xe0\x75\x03\x93\xe6\x4b\xc6\x5b\x12\x9d\x34\x30\x07\xa3\xb4\x81\x4d\x60\xb4\x60\x60\x33\x6c\xfb\x71\x2c\x60\x94\x35\xb0\x15\x87\xa8\x64\x60\x1b\x8e\xc1\x62\x60\x05\x1c\x6f\x19\xd8\x8e\x08\x7e\x23\xee\xcf\x26\x51\xa5\x8c\x9f\x18\x98\x44\x06\x03\x33\xd8\xe9\x8c\x81\x39\x4c\x34\x6e\x60\x13\x4c\x74\xc1\xc0\x66\xf8\xa9\xdf\xc0\x16\x98\x28\x67\x60\x2b\x32\xb4\x6a\x60\x1b\x2e\x42\x35\xb0\x02\x0b\x7e\x69\x60\x3b\x9e\xc1\x07\xf5\x2a\xea\xa8\xd6\x9a\xcd\xd5\xf5\x13\xe9\x74\x75\xb9\x59\xdb\xb8\x94\x5a\x6a\x5c\x49\xaf\x97\x97\x6a\xcb\x2b\x4b\xb5\x46\xa3\xbe\xb1\x96\xae\x2f\x57\x6b\xcd\x67\xcb\xf5\x7a\x65\xed\x2a\x6a\x68\xa2\x89\x55\xac\xe3\x04\xd2\x48\xa3\x8a\x65\x34\x51\xc3\x06\x2e\x21\x85\x25\x34\x70\x05\x69\xac\xa3\x8c\x25\xd4\xb0\x8c\x15\x4d\x37\xd0\x40\x1d\x1b\x58\x43\x1a\x75\x2c\xa3\xaa\xc5\x79\x16\x65\xd4\x51\x47\x05\x6b\xb8\xaa\xe7\x3c\xf8\x66\x7e\xae\x80\x3c\xe6\x50\x10\x15\x9e\x48\xa7\x1b\xab\x95\x95\xf5\xc6\xc6\xda\x52\x25\xd5\x58\xab\xa6\xeb\xcb\x4b\x95\x95\xf5\xca\x7a\x3a\x3f\x53\xd8\x2f\x4b\x2f\xaa\x81\x55\x54\x20\x82\x35\xb4\xa4\x4b\xa8\x20\x85\x06\xd6\x50\x35\x0a\x58\x32\xec\x15\xac\x23\x8d\x3c\x66\x50\x28\x55\xd6\xd6\x97\x1b\x2b\xa1\xf1\xd4\x18\x4a\x5a\x4d\xeb\x58\x46\x03\x2b\x08\x61\x1c\x29\x8c\xe9\x74\xe9\xed\x85\x4a\x75\xa3\x5e\x5e\xc3\x05\x54\x50\xc5\x06\xea\x28\x63\x4d\x37\x9d\x6b\xac\x34\x43\xd5\xca\x4a\x65\xad\xdc\xac\x3c\x1f\xba\x74\x35\x34\xb3\xd4\xc8\x37\x1a\x2b\x29\x9c\xd3\xc2\x35\x11\x42\x55\xcb\x2f\x92\x94\xd1\x44\x05\xcf\x23\x84\x4b\xb8\x8a\x10\x66\x34\x12\xf3\x1a\x65\x2b\x48\x19\xbf\xf2\x9e\xf0\xfc\x3b\x00\x00\xff\xff\xb7\x8d\xe4\xa6\x74\x0f\x00\x00"),
-		},
-		"/img": &vfsgen۰DirInfo{
-			name:    "img",
-			modTime: time.Date(2019, 3, 27, 10, 51, 33, 129822618, time.UTC),
-		},
-		"/img/album.png": &vfsgen۰CompressedFileInfo{
-			name:             "album.png",
-			modTime:          time.Date(2019, 3, 27, 10, 51, 33, 143153326, time.UTC),
-			uncompressedSize: 5558,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x78\x7b\x54\x52\x79\xf7\xfe\x87\xac\xb0\x8b\x9a\x39\x65\x17\x2f\x4d\x91\x64\x9a\x8a\x9a\x26\x0a\xe8\xe4\x25\xd2\x34\xb2\x32\x2d\xb5\x11\xcd\x1c\x33\x4d\xbc\x24\x02\x92\x36\x5e\xc6\xd2\x77\xc0\x14\xad\x80\xb1\x51\x2c\x4b\xb2\x2c\x53\x43\x9d\xd2\x1a\x12\xcb\xbc\x64\x9a\x62\x26\xa1\x90\x97\x04\xd4\xc0\x0b\xbf\x45\xef\xac\xf5\x5b\xeb\xfb\xfd\xeb\xfb\xfe\xfd\xfe\x71\xd6\x3a\x6b\xef\x67\x9f\xbd\xcf\x73\x9e\x75\x9e\xb3\x4f\xce\x61\x5f\x2f\x9d\xd5\x5b\x56\x03\x00\x74\xb0\x07\xdc\x8f\x00\x00\x51\x00\x00\xb9\xac\xbd\x12\x00\xd0\xac\xe8\xf4\x06\x00\x2c\x27\xfc\x84\x3d\xaa\xad\xad\xad\x4d\xd2\x0e\xcf\x06\x00\xac\x8a\x3b\x10\x48\x00\x60\x83\x4c\x73\x40\x1a\x1a\xd5\xa9\x00\x80\xcd\x09\x1e\x27\x12\xfc\x63\xcf\x24\x24\xff\x1c\x1f\x01\x92\x93\x93\xad\xa2\xce\x47\x13\xf0\x3f\xc7\x45\x58\xc5\xc6\x47\x5e\x9f\x74\xd9\x02\xc0\x06\x3b\xac\xbb\xdb\xd1\x8b\x37\x26\x06\x63\xae\x04\xcf\x5c\x1a\x9a\x1e\xba\x62\xbc\x57\xaf\xe1\xa2\x4f\x1c\xc2\xdb\x70\x43\xcc\xfb\x92\xab\x71\xf7\xd7\x7a\xa6\xbc\x5f\xbf\x00\x7c\x1b\x2a\xab\x96\x6d\x48\xc0\xef\x0f\xcb\x6a\x71\x79\x7a\xe7\xf8\xb5\xdd\x31\x51\xd8\x86\x23\x3d\xb3\x1e\x16\xeb\xf1\xb4\xf7\x97\x5b\x82\x37\x3f\xe2\xe7\xb6\x4f\x7e\x9a\x7e\x5f\xfe\xd6\x33\x30\x3b\x23\xe6\x86\xd9\xfd\xdc\x1f\xee\x75\xee\x2c\xa7\x9a\x6e\xcb\x8f\xa9\xe6\x58\x5b\x19\x68\x31\xa7\x07\xa6\x05\x03\x6a\x27\xb5\x5c\x79\xc0\xeb\x99\xec\xfd\x5c\xf2\x66\x09\x85\x3c\xc9\x23\x4c\x1e\x56\xc9\x97\x60\x4d\x74\x91\x16\xb6\xea\xc0\x03\x7b\x7e\x3a\x5a\x6d\x29\xff\x62\x88\xa5\x30\x44\xc5\xb0\xa1\x03\xb2\x21\xfa\x02\xb7\x34\x97\xea\xf0\x6d\x06\x56\x0b\x53\x66\x61\x29\x16\xfc\x08\xac\x30\x24\x41\xdf\xc0\x17\xee\x54\x22\xaa\x83\x0d\x1d\x94\x71\xb0\x4b\xf1\x0e\x69\xc3\x71\xb4\xfc\x9d\x4a\x0a\xed\x82\x57\x74\x9e\x3f\xb6\x91\x19\xfe\xb9\x6f\xe8\x08\xf5\xae\x8f\x0c\x2e\x41\x1d\x2f\xa0\x3c\x8d\x57\xa4\x91\x30\xfc\x4c\x45\xbf\xcb\x8e\x29\xac\xcc\x50\xe7\x89\x56\x9e\x1c\xe3\xbb\x53\x69\x8d\x15\xea\x53\x85\xf8\xbf\xd2\xf2\xfe\x55\xbb\xf8\xed\x0c\xf1\xea\x64\x9b\x14\xf6\x72\xab\x4d\x3f\xc2\xf1\x25\x5d\xc2\x73\x5f\x96\xd0\xdb\x2a\x41\x6d\x2c\xa0\xb8\xc4\x0b\xda\x2f\xb8\xf0\x4b\x7c\x46\x9e\x63\x29\xef\xa8\xd5\x79\x75\xa8\xc6\x22\x51\x29\x08\x56\x4c\xc2\xd4\x76\x9f\x4d\xb3\x8c\x08\xa9\x0b\x2e\x7c\x9d\xd8\x6f\xe8\xc8\x31\x1d\x35\x39\x90\xb6\x1f\x6b\xb5\x76\xaf\xca\x93\xa8\x2e\x75\x3e\x46\xd4\xb6\xb1\x79\x8e\xf5\xa4\xdf\xdd\x83\xba\x9c\x72\xc1\x96\x37\xe7\xbe\xb0\xcd\x49\xfe\x49\x75\x98\x86\xc9\x63\xf4\x8e\xab\x60\x69\x87\x89\xcb\x6d\x0e\xa7\xad\x43\x70\xfb\x0e\xd0\xb8\xa2\x76\xf8\xa8\xf2\xc4\x2b\x0c\xf9\x23\x43\x3d\xf2\x72\x9a\x88\x4c\x68\xed\x84\x71\xcb\x17\x46\xf2\xf0\x4b\xf9\x3a\xfc\x62\x3b\x45\x9a\x7e\xfd\xc7\x00\xda\xc0\x49\xe2\x72\x9b\x75\x73\x66\xca\x9e\xce\x1d\x9b\xb2\xd3\x8a\xc3\xcf\xee\xe2\xe5\xbe\x20\x55\x7e\xdd\xb4\x72\xf4\x57\x58\x51\x79\xf2\xf0\x32\xb6\xef\x73\xec\x92\x03\x7f\x67\x59\x96\x8d\x8d\xa9\x91\xaf\xac\xa1\x2c\xc0\xe6\xb0\x37\xfd\x94\xa6\x92\x5b\x95\xc3\x48\x6e\x9f\xb2\xf2\x89\x0f\x96\x6c\x58\x3b\xb0\xe3\x42\xca\x89\x8a\x3e\x6e\x91\x49\x73\xf3\xbd\x2d\x07\xa7\xa2\x8a\xbd\xbd\xad\x21\xe0\xf5\x87\xfc\xcc\xce\xf9\xb8\xfc\x26\x7f\xe2\x2a\x10\x27\xa4\x20\x0a\x84\x27\x85\x10\xea\xc6\xeb\xd3\x9c\xc7\x00\xb4\xef\x18\x03\x87\x21\x6e\x50\xea\x6a\x6d\x24\x38\xea\xda\x01\x61\x2f\x4b\xd7\x05\x1b\xd6\x25\x80\x0a\x2a\x5b\xcb\x75\xc5\x72\x63\xb0\x7b\xdb\xff\x82\xac\x81\x92\x33\xfc\x5c\xa6\xdd\x0b\x2d\x03\x6d\xc7\xae\xdf\xb4\xe3\xf8\xde\x7c\x55\xb8\xe7\xe4\x83\x34\xf5\x52\xa7\x3f\xb8\x53\x43\x9c\x91\x9e\xfb\xf0\x78\xcf\x9a\x8d\x96\xbb\x50\x9f\x9f\x1a\x3b\x27\x59\x56\x1e\xbf\xb7\x65\x2c\x46\x77\x61\xc4\x3f\xdf\x04\x55\x52\xe6\x5d\xa4\x2b\x33\x1b\x15\xdc\xf6\xe7\x3c\x88\xea\x2a\x27\x29\xdb\x42\xad\x50\x2b\xd8\xbf\xd4\x0a\x89\xd1\x49\x93\x03\xc5\xbf\xae\x81\x52\x84\x4e\xf0\x10\xd2\x74\xeb\x29\xd2\x74\xab\x16\xef\x90\x5f\xdd\x1c\x56\x00\x59\x39\xfa\xd6\xcb\xb4\x3d\x53\x67\xab\xac\x3f\x9a\x7b\x8d\xd9\xb9\x25\x5d\x08\x45\x40\x37\xb9\xe0\x58\x9b\xdd\x58\x2f\x24\x5d\x9c\x56\x23\xf6\x3a\xdc\x14\x8b\xcd\xca\x60\x68\x15\xc0\xa5\x8b\xfa\x0a\x73\x9f\x96\x85\xdf\xbd\x4c\xcc\x51\x2b\xd8\x72\x77\x32\x31\x1d\xbd\x0a\x01\x3d\x6b\x82\xc3\x38\xba\xa5\xad\x41\x40\xcf\x5a\xe1\x58\x25\xec\xa6\x4b\x0c\x2d\xfb\xa1\x5b\xf9\xe8\x92\xab\x5b\x1d\x7f\x53\x9d\xd4\x56\x7d\x69\x31\x62\xa3\x92\x26\xfa\x77\x4a\x63\xdf\xbe\xf6\xfe\x2a\xa8\x2e\x7f\xb9\x90\xbe\xc6\xea\x6f\xf2\xdc\x60\x51\x9b\x55\xc3\x5d\xc5\x0f\x6c\x3c\x33\x6d\x49\x49\x12\xdb\xb1\xf8\x39\x06\xf0\x83\x7e\x4e\x6d\x55\xc1\x0d\x34\x58\xa8\xf2\x55\x40\x50\xaa\x47\x5d\x5d\xb7\x52\xad\x75\x28\x5f\x49\xdb\xe9\xfd\x20\x76\xa8\xf1\x54\x62\xcf\xb1\x32\x0e\x30\x7e\x44\x59\x54\xdd\x0d\xe1\x21\xbd\xf2\x4d\xf6\xa0\x3e\x5b\x1a\xe0\x86\xea\x08\x91\x95\xdc\x45\xb4\x21\x72\xb1\xa0\x81\xa1\xe5\x13\xdb\xf7\x20\xa2\xaf\x29\x2d\xcd\xaa\x4e\x44\xeb\xf4\x37\x7b\x14\xdd\x5b\x75\xee\xfd\xfd\x1f\x39\xbe\x37\x77\xa3\xca\x2a\x5e\xaf\x0c\x16\x40\x54\x70\x69\x7b\x2f\x9f\x9d\xeb\xd4\x2c\x38\xe4\xce\xbb\xcd\x62\xf4\xcd\x54\x24\x5b\x8d\x8d\x8c\x8c\xd4\xcd\x4f\x36\xb4\x1a\x7d\x74\xb1\x3c\xf9\xd8\x32\x1f\xb3\xf4\xa4\xc2\xf1\x30\x27\x1d\x8d\x46\x33\x1b\x26\xeb\x2d\xf4\x50\x8a\xc3\x12\x73\xfa\x7a\x02\x5b\xc7\x9d\x57\x8c\x34\x48\x40\x40\x19\xce\xa5\x42\x97\x9b\xda\xf5\x0c\x2d\x01\x0a\x17\x5b\x28\xcd\xb0\x12\x03\x39\x47\x07\xa7\x16\xc1\x31\xc5\x5d\x1c\xbf\x22\xa6\xed\xd4\x1d\x5d\x4d\xba\xa9\xdc\x6b\xd1\x3b\x54\x75\xc4\x21\x56\x78\x8a\xdc\x5d\xa9\xa3\x6a\x69\xe8\x6b\x35\x3a\xbd\x94\x43\x4c\x39\xb6\x92\xbc\x5d\xfc\xe1\x71\x8c\x05\x2a\xe2\x48\x98\x6e\xf7\x81\xee\xb6\x3b\x56\x63\x31\xd9\x4a\x21\x2f\xb5\xd5\xa8\xe2\x48\x97\x35\xda\x9f\xd0\x5d\xb9\x9a\x78\x32\xac\x91\xa4\x94\x75\xfa\x03\x71\xfb\xb5\x80\x10\x73\xe9\x8a\xa3\x06\x8a\xf2\x97\xa4\xe9\x4f\xad\x29\xe3\xd5\x2f\x5b\x8c\xc6\x2e\x3b\xb8\xf3\x1e\x27\x37\x05\xe8\x2b\x1c\x5a\x48\xe7\xce\x59\x5a\x04\x54\x59\xa2\x56\x9c\x3e\x5d\x62\x32\x93\x65\x4a\x79\x72\xa7\xa2\x16\x3d\x16\xa6\xdb\x71\xaa\x14\xbd\xef\x9e\x66\x28\x7b\x56\x85\x9e\x67\x74\x86\xe6\xfe\x52\x27\xeb\xbc\x04\x90\x20\xd7\xee\xd1\x50\x8b\x0c\xb4\xbe\x1c\x6a\x4a\xba\xaf\x28\xc0\xe6\x38\x35\xf7\x55\x3d\x95\xbd\xba\x92\x97\x27\x2e\xcd\xb5\xda\x8d\x8a\x38\x12\x93\x4f\xfc\x92\x6e\x25\x06\x13\x8f\x48\x4a\xd9\xf8\x87\x5a\xbf\xee\x51\xb7\xca\xef\x01\xb8\x54\x7e\x6b\xaf\x9b\x21\x81\x4a\xcc\x56\x0d\x44\x7b\x74\xb4\x1a\xb1\xd1\x31\xd9\xaa\x9b\xc9\xc2\xbe\x92\x0a\x47\x4d\xeb\xc3\xdc\x72\x2f\xf2\xcb\x3c\xe3\x7b\x39\xc4\x5b\x19\xc2\x4c\xc6\x54\xdf\x43\x2f\x01\xe4\xcc\xcf\x4a\xd9\xe7\xbe\x86\x64\x6e\xc5\x9b\x23\xf8\x0c\x61\x21\x23\x94\xd0\xbe\xb7\x8c\x03\x6a\x84\xf3\xc2\x39\x34\x8e\x55\xe6\xb9\x92\x4c\x22\xb9\xcc\x09\x53\xb9\x0c\xad\x84\xec\x8a\x37\xbe\x23\xe9\x68\x7d\xf9\xbd\x93\xb5\x85\x4c\x5b\x73\x04\x94\x81\x29\xa5\x2c\x6d\xd9\xa0\xe1\x22\x3c\xdc\x5d\x00\x11\x17\x57\xb0\x58\x2f\x96\xf3\x74\x10\xa6\x5b\xb7\x1a\x10\xa8\x90\x75\x33\xc5\xd1\xee\x1d\xad\x46\xd4\xda\xf5\x33\xc5\xe6\x26\x09\x08\xe8\xe9\xdd\xa5\xc2\xa6\x52\x37\x43\x02\x28\xdf\x21\x5d\x34\xdf\x98\x80\x80\xb2\x43\x4b\xd1\xbc\xed\x9a\xd0\x99\x3b\x3a\x38\x75\x37\x07\x7d\xcc\x52\xaa\xa5\x57\xcf\xd0\x8a\x6b\x2c\x31\x99\x99\xf8\x50\x6b\x98\xab\x3a\x4c\x9b\x76\x13\x7e\xf4\xc2\xa8\xde\x92\x26\xa2\x43\xb7\x8a\x0e\xcd\x4e\x3c\x5b\x63\x2d\x10\xf2\x52\x1f\x86\xb5\xfe\xd6\xe9\x0f\xf6\x65\xed\xcf\xd2\x8d\x18\xac\x4f\x74\x4a\x1a\x3f\x21\x31\xa7\xd7\x2b\xc5\xd7\xe5\x3d\x5c\x4a\x22\xbb\xd6\xe9\x2b\xdb\xb1\xa7\xc5\x03\x5f\xc5\x9b\xed\xef\xf4\x07\x63\xcf\x87\x9f\x67\x3d\x4c\x9a\xe8\x7f\xe1\x49\xee\xf8\x4d\xfd\x3e\xc8\x20\xdb\xc9\x75\xfe\x14\x79\xa6\xa7\xaf\x21\x39\x56\xb8\x38\x99\x5c\xc4\xec\xd4\xbe\xa9\x19\xa0\xa6\xa8\x82\xf5\x56\xc2\xce\x75\x72\x9d\xcf\x21\xfa\x45\x6a\x44\x36\xb3\x6e\x26\x4f\x23\x65\x69\xd7\x77\xe9\x6a\xa8\xb5\xf2\xe2\xf9\x6b\xd8\x57\x25\xee\x95\xde\x5c\x55\xcf\xd0\x4a\xf0\x32\xbc\x25\xcc\xd1\x94\xcd\x25\xec\x91\xf6\x40\x83\x05\x90\xfa\x4a\x6f\x1c\x17\x39\x16\xa6\xeb\x1a\x50\x1a\x12\xd3\x61\x68\x1d\x72\x83\x97\x3a\xf7\x9b\x13\xa8\x53\x49\x2b\xed\x90\xc8\x92\xd6\x5c\xc3\x22\xa6\x6d\xec\x9d\xb5\xaa\x4f\xad\xb9\x76\x0e\x0e\x85\xd7\x2c\x02\x0a\x99\xcb\x5c\xf7\xdf\x3d\x55\x67\x8b\xc7\xe3\x7b\x3c\x79\xf5\xec\xfc\x2b\x57\xc2\xe7\x26\x07\xc3\x74\x9b\x03\x8f\x57\x9d\x2a\x5e\x9c\x9f\x0b\x50\x2a\x52\x8f\xb9\x99\x6e\xde\xcc\x1f\x1b\x3b\x7b\xfc\x5e\xd0\x31\xe2\x6a\xa0\x73\xec\x4b\xb4\xb4\x67\x39\xf9\x53\x54\x12\x02\x7a\x1a\xb9\xb7\xb1\x3c\xc4\xa3\xc3\x70\x4f\x50\xc1\x60\x43\xf2\x68\x49\x74\x95\x39\x6a\x05\xb5\xf0\x44\x5d\x7c\xc4\xec\xc4\x87\x80\xf6\x62\x0d\xb7\x9d\x3b\xce\xe0\xc3\xc3\x61\x78\x3c\x3e\x4c\xb7\x39\x25\x45\x7a\x9b\xf3\xe0\xfc\x60\xfd\x8d\x7d\x17\x4a\x15\x05\x81\x51\x33\x9b\x55\xa5\x1e\xb9\xb6\x48\xe4\x6e\x31\xb8\x51\x1b\xf4\xba\xfb\xe4\x56\x85\x47\xee\xc6\x88\x37\x4c\x54\x88\x1d\xf4\xf4\xbb\xe3\x77\x4f\xfc\x5e\x5d\x5d\x7d\xdc\x8b\x77\xcd\xaf\x2e\xd7\x09\xc8\x87\x3b\xfe\xd8\x1f\xd8\x90\x14\x93\x67\x8a\x09\x21\xbe\xba\xf2\x68\x72\x6d\xb0\x00\xc2\xb0\xb6\x38\x7e\xf7\x6f\x21\x2f\x95\x30\xfc\xab\x61\x21\xd3\x16\x7f\x6f\x75\x3d\x43\xcb\x66\xa8\x7f\xcb\xcc\xbb\xe5\xc1\x02\xc8\xa6\x02\x07\x4f\xde\x06\x8d\x0e\x56\xde\x8b\xc1\x99\xfa\xca\x82\x24\xfa\xa3\xb6\xd3\x04\x71\x98\x6e\x33\xfb\xde\x89\xea\xed\x0d\xc9\x53\xaf\xff\xfe\xfb\x80\xc4\xbc\x2b\x25\x66\x17\xbf\x0d\xbb\x45\x89\x5e\x4c\xe6\x7d\x09\x4e\x59\x98\x7e\x49\x5e\xbe\x1b\x78\x18\xef\x8b\xdb\xe6\x38\x10\xb7\x9f\xa2\xea\x62\x15\x32\x6d\xaf\xe1\xd7\xd0\x16\xba\x60\x4d\xc7\x89\xfc\xf0\xc9\x26\xf5\xd2\x00\xf1\xcb\xbd\xb2\x48\xd0\x71\xa1\x28\x84\x18\x25\x9c\x7d\x5c\x1b\x71\xee\xdc\x5d\x45\xc1\x6d\x52\xec\x3e\xfe\x5f\x9d\x16\xe5\xf1\xad\x1b\x02\x0a\x98\x00\x60\x69\x6d\xd6\x4d\x4f\x15\x97\x09\x4e\x2e\x37\x44\xd5\x9d\x16\x80\x1a\xa8\x94\x0f\xc3\x6e\x49\x78\x79\xba\xa0\xe6\x4d\x1b\xd6\x33\x8b\xbe\xe0\xc2\x7f\xf7\x0c\x0f\xa8\x72\xf9\x69\x1a\xcb\x6f\xc1\x48\x95\x76\x30\xbe\xe6\xe3\x26\x70\x5a\x9c\x67\xa6\xcc\xe7\x17\xaf\x69\x5b\xd8\xc7\x17\xd7\x7c\xcd\x08\x02\xec\x5b\x2f\xe8\xe7\xb0\xdc\xe1\xd8\xfa\x23\x03\x78\xec\xa4\x45\xb5\x36\x60\x54\xcf\xd3\x16\xf8\x2d\xd6\x42\xa2\xa4\x37\xad\x48\x94\xbf\x12\x09\x05\x8c\xdb\x98\x83\xdc\xbe\x26\xfb\xbe\x47\x03\x6a\x1b\xbe\x31\x36\xc4\xb8\x65\x3b\x60\xc7\x72\xcb\x7b\xc7\x30\xa4\x2f\xb1\x3e\x33\xc3\x96\xa1\xbe\xf1\xc7\x68\x18\xdf\x78\xe2\x52\x12\x04\x90\xf7\xdd\x0c\xa1\x73\x0f\x72\xdf\x46\x3a\xf5\x72\xee\x2d\xda\xf0\x5f\xd2\x2c\xbd\xa7\x6a\x96\xfe\x3e\xc5\xd5\x02\xe5\xf6\x4c\x91\x04\xa6\x76\xe0\x63\x0e\x70\xf9\x98\x1b\xd2\x5c\xc3\xd9\x69\x43\x74\x91\x28\x94\x47\x21\x59\xf0\xe7\x23\x9b\xf4\x01\xe3\x85\x01\x16\xdd\x31\x65\xf8\x46\x21\xef\xf0\x6b\xff\xfa\x05\x42\x95\xdb\xdd\x12\xbf\xb6\x1f\x03\xae\x5a\xab\xfe\x0f\x86\xfa\x5f\xc8\x7f\x21\xff\x31\x44\xa5\xa3\x2a\x85\xd5\xc2\xea\x48\x26\xb5\x00\x44\x9e\x62\x86\xa4\x1a\xce\xc8\x5b\xbb\x56\x2c\xf2\xb0\x4b\xd1\x34\x43\xda\x42\x2d\xac\xe8\xf5\x2f\x5b\x40\x07\xd7\xbb\x2b\x7a\x6a\x8d\xe4\xd1\x37\xde\xa8\xea\xd3\xed\xf4\x25\x0b\xac\xc9\x81\x78\x18\x7f\x0a\x9e\x32\x6f\xa4\xbb\x1c\xa8\xe6\x66\x15\x41\x24\x18\x3f\xb2\x2b\x5a\x11\x68\xb6\x46\xf5\x19\xcb\x3c\x10\x3f\xf5\x43\x93\x1e\x58\x2b\x8c\xa3\xa5\xd2\x8c\x7c\x64\xd7\xe9\x8b\xe2\xad\x8b\x9e\xb4\xd8\x33\x25\x5d\x44\xc0\x66\x58\xd0\x16\xf2\x15\x25\x8b\xde\xb1\x6b\x55\xa5\xb0\x06\xfa\x42\xff\x47\x07\x08\x18\x1d\xa6\x29\x4a\x16\xdb\x1c\x05\x3c\xc4\xd8\x49\xfa\xd3\xc8\x8e\x5c\x40\x95\xcb\x98\x30\x3d\xdf\x85\xb0\xed\x63\xbd\x9c\xb9\x74\x67\xd0\x61\xa2\xb6\xe4\x67\xd1\xed\xdc\xd4\x8e\x65\x85\x00\x3c\x94\x71\xe9\x21\x1d\x45\x9f\x67\xea\xb5\xc0\x3d\xf9\x30\x2c\x40\x17\xae\xe4\x3d\xdb\x0f\xa8\x7d\x4a\xf8\x73\x2c\xa5\x41\xb1\x1a\x9c\x3e\x4e\x49\xdd\xc7\x8f\xbd\x16\x02\x01\x82\x57\xa9\x59\xf4\x85\xc1\xef\x2f\x95\xba\xb3\xfb\xf8\xce\xde\x5c\x00\xce\x7c\x0e\xae\xea\xf9\x9f\xcc\x75\x8c\xb1\x31\x73\x03\x84\x32\x38\x70\xa5\x89\xa0\x98\x6f\x6e\x92\x55\xa3\xf6\x05\xe1\x4f\x15\xd9\xb7\x9f\xca\x6f\x4e\x09\x96\x16\xe7\x09\x83\x89\xbd\xd7\x98\xcb\x5c\xb3\x8c\x5d\x2e\xda\xf4\x86\xa6\x91\x29\x32\x3a\xab\x80\x69\x5b\xe6\xc9\xfa\x43\x3d\x1e\x89\x69\x3b\xe4\x38\x58\x00\x67\x91\x1e\x28\x7e\x00\x48\xe9\xc7\xbf\x32\x02\x6b\xcf\x07\xdf\xc2\x33\xed\x5d\xc6\xb0\xb2\x57\x16\xdc\x6c\x24\xf0\xf0\x69\x59\xf8\x36\x3d\x52\xc6\xf1\xa9\x9c\x76\xf3\x73\x37\xa6\xfe\x15\xed\x2f\xa0\x17\x2e\x47\x49\x3c\xc8\x8b\xaa\x19\xa7\xb8\x8f\xae\x12\x73\x70\x74\xb2\xf9\x92\x56\xe0\xc3\x33\x87\xa2\xc3\x98\xf6\x0b\x87\x2e\xd0\x03\x6b\xce\xe2\x3c\xbd\xbc\x78\x38\x5d\x6a\xca\x85\xb1\x8e\x3f\xee\x06\x37\x3c\x3e\x86\xf0\x0b\x4a\xc8\x44\xff\xd5\x35\x05\x04\x45\x8f\x5f\xb8\x44\x0d\x6f\x56\x5d\xfe\xc7\x26\x02\x1f\xfd\x72\x4c\x73\x1d\x76\x3b\xc2\x2f\xe8\x62\x26\x7a\xf8\x5a\x40\x08\xff\xaa\xa3\x90\x11\x9b\x36\xff\xa1\x8c\x03\x3a\xe2\x63\xf2\x53\x3a\x3f\xb1\xe1\xa5\xba\xcd\xe3\x3d\xeb\xe4\x7f\x7e\xfe\x3e\x8c\x3c\xc0\x5b\x40\x5f\x9d\xce\xcc\x46\x02\xbb\x00\x7f\x01\xfd\xe0\xf7\xd3\xc5\xca\x3d\x62\xac\xc7\x77\x80\xa4\x67\x93\xfc\xcf\x07\xdf\xcb\xba\x75\x8d\x91\xdd\x77\xda\xea\x5e\xbb\x27\x7e\x4f\x3d\x3c\xd4\xb2\xf0\xbe\x1a\x5f\xc6\x11\xe4\xee\xce\xd0\x94\x45\x76\x9b\xc8\xff\xfc\xc4\x86\x47\x5a\x19\x58\x48\xc1\x60\xcf\xaf\x0c\xf8\x9e\x46\xc5\x17\x9f\x2b\x57\xae\x84\x27\x24\x74\x47\x84\x41\x90\xe2\xaf\xc3\xcf\x35\xbe\x35\x83\xf0\xdb\x8d\xd9\xcf\x8a\x93\x89\xb0\x91\x52\x60\xdc\x55\xc6\x80\x1b\x3b\xed\x67\xd9\xd8\xda\xd2\x50\xa9\xb3\xa2\xfe\x68\xee\x1e\xd4\x0a\x6a\x4a\xf7\xa3\x7f\xc7\xe3\x64\x22\xfe\xc0\xc5\x91\xab\xff\xb0\x25\xa0\x5b\xbd\x70\x91\x9c\xe8\x30\xc0\x41\x2b\x02\x03\xf0\xcc\x04\x9b\xb1\x9c\x0a\xad\xee\x8a\x36\x84\x1f\x72\x8b\xc2\xbd\x1b\xf2\x4b\xe7\x0c\x03\x6e\xac\x4b\xdc\x2e\x05\x7b\xd7\xc9\x99\xbe\x50\x94\x7e\x22\xd5\xc1\x40\x6e\x87\x34\xd5\xa4\xfb\x0f\x08\x86\xce\x7d\x74\x6b\x52\x8a\xaf\x43\xc9\x74\xb2\x53\x8a\x2c\xfc\x1d\x37\x94\x95\x01\xd5\x2b\x64\x2e\x6b\xb6\xd7\x35\x46\x16\xec\x8d\x7e\x67\xef\x20\xae\x1d\x88\x6f\x6b\x8e\x25\x7c\x74\x4b\xfb\x78\x09\x1a\xff\x06\x35\xb7\x56\x75\x66\x3e\x7e\xe1\xdb\xf4\xdd\xc0\x87\x2f\x8a\x91\xae\x19\xc6\x68\xd2\x93\xb7\xa6\x97\xea\x53\xe4\x62\x97\xaf\xcd\x5a\x5a\x4d\x83\x97\xa0\xe4\xb3\x67\x2b\xde\xe2\xe6\x14\xce\xae\x84\xbd\x8b\x51\xb3\xed\xd7\x1d\x47\xe5\x2c\x35\x29\x33\x0b\xaa\xfe\x2d\xe9\xf7\xde\x0e\x0f\x63\x6a\xf1\x7a\x39\xc1\x62\xb6\xfc\x49\x4e\xc5\x10\x79\x6e\xb0\xcc\xec\x06\xbd\x42\xcb\x3e\x8b\xe1\xc7\x1d\x6b\x18\xc8\xca\xcc\x94\xf5\xbd\xa1\x3c\x9e\xc6\xa9\x5f\x27\x37\xfa\x13\x57\xbb\x46\xe6\xa4\xa4\x69\x3e\xa3\xb6\x96\x36\x9e\x1f\x44\xe2\xa0\xbb\x5c\x05\xf3\x01\x14\x37\xcd\x23\xc8\x51\x0a\xec\xf1\x1e\xee\xc6\x54\x3b\x26\x45\x15\x85\x60\xfd\x21\xb6\xcc\xa9\xd0\xda\x26\x1e\xad\x0c\x8c\xde\x2e\x05\xc1\x0e\xfa\xf2\xa2\x50\x4a\x9d\xa2\x00\x1b\xd3\x5f\x73\xfb\x77\x84\xdf\x96\x4d\x1a\x02\xea\x2b\x74\x10\xea\x67\x86\x8d\x1d\x7f\x33\xe0\x3a\x3a\x1a\x9a\x82\xb7\x8b\x1f\xf6\x0e\x44\xe8\x27\x52\xad\xd6\xc9\xeb\xde\x8e\x27\xea\x27\x52\x4b\x72\x94\x03\x51\x4b\xcf\x6a\x76\x48\xab\x76\x41\x6d\xec\xc2\xd0\xb5\xfd\xd5\xb6\x91\xa5\x94\xde\xe0\xe4\x6b\x4c\xdb\xdb\x06\x70\xdf\x6c\xa4\x2b\xab\x85\x34\xf9\x28\x32\x8a\x88\x53\x0f\x8c\xa2\xe1\x52\xb0\xc7\x4c\x2c\xbf\x15\x1c\xdd\xc5\xac\xc0\xcc\x74\xfb\x97\x71\x80\x7d\x1e\xe3\x0d\x6b\x76\x8f\xaf\xf3\x3f\xd3\x5f\xd4\x42\xb0\x42\x27\x4e\x19\xe3\xa0\x67\x0d\x10\xac\xb4\x89\x60\x13\x1c\xf4\x6c\xc6\x0e\xaf\xbc\x6c\x25\xa8\x49\x25\xbb\x1b\xb3\xf9\x79\x46\x4e\xb4\xbd\xd1\xef\x34\x01\xb5\x89\x7b\x37\xa4\xa4\x42\x82\x50\xb7\xc0\xd1\xb8\x43\xff\x28\xd4\x95\x5e\xf3\xa3\x38\x74\xe1\x93\x61\x65\x4e\xca\x11\x5c\x07\xdd\x0e\x37\xf4\x20\xc2\x5b\xb3\xc1\x24\xb2\xd7\xb4\x3c\xbd\x52\x3f\x79\x88\x88\x1b\xaa\x4f\xac\xac\xd0\xfa\x61\x9b\xf8\x73\x77\x72\xfe\x76\x29\xd8\x99\x9d\x12\x98\x28\xac\xcc\x46\x36\x6f\x43\xc9\x05\x76\x75\x92\xf2\xeb\x2d\x4f\xd9\xc4\xa9\xc0\x7e\xfd\x99\x7c\x92\x68\x87\x53\xbc\xe8\x80\xc4\x1c\x8c\x4e\x14\x47\x32\xf9\xd2\x9e\x4a\xf9\xb3\x35\xd6\x05\x4c\xdb\xdb\x56\x2d\x2e\xb7\x5d\xc6\xb0\xf2\x5b\x4b\x9d\xde\xd6\x11\x13\xfd\x8f\x4a\x75\x6b\x68\x0c\x47\xaf\xc5\x39\x9b\x89\x1c\x55\x72\xd3\xfc\x60\xa7\x3f\x50\x99\x89\xdb\x3b\xaf\xa6\x16\x27\xb9\x93\xdb\x4b\xf6\x96\xea\xd6\xfc\xd4\x85\x5f\x9a\xce\x4f\x7b\xea\x8f\xf0\x43\xfe\xa0\xe1\x5c\xfc\xd3\xd6\xb0\x46\xf2\xfc\xac\x6f\xab\x46\xba\x74\x3b\x9c\x5a\xc1\xc1\x04\x13\x5f\xfd\xe9\x6e\x7c\xd6\x16\x11\x5b\x35\x39\x38\xa2\x28\x5d\xfa\x98\xa1\xf7\x1b\xb2\x79\xad\xcb\xfc\xf8\xc3\x30\xe1\xe9\xc1\xa7\xbd\xfa\x89\xd4\xe2\x8b\xb3\x7d\x91\x84\xe7\x3a\x8e\xd9\x29\x19\x89\x73\x4c\xaf\x6e\x88\xea\x47\xf1\xc4\xfd\xfb\x0a\x6a\xa7\x59\x29\x13\xa6\x59\xde\x86\xf7\x46\xa6\x4a\xca\x8a\x64\x7f\xe8\x91\x77\xcd\x0d\x26\x87\x5a\x35\x8c\x3f\xe8\x24\x50\xf3\x52\x96\x94\xa3\x04\xf5\xe2\xdc\xa8\x88\x4f\x2f\xe3\xf8\x48\x1f\x7f\x38\xff\x65\xa0\xa1\x8f\x63\x5b\x10\x4e\xfa\x97\xe3\x45\xc5\xd8\x5b\xcb\xe0\xfa\x84\x7f\x39\xf6\x15\x75\x71\xfc\x22\xbb\xca\x0f\xa5\x88\x7e\xf7\x3a\xe8\x07\x61\xeb\xd5\x0e\x26\x9d\xd4\xac\xdb\x2b\xd6\x6c\x2c\x60\xc6\x17\x1a\x86\x92\x10\x86\xa1\xa4\x69\xd7\xd8\x2e\x4c\x54\x88\xa3\x8b\xcb\x0d\x63\xe7\x24\x51\x9e\xf3\xe4\x2e\xd4\x0a\x6a\x94\xbd\x93\xb8\x4c\x5b\xb5\x35\x1b\xe9\x2a\xcf\x51\x8a\x96\x2f\xee\x71\x37\x66\xd7\xb5\x90\x7e\x49\x47\xaf\xc2\x41\xcf\x9a\x20\x30\x8e\x6e\xac\xf4\x7f\x4b\xdc\xaf\xe3\x8d\x5b\x37\xe4\x95\x81\x7c\x49\x5b\x65\x9f\x99\x95\xf5\x90\x3c\x3f\x3b\x40\x99\x9f\x28\xe3\x80\x84\xd7\x13\xb5\x43\x56\x62\xf2\x7a\x05\xda\xf2\xc4\xfd\x6d\xce\x49\xe3\xeb\x12\xa9\x90\xfe\x27\x17\xf0\x49\xe3\xef\x7f\xfc\x1e\x3f\xe4\x4e\x1e\x79\x71\xb5\x8c\x03\x8e\xc6\x8e\xbc\xb8\x9a\xf2\x6d\x38\xab\x95\xb4\x72\x25\xa5\xec\x09\x9c\x79\x71\xbb\x77\xa1\xe5\x66\xd1\xa1\xd7\xb7\xfd\x39\xbe\x11\x6d\x85\x7b\x34\xab\xb2\xc4\x1c\x1c\x0b\xe2\x11\x13\x1f\xc7\x7c\x78\x27\x65\x98\xfe\xff\x9f\x0d\x2e\x63\xa0\x79\x40\x5e\xe0\xfc\x1f\xda\x6a\x26\x7d\xa1\x54\xa1\xad\xf1\x1d\xc4\x0d\xd1\x09\xc9\x06\xd0\x7c\xd7\x28\x94\x21\xe2\xd2\x4e\x05\x11\x57\x11\xa4\xea\x9d\x4a\x4f\x89\xbe\x2b\x3e\x98\x25\x8a\x29\xf3\xb2\xe1\x2c\xfd\xf2\x33\x0d\x4e\xbf\x6b\xc5\xbb\xec\xb4\xb7\x50\x64\x5a\x64\x02\xb1\x7f\xc7\xda\xa9\xf4\xd2\xe4\x98\x46\xbe\xb2\x99\x4e\x0b\x06\xa1\x91\xd3\x5c\x77\xf1\x4a\xc5\x9b\xde\xac\x10\x75\x3e\x87\x6e\x69\x5d\x77\xd9\x49\x4e\x87\xa5\x59\xf3\x2d\xca\xb2\xc0\xd5\x99\x4a\xec\x92\x03\x7f\x45\x59\x96\x0d\xbc\xb1\x48\x74\x27\xc7\xf4\xe4\xbc\xde\xce\x22\x1b\xce\x63\x4f\xc1\x25\xc2\xfc\x05\x9a\xa6\x51\xe4\x12\x99\x36\x9d\x63\xa7\xf8\x03\x96\xf6\xbb\x28\xe3\xf3\xac\x0d\x34\xcf\x2c\xb0\x50\xd4\xd3\xb9\x9f\xfa\xa2\x61\x1b\xff\x21\xd6\xca\x1c\x75\x39\x50\x79\x9c\xa6\xb7\x73\x33\x6b\xdf\x7e\x53\x3f\xd9\x3c\x36\xcf\xf0\x7a\xcb\xb9\x9b\xbe\xf1\x5e\x2d\x46\x63\x6f\x2a\x25\xa8\x49\xd6\x6e\xd4\x65\x79\x92\x2d\xff\x85\x62\x0b\xa5\x50\xaf\x7c\x6e\xcb\xf8\x93\x5b\xc7\x5e\x4a\x60\x27\xcd\x94\x94\x65\x3e\xa3\x06\x12\x54\x66\x01\xc5\x88\x20\x18\xff\x86\xc1\x9e\x2f\x11\xcd\x2b\xcd\x2a\xbb\x9c\xfd\xad\x51\xef\xde\x0e\x4d\xa7\xd1\x07\x9c\x24\xc9\xeb\xd9\x98\xe4\xf2\xb9\x13\x47\x54\xd3\x82\xb7\x43\x17\x5c\xf8\x25\xd8\x26\x3a\xfd\xa2\x1f\x91\x7b\xbb\xc8\x64\x62\xdf\xdc\x74\xef\x74\x23\xf4\xf4\x79\x92\x0d\xef\x65\x41\x67\x7e\x74\x1e\xc1\x74\xc8\x87\xfb\xd6\xce\xf6\x86\x08\x8f\x6d\x1c\xa2\x3a\x7f\xbc\x28\x9f\xbe\x05\x53\xbb\xf0\x9d\xcb\x17\x26\x21\x82\x01\xe7\xce\xd4\x73\x4a\xdc\x2b\xcc\x6b\x27\x21\x89\x84\xe1\x67\xde\x18\xd9\xdf\x93\x59\xae\xba\x5a\x7b\x31\x8d\x36\x84\x95\x5d\x82\x05\xc0\x02\x4b\xc2\xdd\x58\x69\x85\x7d\xf3\x43\xd8\x25\x33\x5e\xa5\x6a\xc2\x77\xea\x60\x1c\xf7\x9a\xd1\xf2\xa3\x31\x3f\xd3\x12\xb1\xef\xe0\x75\x56\xfc\x21\xbf\xf8\x0b\x7c\x37\x63\x02\x92\xc7\x0a\x22\x5a\xf1\x92\xab\xd5\x51\xb4\xa6\x1b\x93\x7a\x8b\x69\xa1\x73\x7d\x14\x7e\xe8\x46\x1a\x00\x00\x60\x3d\x7c\xdd\xab\x7e\x3a\x9d\xfe\xff\x02\x00\x00\xff\xff\x28\x23\xef\xb2\xb6\x15\x00\x00"),
-		},
-		"/img/icon.svg": &vfsgen۰CompressedFileInfo{
-			name:             "icon.svg",
-			modTime:          time.Date(2019, 3, 26, 11, 26, 27, 210985790, time.UTC),
-			uncompressedSize: 3673,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x56\x5d\x8f\xdc\x26\x14\x7d\xdf\x5f\x41\xc9\x4b\xa2\x8e\x31\xe0\x8f\xb1\x9d\xf1\x46\x6a\xa3\xa8\x91\xda\x97\x36\x6d\x9f\x59\xc3\x78\x68\x6c\xb0\x80\xf9\xca\xaf\xaf\xf0\xf8\x6b\x66\x77\x9b\x2a\xed\x4b\xe2\x97\x1d\xce\x3d\x17\xb8\xe7\xde\x83\x76\xf3\xe6\xd4\x36\xe0\x20\x8c\x95\x5a\x95\x90\x20\x0c\x81\x50\x95\xe6\x52\xd5\x25\xfc\xfd\xc3\xbb\x20\x83\xc0\x3a\xa6\x38\x6b\xb4\x12\x25\x54\x1a\xbe\xb9\xbf\xdb\x7c\x17\x04\xe0\x47\x23\x98\x13\x1c\x1c\xa5\xdb\x81\xf7\xea\xa3\xad\x58\x27\xc0\xcb\x9d\x73\x5d\x11\x86\xc7\xe3\x11\xc9\x01\x44\xda\xd4\xe1\x2b\x10\x04\xf7\x77\x77\x1b\x7b\xa8\xef\x00\x00\xa7\xb6\x51\xb6\xe0\x55\x09\x87\x84\x6e\x6f\x9a\x9e\xc8\xab\x50\x34\xa2\x15\xca\xd9\x90\x20\x12\xc2\x99\x5e\xcd\xf4\xca\x9f\x2e\x0f\xa2\xd2\x6d\xab\x95\xed\x33\x95\x7d\xb1\x20\x1b\xbe\x9d\xd8\xfe\x36\xc7\xa8\x27\x91\x3c\xcf\x43\x4c\x43\x4a\x03\xc3\xb7\x81\x3d\x2b\xc7\x4e\xc1\x75\xaa\x3d\xd4\x4f\xa5\x52\x8c\x71\x68\x0f\xf5\xcc\xfc\x77\xac\xc2\x6a\x2e\x3b\xcd\xe5\x44\x1f\x01\x64\xf5\xde\x54\x62\xab\x4d\x2d\x90\x12\x2e\x7c\xfb\xe1\xed\x14\x0c\x30\xe2\x8e\x2f\xb6\x19\xf5\xbc\x3a\xf5\x4a\x64\xc5\x5a\x61\x3b\x56\x09\x1b\x8e\x78\x9f\x7f\x94\xdc\xed\x4a\x48\x09\x6e\xdb\x1e\xd8\x09\x59\xef\x5c\x09\x69\xbe\x1e\x90\x83\x14\xc7\x1f\xf4\xa9\x84\x18\x60\x40\x09\x06\x34\x5f\x5f\x02\xf3\x74\x90\x1e\x90\xbc\x84\xf6\x50\x67\x97\xc5\x70\x4c\x31\xd1\x30\xca\x29\x8a\x41\xc2\x59\x9a\xe5\x55\x44\xa2\x15\xa0\x98\xe4\x01\x26\x01\x89\xfb\x9c\xb1\xc0\x82\xeb\xca\xdf\xb8\x84\xb2\xd2\x0a\x79\xcd\xee\xef\x00\xd8\x70\xb1\xb5\x9e\x77\x39\xca\xaf\x28\x04\x61\x1f\x9a\x52\x7d\x1e\xf7\x77\x9e\x89\x0f\xcc\x5e\xaa\x05\xa0\x63\xb5\xa8\x74\xa3\x4d\x09\x5f\x6c\xfb\x6f\x08\x3c\x68\xc3\x85\x19\x43\x69\xff\x5d\x85\x74\xc7\x2a\xe9\xce\x17\x2f\x0c\x7b\x8f\x25\xfa\x5d\xa7\x38\x7e\x3a\x6e\x77\x8c\xeb\x63\x09\xe9\x6d\xf0\x93\xd6\xad\xcf\x5a\xdf\x06\xaa\x53\x09\x83\x84\xa2\x35\xcd\xa2\xf4\x51\xf0\x5c\xc2\x8c\xc6\x28\x4b\xa2\x38\xbb\x0d\x72\x5d\xed\xbd\x4f\x82\xbd\x92\xce\x96\x70\xe8\xe4\x32\x7d\x6f\x8c\x27\x34\xec\x2c\x4c\x09\xfb\x3f\x64\x20\xd9\x9d\x3e\xd6\xc6\x0b\xb7\x65\xcd\xa4\xdc\x94\x7a\x94\x8a\xeb\x63\x30\x4c\x0e\x89\xd2\x47\x15\x0d\x8c\x71\x94\xd2\x9c\x3c\xc3\x38\x95\x90\xd2\x47\x6a\x0d\x41\x2f\x35\x79\x24\xca\x10\x6c\xd9\x49\xb6\xf2\x93\xe0\x25\xc4\xe3\x08\xb4\xc2\x31\xce\x1c\x9b\x1b\x3f\x22\x49\x3f\x3e\x00\x6c\x0c\xdf\x16\xbf\xbe\x7d\x77\x59\x01\xb0\xa9\xaa\xe2\x4f\x6d\x3e\x0e\x4b\x00\x80\x27\xb0\x07\xbd\x77\x25\x84\xf7\x13\xbc\xe1\x55\xb1\xd5\xa6\x65\xee\x5e\xb6\xac\x16\xde\xc5\xdf\x9f\xda\x66\x13\xce\x81\x2b\xb2\x3b\x77\x62\xde\xf4\xb2\xad\x11\x17\x4f\x3f\xf9\xb0\xf1\xaa\x95\x3e\x29\xfc\xcd\xc9\xa6\x79\xef\x0f\x19\xca\x5a\x6c\x2a\x5d\x23\x66\x70\x13\x0e\xb7\x1f\x6a\x0b\x17\xc5\x6d\xc2\xb1\xf4\x7e\x55\xdf\x88\xd8\xb0\x07\xd1\x94\xf0\x67\xdf\x75\xf0\xa8\x39\xb5\xd1\xfb\xae\xd5\x5c\x0c\x73\x01\x67\x41\x87\x39\x19\x4e\xac\xc7\xeb\xf9\x50\x9d\x45\x31\x9c\x80\x71\x2f\x71\xea\xb4\x71\xc1\x56\x36\xe2\x62\xe8\xd0\xb5\x5d\xc8\x50\xa7\xea\x67\xc9\x27\xde\xc9\x12\xd2\x0c\x25\x31\xc6\x98\x3c\xcb\x3b\xdf\xf0\x26\x61\x8c\xa8\xdc\xac\xfe\xb9\x84\x39\x4a\x49\x12\x61\x4c\xe1\x0c\x9f\x4a\x48\x62\x94\x46\x19\x49\xd2\x05\x3c\x0d\x2d\x5e\x80\xc3\xac\x67\x4b\xcc\xd7\xec\x0f\x8a\x13\x92\x2d\x60\xeb\xce\x8d\x28\xe1\x56\x36\x4d\x31\xbc\x30\xaf\xfd\x22\x18\xde\x87\x82\xbc\xb6\xce\xe8\x8f\xa2\x78\x81\xfb\x6f\x58\x5e\xfc\x54\x24\xe3\xb2\x91\x4a\x54\xac\x2b\x8c\xde\x2b\xbe\x04\xff\xd2\x52\x5d\xa3\xad\x74\xc2\x34\xb2\x95\xae\x88\x47\x8c\x33\xbb\x63\xc6\xb0\x73\xa1\xb4\x12\x4b\x54\x6f\xb7\x56\xb8\x62\x3a\x77\xba\xd7\x62\xde\xe6\xce\x02\xe0\x0c\x53\xd6\xcf\x78\x09\xfb\x9f\x0d\x73\xe2\x25\x45\xa4\x17\x8e\xae\x02\x4a\x62\x94\x65\xf1\xfa\xd5\x8d\x36\x75\x9c\xc4\xd1\xd2\x43\x1d\x73\xbb\xa5\x27\xbe\x62\xa5\x96\x65\xf0\x12\xfe\x02\x08\x46\xc9\x8a\xa6\x39\x22\x74\x1d\x53\xf0\x07\xa0\x59\x8a\x12\xb0\x03\x24\x45\x38\x4a\x69\x44\xc0\x01\x04\x04\x45\x51\x1a\x25\xe0\xd3\xd5\x06\xf3\x20\x2d\x0c\x74\x35\xef\x95\x56\x4a\x54\x4e\x9b\xa0\xda\x9b\x03\x73\x7b\x23\xe6\x67\xef\x19\x75\x3f\x97\x7c\x7b\x03\x9f\x1f\x27\x51\xfe\xb8\x36\xba\x46\xd9\x9a\x26\xd9\x8a\x52\x8a\xe2\x3c\x5f\xaf\xc1\x4f\x7d\xc1\xbe\xcc\x28\x43\x49\x94\xfa\x42\x9f\xac\xed\x2b\xee\xf1\xf2\xa1\xad\x3f\x63\x8c\x96\x39\x23\x4f\x2f\x03\x8c\xf2\x3c\xcf\xb3\x2c\x89\x57\x78\x85\x57\x04\x5d\x8a\x8a\x57\x04\xaf\xbd\x63\x70\x32\xf9\x25\x23\x4f\x19\x26\xff\x27\xc3\x7c\x59\x4b\xe3\xe4\xff\x1d\xd7\x6f\xa2\xa5\xdf\xf8\x73\xf4\x5f\x2c\xbb\x98\x9c\xf5\x17\x3e\x47\x93\x61\x86\x1f\xfd\x9f\x8d\xff\xa7\xe9\xfe\xee\xef\x00\x00\x00\xff\xff\x08\x43\xba\x41\x59\x0e\x00\x00"),
-		},
-		"/img/loading.gif": &vfsgen۰CompressedFileInfo{
-			name:             "loading.gif",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 3801,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xd7\x79\x54\x13\x87\xbe\xc0\xf1\xd9\x33\x49\x66\x20\x1b\x69\x12\x59\x42\xc0\x16\x91\x72\x11\xd1\xaa\xd5\x6b\x98\x24\x04\x48\x94\x45\x16\xeb\x43\x0d\x1a\x44\x52\x81\xb0\x88\x88\x5b\x12\x22\x04\xb0\x0a\x82\x57\x0a\x8f\x16\x14\x11\x69\x05\xb4\x2a\xa8\xd7\x92\xb2\xc9\x2a\x0a\x8a\x8a\x1b\x22\xa8\xa8\x58\xd7\xa7\xbe\xfb\xee\xf3\x1d\xbd\xb6\xe7\xe8\x7b\xf7\xd8\x73\xde\x9d\x3f\x67\xfe\xf9\xcd\xe7\xcc\xf7\x37\x33\xca\x00\xbf\x59\xb3\xa3\xc5\x80\x18\x78\x2e\x04\x7c\x7c\x7c\x96\x2f\x5f\x4e\x51\xd4\xdc\xb9\x73\x23\x22\x22\x8a\x8b\x8b\xbf\xf8\xe2\x0b\xb9\x5c\xee\xed\xed\x6d\xb1\x58\x0a\x0a\x0a\x94\x4a\xe5\xc6\x8d\x1b\xbb\xbb\xbb\x9b\x9b\x9b\xab\xaa\xaa\x9e\x3d\x7b\xf6\xfa\xf5\xeb\xf9\xf3\xe7\xcf\x9b\x37\x2f\x2e\x2e\xae\xb1\xb1\xd1\x68\x34\x26\x25\x25\xa9\xd5\xea\xa0\xa0\xa0\xa8\xa8\xa8\xfa\xfa\xfa\xc4\xc4\xc4\xb1\xb1\xb1\xc8\xc8\xc8\xa1\xa1\xa1\xb4\xb4\xb4\x6f\xbf\xfd\xf6\xf0\xe1\xc3\x56\xab\x75\xda\xb4\x69\xc0\xbf\xfa\x70\x7e\xcd\x5c\xa8\x08\x5b\x24\xf3\x0d\x56\x78\x7b\x7a\xc1\xe0\x9b\x53\xaf\x10\x94\x01\x08\x01\x8f\x37\xd7\xdf\xdc\x26\x80\xbd\xb6\x6e\xd3\xfb\xbb\xda\x88\xf3\x15\x49\x9d\xae\x2b\x88\x56\xa9\x2b\xc7\x0d\x2a\xe9\x51\xad\xe4\x96\x45\x64\x67\xc2\x3c\x55\xa5\x8f\xa8\xa8\xa2\x68\x0e\x62\x6f\x03\x27\x1f\xea\xd7\x6f\xbb\x78\x43\xfd\x52\xfc\x27\xce\x19\x3a\x95\x4e\x25\xac\xd8\xa4\x70\x8e\x85\x84\x99\xc2\x44\x4e\x32\x82\x28\xa0\x58\xdb\x6d\xcb\x84\x58\x62\xa4\x1c\x5b\x29\x84\x50\x94\x1e\x28\x23\x62\x05\x30\x08\xc2\x78\x62\x52\x3c\x27\x11\x37\xf3\xf7\xa2\xc4\x21\xcc\xb9\x50\x81\x73\x8f\x0a\xf1\x0d\xfa\xcd\x8c\x46\xb0\x2c\xb9\x8d\xd3\x2d\x07\x78\x8d\xe2\x4d\x6d\xe5\x0b\x60\x02\xad\xd2\xd6\xd5\x66\x57\x0c\x37\xab\x00\xf8\xf6\x1d\xb8\x2f\x19\x78\x30\x01\xe0\x3f\x3e\x7e\x9c\x97\xbd\x35\xf1\x05\xed\xc9\x93\x8d\xcf\x5f\xbe\x1c\xbb\xdb\x73\xa0\xc5\x64\x5d\x7a\x32\xa9\xa9\xd5\x38\x90\xaa\x11\xcf\x96\xdd\x5f\xe5\x60\x70\x8d\x6d\x9e\x97\xdd\x92\xbb\xa9\x50\xf6\xe0\x3a\xb2\xb6\xd4\xf1\xf3\xbf\xf9\x7b\x0a\x9c\x73\x58\x5b\xaf\x9e\xe4\x3f\xb9\xf6\x19\x52\xb7\x8d\xa4\x77\x06\x75\xa8\x24\xd3\x6e\xf1\x4e\x1c\xa6\x8d\xce\xf0\xfc\x74\x83\x6e\x22\x6c\xbd\xa6\x99\xa2\x12\xdb\x89\x98\x4b\x8f\xaa\x5b\x67\xfe\x7b\xd6\x5c\xb4\xc1\x47\x76\x81\x9a\x7f\xa8\x71\x1a\xe3\xe8\x14\x41\x9d\xf2\xe4\x0a\x42\x65\xe6\x37\x8a\xdf\x10\xd2\x19\x00\xe7\x1f\x84\x42\x40\x00\x00\xd8\x01\x69\x8e\xde\xdf\x55\x64\x6f\xe3\x9b\x14\xc0\x03\x2c\x66\xbf\xcc\x60\xc2\x6b\x72\x89\x09\x75\xdf\x67\xa3\x28\xa0\x15\x06\xbb\xa5\x4f\x3e\x37\xee\xc5\xca\xb1\xd4\xe6\x98\x46\x72\x1e\xda\xcc\x67\x77\x0c\x9f\x70\x5b\x64\x8e\x5b\x46\xc4\xa3\x91\x94\x38\x66\x83\x91\x03\xb3\xd8\x0e\x0b\x02\xe5\x70\x0c\xb1\x56\x87\xc6\x47\x50\x18\x08\x8a\x36\x7c\x1d\x03\x61\x08\x82\x31\xe3\x49\x39\xee\xf8\x1d\x88\xa4\x02\x62\xa6\x0a\x71\x3a\xaa\x80\xea\x40\x26\x9e\x65\xc5\x05\x75\x42\x6b\x3b\x80\x80\x15\xed\x56\xac\xe7\x47\xe0\x5c\x3f\xd0\xd4\x47\xd1\x2e\x5d\xbe\x94\x3a\x38\x34\x74\x66\x90\x83\x0f\xf4\x5f\xb8\x79\xe7\xee\xf8\xbd\xfb\x0f\x26\x7e\x6c\xf7\x7d\x37\x3d\xff\x1f\xd3\x3b\xbd\x9d\xfe\x91\x34\x4f\xef\xef\x0a\xf1\x72\x7d\x93\x3a\xed\x45\xac\x56\x45\x45\xbd\x1b\xa7\xa4\x5b\x66\x7d\xf3\x00\xe4\xfa\x41\x2a\x1e\x02\x99\x2a\x8a\xe6\xb8\xfa\x88\x18\xde\x21\x29\x59\x4b\x06\x97\x77\x57\xdd\xf4\xea\xc8\x86\x63\x05\x54\x3a\xc5\x4b\x24\xb6\xc8\x85\xb1\xc0\xba\x65\x7c\xcc\x86\xb4\xd3\x02\xdb\xfd\x62\x23\xb7\x12\x89\x91\x72\xfa\x4a\x3e\x4e\xa3\x71\x8a\xcd\xb1\x18\xc0\x64\x02\x50\xa2\xad\xdc\xde\x81\xcd\xfe\x9e\xbb\x8f\x06\xa7\xe1\xc2\x40\x85\x60\x52\x1c\x3f\xe1\x18\x9b\xf4\x3b\x41\xdb\xbc\x05\x96\x9c\x76\xc8\x51\x61\x27\xac\x5a\x97\x63\x2c\xf4\xa2\x0a\x87\x69\xd5\x8b\x11\xb6\x64\xbd\x36\x6d\xcb\xf7\x0a\x7c\xf7\xc3\xdd\xe3\x5b\x7e\x7d\xd8\xdd\x3d\xf2\x97\xe7\xaf\xba\x7b\x9f\xfc\xe7\xc4\x53\x60\xa4\xe2\x64\xb6\x42\xe3\xab\x28\x68\x7e\x7e\xeb\x22\x62\x30\x87\x72\xfe\x7b\x74\x4e\x30\x2b\x6a\xca\xb2\x2d\x3f\x87\x68\x43\x49\x49\x0d\x1d\x01\xd5\xf5\xb2\x60\x8b\x67\x96\x03\x5b\xec\xf5\xc8\x7c\xde\xd3\xc3\x17\x78\x87\xf0\x7b\x05\x6f\x10\x9e\xbf\xad\x40\x02\x81\xd2\xa4\x4e\x1f\x3e\xad\xc3\x37\xe9\x9c\x56\x64\xc3\x95\xf8\x88\x1d\x92\x19\x45\x3a\xb6\x7e\x96\xdb\xd6\x2b\x09\xab\x42\xdc\xf4\xad\x8a\x80\xf5\xc5\x8e\x4b\x18\xb9\xd3\xa3\xfc\xff\x2c\x38\x1b\x42\x21\xf1\xe0\x86\xa0\x18\x5c\xb8\x16\x60\xa0\x74\x3d\x90\x41\xe1\x31\xa1\x6b\xc1\xf8\x30\xf9\x27\x9f\xc8\x8a\x68\x9c\x9d\x91\x34\x1c\x87\xe1\xaa\x78\x42\x0e\xda\x91\xa4\x40\xc6\x8d\xa1\x55\xa7\xee\xa4\x20\xfe\x52\x81\x6d\x1d\x69\xbb\xf2\xc8\xe6\x2c\x8c\xd1\x64\x17\x1d\x71\x44\x17\xee\x5c\x67\x43\x6c\x09\xaf\x5a\xb5\x41\x40\x32\xa0\x2c\x61\x4e\xd6\x21\x35\x5e\x70\xab\xe0\xfa\x86\x7f\xf3\x1f\x67\xb6\xb4\x34\x86\x83\xec\xc7\x4f\xd8\xac\x07\x47\x06\x36\x3c\x7a\xfa\x98\x35\x32\x3a\x76\x95\x5f\x39\xa9\x3a\x2e\x67\xf6\x2c\xda\xcd\xa8\xa4\x06\x33\xf1\xb3\x3b\x30\x6a\x18\x9a\xfe\x03\xd1\x92\x00\x17\x4f\x26\x76\x1f\xea\x41\xa2\xf6\x4a\xc7\x5f\x5d\xd2\x9b\x1a\x2c\x2f\x2c\x95\xf6\xde\x35\x45\x20\xbc\xcf\x9d\x4d\x9f\xe2\x8a\xbf\x93\xe0\xfd\x26\x21\x7c\xb3\x0f\xa4\xb9\x7a\x7f\x0f\x47\x1b\xcb\x9e\xe4\x00\x35\x07\x54\x4a\x93\xac\x51\x22\x9b\xc2\xd8\xa2\x62\xba\xa6\x46\x71\xd7\xd7\x9d\x57\x38\x25\x53\x2a\x86\x3d\x03\xb7\xcb\x9e\x9e\xdd\x9b\x76\xac\x5b\x09\x6f\xf6\xe0\x55\x7f\xb2\x6e\x91\x32\x20\x19\xd7\x89\xe2\x81\x58\x0a\x57\x86\x25\x33\x75\x61\x72\x91\x65\x9b\x92\x93\x17\xe9\xcf\xcb\x2d\xd3\x21\x51\x74\x14\x85\x64\x5c\x25\x0d\x4e\x83\xf3\x28\xcc\x51\x0b\x11\x95\x28\x11\x7d\x80\x96\xb1\x0e\xb0\x3f\x4a\xaf\x88\x38\x50\xa8\x11\x57\x32\xc0\x5a\x05\x0e\x6f\x8c\x87\x50\xfb\xd6\xf8\xb4\x3e\x35\x9e\x7b\x39\xf7\x82\x06\x43\x6e\x0c\x23\xd0\xf1\xe3\x5d\x6a\x84\x1c\xbf\x47\xda\x8c\x1e\x38\xb9\xee\xee\xfd\x71\x9b\x4b\x43\x57\x8c\x3c\xe8\xe6\x70\x95\xea\x6a\x38\x30\x76\x72\x45\xad\xbf\x01\x47\xab\x3e\x07\x1f\xdd\x36\x98\xbf\x74\x92\xc8\x0d\xfa\xfb\xcb\xa7\x2b\x81\x5d\x1e\xab\xc5\xd3\x9a\xb3\x01\xc5\xcc\xd9\x73\xa7\xfb\x0a\x0d\xf4\x85\x50\xea\xdd\x97\x86\x8e\x2f\x25\xa5\x2c\xb1\x97\x46\x5a\xe5\x22\x41\xc5\xfe\x3d\xa7\x53\xa4\x46\x04\xa1\x4c\xc0\xfb\x9d\x88\x01\xc7\xb7\x30\x79\xef\xc3\x84\x74\x85\xbd\x85\x99\x35\x4b\x50\x33\x70\x90\x95\x51\xbe\xa4\x03\xd6\x87\x24\xdb\xef\xec\x2d\x3c\xb6\xe4\xbe\x43\x5e\xe7\x8d\xd9\x2f\x8a\x37\x32\x77\x70\x74\xc0\x2a\x0a\x57\xc6\x6d\x8e\x8c\xf1\xa3\xc9\xb2\x68\x1c\x73\xee\xa6\xd0\x1d\x71\x32\xae\x08\x04\xd7\xf3\x93\xb2\x17\xf2\x61\xf3\x56\xee\x42\x0c\x29\x05\x91\x68\xe5\xda\x14\x5c\x58\x23\x82\xd4\xb9\xb4\xdd\x9a\xc3\xa5\xcc\x0a\x15\xbe\xb4\x52\x85\x81\xc2\x66\xf5\xcf\x9a\x32\x05\x9e\x79\x26\xb3\x33\x1c\x60\x9e\xbf\xc0\x5c\x5a\x7b\xf9\xb4\x8a\x89\x5e\xbf\x81\x32\x2e\x5f\xee\xd6\x5c\x1b\xbe\xce\xe8\xed\x3b\x9b\xb1\x67\xf0\xc2\xf1\x9e\x87\x47\x1f\x46\x5e\x91\x23\x24\x31\x16\x08\xac\xc9\xbf\xbd\x66\xf5\x59\x2b\x88\x6a\x2f\xe9\x15\x8b\x24\xc3\xe0\x2f\xd8\xa4\xc5\xec\xe3\x76\xf4\xc2\x7a\xc0\x89\x8f\x3b\x07\xdf\x8c\x86\x24\xb5\x7f\x59\x40\x69\xac\x80\x09\x65\x4b\x2c\xe2\x66\x2b\xf8\x4b\xce\xa7\xa5\xf6\x73\x7e\x52\xc8\xbb\x00\xae\x30\x4a\xdf\xa0\x05\xcc\xf6\xb8\x53\xfa\x89\xa9\xf9\x3b\x8d\xc1\xf3\x1c\x24\xd2\xfc\x75\xa9\x01\x86\x22\xa7\x52\x53\x1c\xcb\xd8\xb2\xf3\xb7\xb5\x28\x78\xef\xcd\x22\xdd\xf6\x11\xb0\x15\x1f\x05\x53\x60\xeb\x05\xef\xc4\xf2\xe3\x12\xd6\xf8\xcb\x92\xb2\x17\x0a\xde\x72\xe1\x4b\xb3\xa3\x95\x6b\x33\xf6\x17\xec\x91\xe5\xd2\x8a\x35\x07\xb7\xab\xab\xf2\x74\x59\xf0\xf7\x27\x32\x76\x34\x64\x36\x67\x9e\xd2\x54\xc1\x1d\x9d\xda\x9a\x9a\x3a\x35\x0c\x9e\xeb\x3f\xd7\xd3\x73\x46\x75\x76\xa0\x1f\x6f\x69\x6e\x0b\x07\x3a\x47\x2e\xde\xe8\x6b\x12\x44\x5e\x94\x33\x51\xf0\xde\xca\x12\xa3\x8e\x8f\xa2\x0c\xa4\xe1\xe0\x5f\x53\x60\xe2\x39\xca\xe7\x7b\x85\x4e\xce\x19\x98\x0a\x4d\x42\xf0\xaf\xf2\x87\x9d\x50\x49\xcd\x9a\x90\x15\x91\x1a\xa3\x01\x23\x79\x2c\x7a\x79\xb9\x63\x81\xdf\x29\xcf\x68\x9c\xe5\x4c\x01\x04\xc3\x6b\x24\x85\x2b\xcd\x2c\x59\x14\xe0\x5f\x22\xbf\x53\xfe\x4b\x56\x4a\x5a\x44\xe7\xdc\x1c\x23\xd8\x3e\x35\xfb\x8e\x0f\x79\x79\x76\x25\x05\xd3\x8e\x59\x5f\xb2\xa9\x30\x1c\xdc\xf6\xc9\x66\xdf\x4e\xef\x23\xae\x41\xce\xb6\xa2\xa8\xb6\xce\xf3\xee\x17\x77\xb3\xc4\xf8\x79\x2e\xf2\x61\x89\xe2\xff\xab\xc4\xff\xe5\x17\xfa\x47\xfc\x78\x1f\xfa\xf1\xfe\x90\x9f\xa9\xa5\xb5\xa6\xf3\x78\x8a\xb6\xeb\x4c\x4d\x9d\xba\xb7\x0b\x3f\xdd\xda\x74\x61\xb0\x4d\x76\xa5\xbf\x89\x17\x79\x4e\x01\x83\xcc\x6b\x41\x25\x46\x9d\x23\x38\x01\x35\x1c\xfc\x6b\x0f\x32\x01\x3a\xfe\x44\x1d\xda\x49\xc1\x4e\x60\x20\xd3\x43\x23\x2a\xaf\x70\xc4\x24\x35\x6b\xe6\x59\x58\x46\x03\x80\x11\x08\xe1\x16\x6c\x80\x0b\xd0\xe7\x16\x9f\x7b\x47\x0d\xd1\x30\x03\x11\xf9\x88\xa5\x46\xb8\x0d\x5d\xc0\xb7\x0f\x1e\x30\x38\x77\xe5\xbb\xf4\xb9\xb9\x69\x6e\x96\xe6\x03\x04\x13\x37\x06\xec\xa4\x00\xef\xdc\x79\x5b\xd6\x5a\x00\x90\xdb\xe7\x4c\x67\xb8\xb1\xf6\x9b\x41\x19\x26\xdb\xce\xf8\x4a\x1a\xd2\xf2\x27\x1b\xf6\x9f\x59\x05\xee\x66\x5f\x23\x31\x33\x4f\x4f\x76\xb7\x1a\x60\xda\xaf\xcf\x10\xf0\xc3\x6e\xc5\xff\xa2\x6e\x15\xd8\x7a\xfe\x87\x8c\xfc\xff\x0f\x23\xf3\x52\x13\x8c\xb2\xc0\x9e\x1f\x34\x84\x03\x9b\xed\x90\x52\xd6\xad\x40\xc8\x71\x36\x89\xa4\x34\x2a\xb9\x7b\x6c\x9f\x38\xd8\x53\xd8\xa4\x7b\x76\xc2\x61\x23\x03\x58\x30\xe6\x51\x99\x19\xac\x89\x30\x61\xa4\x17\x81\xb3\xc4\xbc\x36\x42\xbe\x82\x1d\xf0\xa8\xfc\x0a\x0b\x64\x39\x2d\x5e\x1c\x5c\x2e\x6d\x20\x78\xb1\x7f\xff\xe9\xe8\x28\x28\x07\x5d\x54\x69\x32\xe9\xb9\x96\x3a\xe7\x47\x22\x2f\x16\xd5\xef\x1a\x34\x2d\xd7\x2b\x31\x62\xf5\xb0\x38\x28\x68\x07\xdf\x27\xf8\x6a\xbb\x19\x92\x33\xae\x6a\x6d\xbe\x15\x0c\x5b\xeb\x00\x72\x26\x6b\x76\xa0\x59\x6a\xf0\xf8\x22\x4f\x4f\x4e\xf5\x37\x42\xbb\x34\xbf\x6f\x3f\xd1\xfb\x8a\xdf\x7c\x44\x51\xf7\x47\x14\x45\x1f\x2a\x8a\x3e\xa6\xa8\x66\x82\x2d\xad\x20\xb3\x29\x36\xb3\x2b\x94\xc5\x3e\xd3\xc7\x26\x9a\xb4\x35\x35\xbd\x67\xcf\xf4\x67\x9c\xbf\x40\x6b\x6e\x6b\x39\x9d\x81\x77\x77\x9d\x92\x43\x4d\x77\xc2\x31\xc2\x06\x49\x09\x3e\xa6\xe6\xd9\x91\xa4\x5d\x4a\xd9\xf1\x40\x81\xed\x0b\xd2\x51\x53\x9c\x79\xbf\x03\x09\xa1\x03\x11\xb8\x57\xbb\xa9\xf1\xb3\xca\xf6\xd5\x4b\x26\x8f\x7e\x07\x63\xdb\xdd\x78\x6e\x62\xc0\x6a\x42\x01\x4f\x76\x8e\x62\x94\x32\xa5\xca\xb0\x35\x45\x33\x35\x8b\x4b\x16\xed\x11\x1d\x88\xae\x7f\xbc\xaf\x7b\xfe\xe2\x7c\xfa\x57\xf5\x05\xce\xd5\x53\x4b\x45\xbc\x53\x50\x61\xb9\x01\x72\xff\x1c\xee\xe9\x37\x6f\xb3\xfa\xa5\x53\x3b\xcf\x34\x3f\x9d\x2a\x03\x99\x51\x06\xbf\xb1\xfd\xd3\x6b\x06\x6b\x1b\xb2\xc8\xa5\x9c\xdf\x62\xb6\x7b\xff\x33\xfb\x63\x31\x47\xfc\x33\x3f\x82\x50\xfc\xee\x67\x87\xb0\xd9\x0e\xa0\x3c\x77\x53\x68\x16\xed\x0d\x21\x05\x3b\x15\xb0\x5d\x98\x32\x78\x95\x0c\x7f\xcb\xa8\x20\x58\x7b\xd0\x52\x5d\x2d\x8d\x53\x5d\x65\xbb\xc7\x25\xa5\x61\xbb\x9a\x49\xb2\x8b\x74\x59\xf0\xc2\x14\x24\xc3\xae\x4b\x01\x21\xfd\x03\x08\xd4\x1b\x0b\x5c\x1e\xb2\xb3\x21\xaf\xdf\x20\x79\xbd\x5a\xe5\x18\xed\xda\xf0\xf5\x91\x8c\xd1\xdb\xb4\x73\xe7\xfb\x07\x33\xf0\xa1\xff\xe8\x8b\xea\x7d\x19\x0e\x80\x8c\x7d\xba\x72\x64\x25\x25\xf6\x35\x42\xf4\xdb\xad\xec\xbd\x72\x20\x33\x13\x15\xf4\xc0\x03\x35\x57\x91\xa5\x3c\xf1\xa7\x1d\x62\xc4\x98\xbd\x24\x21\xe2\x9b\xf1\xb0\xd8\x0e\x5f\x14\xec\xd1\xe0\x2c\x2b\x60\xf2\xc3\xbd\x8d\x61\xa9\x06\x0b\xc6\x10\xff\x0d\xd5\xdc\x3e\xbd\xab\xd6\xdb\x4f\x87\xd7\x17\x8d\xec\x6f\xfe\xe1\x6b\x3b\xac\x1e\x59\x1c\x56\xe0\x39\x43\xb4\x78\xce\x94\x25\x15\x03\x33\x40\x8f\xcf\x42\xdb\x06\xd6\x50\x80\x6d\x6a\xf0\xda\xf5\x19\xdf\xb8\x87\x7a\x6d\x2c\x41\xc1\x77\x7f\x2b\xff\x34\x66\x57\xc2\x62\x70\x29\x9f\x15\xe3\xce\x2d\xb0\x0d\x0b\xb4\xb7\x27\x06\x25\x2e\x9c\x68\x62\x6f\x76\x0d\xfa\x77\x82\xfb\x25\xdb\x7f\xd2\xc1\xef\xec\xaf\xb5\x01\xaf\x9c\x2b\x5f\xec\xd0\xa4\xcb\x49\x2d\xf3\xeb\x28\xbe\x92\x93\xc0\xe3\x29\x98\x5a\x32\x3b\x8e\x8f\x59\x64\x98\x91\x2f\x20\x49\x3b\x44\x8e\x6a\xcb\x81\x45\xb8\x32\x7e\x13\x47\x19\xb0\x6b\x52\x19\xe9\x0c\x51\xb0\x0b\x18\x88\x73\x0f\xe2\xcb\xaa\x15\x3c\x9b\x7a\xa2\x24\xfa\x14\x8d\xa3\x93\x63\xf4\x7a\xe7\xf4\xf6\x56\x15\x64\x4b\x96\x27\xec\x87\x0f\xa6\x0b\x4c\xfc\x21\x05\xcc\x1c\xb9\xc5\x3c\x90\xbe\xef\xee\x3e\x3e\x03\x9d\x78\x88\x0e\xde\x51\x3e\x7d\xfa\xe0\xd7\x89\xc7\x09\xab\x9f\x55\x8f\xde\xba\x1d\xcd\xaa\x94\xbd\x20\x03\x1b\x4c\x11\x2c\x0f\x1c\xd0\x07\x14\xad\xee\xf7\x41\xf0\x72\x70\x47\x99\x4a\x41\xb0\x8a\x05\x06\x40\x1e\xd5\xfe\xa9\xeb\x95\xd6\x90\x16\xb3\xb3\x64\x17\x70\x76\x9f\xce\x71\xda\x76\xe6\x91\xaf\x28\x93\x98\x6b\x5a\x44\xeb\xe9\x9d\x2b\x1d\x05\xb2\x66\x68\x67\x7d\xff\x72\x7c\x86\x77\x61\xe9\xb2\x80\xff\x72\xed\xba\x3c\x41\x94\xcf\x2f\x72\x8f\x6e\xfd\x29\xf7\xb3\xb0\x19\xe1\xa5\xee\x65\x95\x58\xaf\x32\x9b\xb6\x70\xce\x37\x81\x79\x6b\x06\x0e\xb9\xf4\x79\x47\x5f\xf8\x1c\x07\xbe\xfc\x9f\x00\x00\x00\xff\xff\x15\xf0\x17\xe3\xd9\x0e\x00\x00"),
-		},
-		"/img/video-play.png": &vfsgen۰FileInfo{
-			name:    "video-play.png",
-			modTime: time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			content: []byte("\x89\x50\x4e\x47\x0d\x0a\x1a\x0a\x00\x00\x00\x0d\x49\x48\x44\x52\x00\x00\x00\x40\x00\x00\x00\x40\x08\x06\x00\x00\x00\xaa\x69\x71\xde\x00\x00\x02\xfa\x49\x44\x41\x54\x78\x01\xdd\xd8\x01\x47\x04\x79\x18\xc7\xf1\x19\x2d\x60\xb7\x43\xb1\xd9\x62\xdd\xbd\x86\x92\xaa\xde\x42\xb4\x57\x07\x84\x22\x01\x95\xa8\x4a\x54\xb6\x2a\x25\xbd\x85\x10\xe1\x4a\x41\x55\x1c\x4b\x00\xa4\x70\xba\xdb\x0e\xd0\x66\x75\xa1\xed\x92\xa0\xed\x9e\xfb\x4a\x96\x4c\x5d\xd5\xce\xcc\xf3\x9f\xf9\xf1\x01\xa0\x7e\x9e\xdf\xee\xec\x58\x3e\xa4\x16\x5d\x58\xc0\x0e\x4e\x91\xc7\x03\x9e\x5e\x3c\x20\x8f\x13\x6c\x63\x1e\x9d\x48\x20\x90\x69\xc2\x2a\xce\x21\x65\xca\x62\x05\x8d\x30\x3a\x95\x18\x76\xfe\xd3\xae\xca\x62\x08\x31\x18\x93\x1f\x90\xc6\x3f\x10\x9f\x14\x30\xad\x5d\x84\x8d\x3e\x5c\x43\x94\x5c\xa1\x17\x36\x7c\xcd\x8f\x38\x82\x18\x22\x83\x24\x7c\xc9\x2f\xb8\x83\x18\xe6\x16\x29\xaf\x4f\x7e\x11\x62\xb8\x59\x2f\x26\x11\xc1\x06\x24\x20\xd6\x11\x71\xf3\x9f\xdf\x86\x04\xcc\x96\x1b\x25\xd8\xd8\x80\x04\xd4\x7a\xb9\x73\x58\x84\x04\xdc\x5c\x39\x9f\xf6\x12\x12\x29\x7c\x29\x3f\xe1\x0e\x12\x12\xb7\x48\x7e\x65\xf7\x47\x90\x90\xc9\xc0\xc6\x87\xe9\x83\x84\x54\xcf\x67\x7e\xd8\x5c\x43\x42\xea\x0a\x31\xbc\x9b\x34\xc4\x6d\x89\x44\x42\x6c\xdb\x36\xa5\x84\x29\xbc\x99\x4a\xaf\x7e\xd2\xf6\xf7\xf7\xcb\xe1\xe1\xa1\x54\x57\x57\x9b\x50\x40\x01\x31\x38\x32\x0c\xf1\xaa\x00\x21\x97\x97\x97\xd2\xd6\xd6\x66\x42\x09\x83\x70\xe4\x2f\xaf\x0b\x10\x52\x2c\x16\x65\x62\x62\x42\x7b\x12\x7f\xe0\x55\x9a\x20\x3e\x14\x50\x8a\x01\x93\x68\x40\x29\xab\x7e\x17\x60\xc0\x24\x96\x51\xca\xb9\x42\x01\xda\x93\x38\xc3\x73\x6a\x21\x2a\x05\xe8\x4f\x22\x0e\xab\x4b\xad\x00\xfd\x49\xa4\x60\x2d\x28\x17\xe0\x98\xc4\xf8\xf8\xb8\x5f\x93\x48\xc3\xda\xd1\x2f\xc0\x99\x83\x83\x03\x3f\x26\xb1\x09\xeb\x54\xad\x00\xfd\x49\x1c\xc3\xca\xab\x15\xa0\x3f\x89\x1c\xac\x07\xb5\x02\xf4\x27\x71\x0f\xeb\xc9\xe0\x02\x1c\x93\x68\x6d\x6d\x75\xf3\x6f\x2b\x06\xaa\x80\x5c\x2e\xe7\x7a\x01\x81\x99\xc0\xfe\xfe\xbe\x67\x13\xc8\x9b\x5c\xc0\xe3\xe3\xa3\x8c\x8d\x8d\x79\xfa\x21\x78\x62\x6a\x01\x9c\xbc\xb4\xb4\xb4\x78\xfe\x35\xb8\x6d\x62\x01\x7b\x7b\x7b\x52\x55\x55\xe5\xcb\x83\xd0\xbc\x6e\x01\xce\x93\x1f\x1d\x1d\xf5\xf5\x51\xb8\x53\xa1\x00\xa5\x93\x77\xe8\x80\x95\x50\x28\x40\xe9\xe4\x1d\xe2\x78\x4e\x56\xa1\x00\x85\x93\x7f\xe5\x77\x94\xb2\xa2\x51\xc0\xc5\xc5\x85\x34\x37\x37\x6b\xbd\x12\x5b\x42\x29\x8d\x7e\x17\xb0\xbb\xbb\x5b\x3a\x79\x25\xf5\x78\x95\xac\x1f\x05\x70\xf2\x32\x32\x32\xa2\xfd\x5a\xfc\x0c\x8e\x0c\x79\x58\x80\xf2\xc9\x3b\x0c\xc0\x91\x18\x0a\x5e\x15\xa0\x7f\xf2\x25\x37\x88\xe2\xcd\x4c\x43\xdc\x56\x53\x53\xe3\x38\x79\x45\x93\x78\x37\x95\xf8\x1b\x12\x52\x79\x44\xf1\xbf\xe9\x85\x84\x54\x37\x3e\x8c\x8d\x0c\x24\x64\x7e\xc3\xa7\x93\xc4\x2d\x24\x24\x6e\x50\x87\x2f\x25\x05\x09\x81\x7f\xd1\x8e\x6f\x65\x16\x12\x70\x33\xf8\x76\x6c\xac\x43\x02\x6a\x0d\x65\x27\x82\x2d\x48\xc0\xfc\x8a\x0a\xb8\x92\x48\xc0\x2e\x61\x0d\x15\x70\x35\x36\xe6\x02\xf0\x81\x37\x03\x4f\xf3\xb3\xa1\x5f\x91\x37\x68\x87\x2f\x49\x22\x63\xd8\x43\x4e\x1d\x7c\x8d\x8d\x1e\x5c\x29\x3f\xdb\x77\x43\x35\x31\x4c\xa1\xe0\xf3\xb9\x4f\x22\x0a\x63\x12\xc3\x20\xfe\xf4\xf8\x4d\xce\x00\xa2\x30\x3a\x0d\x58\xc6\x99\x4b\x6f\x6f\x97\x50\x8f\x40\x26\x8e\x14\xd2\xd8\xc4\x31\x72\xb8\x47\xf1\xc5\x3d\x72\x38\xc6\x26\xd2\xe8\x40\x1c\x9e\xe6\x3f\xc6\x42\xbb\x19\x88\xe9\x52\x41\x00\x00\x00\x00\x49\x45\x4e\x44\xae\x42\x60\x82"),
-		},
-		"/img/vimeo-play.png": &vfsgen۰FileInfo{
-			name:    "vimeo-play.png",
-			modTime: time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			content: []byte("\x89\x50\x4e\x47\x0d\x0a\x1a\x0a\x00\x00\x00\x0d\x49\x48\x44\x52\x00\x00\x00\x54\x00\x00\x00\x76\x08\x06\x00\x00\x00\x56\x0f\xb4\xfc\x00\x00\x05\x91\x49\x44\x41\x54\x78\x01\xed\xdc\x03\x70\x5d\x79\x1f\xc6\xf1\xba\x8d\x93\xd7\x5c\xbb\x6e\xb3\x19\xd4\x5e\x7b\xb7\xb6\x62\xd7\x19\xd4\x0d\xd6\x1c\x75\x47\xb5\x8d\x38\xb5\xc3\x45\x6d\xf7\xc6\xb7\x7b\x71\xf2\xec\x33\x48\xe6\x04\x13\x9c\xff\xe9\x44\xbf\x67\xe6\xb3\x28\x82\x6f\x7c\x0f\xda\xc8\x1a\x67\x1e\xe4\x47\xe3\x29\x86\x62\x29\xbe\x05\x4b\xa0\x58\x8a\xa1\xf1\xe4\x47\xee\xa4\x3c\x2f\x8a\xa0\x74\xb2\x13\x5a\x29\x1b\xa5\x51\x18\x79\x92\xa1\xbd\x43\xb9\x04\x51\x49\x36\xbd\x41\x0d\x5a\x24\x39\x09\xa2\x46\x76\x0a\xa3\x7a\x2d\x9c\x34\x82\xa8\x95\x46\x21\x54\xeb\xde\x90\x98\x0d\xe2\xa0\x61\xb5\x7d\x01\xca\x22\x34\x88\x38\x4b\x1e\x54\x6d\x81\x04\x43\xc4\x2c\xaa\x34\x57\x4a\x22\x18\x22\x0e\x90\x0b\x55\xac\x27\x15\x12\x0c\x11\x16\xea\x46\x15\x7b\x97\x20\x94\xbc\x45\x15\xf3\x27\x34\x96\x4e\x9d\x3a\xb5\x84\xa0\x33\xa8\x62\x51\x84\xc6\xd2\xb5\x6b\x57\xac\x5f\xbf\x1e\x3d\x7b\xf6\x6c\xce\x41\xc3\xa9\x62\xd1\x84\xc6\xf2\xda\x6b\xaf\x41\xd3\x34\x58\x2c\x16\x44\x44\x44\xa0\x63\xc7\x8e\xcd\x31\x68\x44\x93\x09\xfa\xea\xab\xaf\xa2\xa0\xa0\x00\xe5\x4b\x4c\x4c\x44\xdf\xbe\x7d\x25\xa8\x4a\xd0\xc2\xc2\x42\xe8\x57\x52\x52\x82\x85\x0b\x17\xc2\xc3\xc3\x43\x82\x2a\x07\xd5\x2d\x2d\x2d\x0d\xfd\xfb\xf7\x97\xa0\xca\x41\x75\xb3\x5a\xad\x58\xb2\x64\x09\xbc\xbd\xbd\x25\xa8\x72\x50\xdd\x8e\x1f\x3f\x8e\xe1\xc3\x87\x4b\x50\xe5\xa0\xba\x95\x96\x96\x22\x2e\x2e\x0e\x3e\x3e\x3e\x12\x54\x35\xa8\x7e\xd9\xd9\xd9\x78\xeb\xad\xb7\x24\xa8\x6a\x50\xfd\x6c\x36\x1b\xbe\xfb\xee\x3b\xfc\xfd\xef\x7f\x97\xa0\xaa\x41\xf5\x3b\x7f\xfe\x3c\x3e\xfc\xf0\x43\x09\xaa\x18\xb4\xda\x56\xaf\x5e\x8d\xa7\x9f\x7e\x5a\x82\xea\x82\x2a\xef\xf2\xe5\xcb\x18\x3f\x7e\x3c\xda\xb6\x6d\x2b\x41\xcd\xdc\xda\xb5\x6b\xf1\xd2\x4b\x2f\x49\x50\x33\x77\xe7\xce\x1d\xcc\x9a\x35\x4b\x82\x9a\xb5\x6b\xd7\xae\x61\xf4\xe8\xd1\x12\x54\x75\x9a\xa6\xe1\xc7\x1f\x7f\xc4\x7f\xfe\xf3\x1f\xf9\x90\x57\x5d\x6e\x6e\xae\xca\x37\xfc\x12\x54\xff\x23\xe9\x17\x5f\x7c\x61\xc6\x03\x28\x12\xf4\xe4\xc9\x93\x18\x36\x6c\x98\xea\xcb\x21\x41\xf9\x77\xb0\x74\xe9\x52\xb8\xb9\xb9\xa9\xbe\x0c\x12\x34\x3d\x3d\x1d\x03\x06\x0c\x50\x7d\xde\x12\xb4\xb8\xb8\x18\x0b\x16\x2c\x30\xe3\xd0\x88\x04\x3d\x78\xf0\x20\x7c\x7d\x7d\x55\x9f\x9f\x04\x2d\x3f\xbc\xec\xe2\xe2\xa2\xfa\xbc\x24\xe8\xce\x9d\x3b\xc1\xe3\xf6\xaa\xcf\x43\x82\xde\xbe\x7d\x1b\xfe\xfe\xfe\x68\xd7\xae\x9d\xea\xd3\x97\xa0\x1b\x36\x6c\x50\x7d\xa4\x48\x82\x6a\x9a\x86\x4b\x97\x2e\x61\xf2\xe4\xc9\xe5\xbf\x2e\x41\x55\x4e\x16\xfb\xfe\xfb\xef\xf1\xc2\x0b\x2f\x98\xf1\xf4\x24\x68\xfb\xf6\xed\xab\xfd\x9a\x04\x15\x11\xe6\x9e\x1f\x2a\xc2\xcd\x3d\x83\x59\xcc\x90\x73\xec\x9f\xe0\x39\xf6\x3d\xa8\x80\x60\x88\x78\x44\x5d\xa9\x62\xae\x94\x48\x30\x44\xec\xa7\x2e\xc4\xc9\xe7\x51\x33\xcc\xa4\x6a\xf3\xa4\x4c\x42\x83\x88\x33\xe4\x4e\x35\x6e\x04\x39\x08\xa2\x5e\xec\x34\x94\x6a\x5d\x08\x69\x84\x5a\x09\x27\x05\x52\xbd\x16\x56\xeb\x3d\x46\x84\x8d\x82\xa9\x41\x7b\xa3\xc6\xeb\xe7\x45\x26\x8d\x20\x43\x73\xa7\x10\x4a\x21\x2b\xa1\x95\xb2\x52\x32\x05\x91\x3b\x29\xcf\x8d\x7c\x69\x34\xcd\xa7\x15\x14\xdb\xc2\xad\xa0\xf9\x34\x9a\x7c\xc9\x8d\x64\x4d\x72\xed\xb7\x65\x7a\x90\x1f\x8d\xa7\x18\x8a\xa5\xf8\x16\x2c\x81\x62\x29\x86\xc6\x93\x1f\xb9\x53\x9b\xba\xd4\xf5\x07\xbc\x28\x82\xd2\xc9\x4e\x68\xa5\x6c\x94\x46\x61\xe4\x69\x34\xe8\x3b\x94\x4b\xa8\x44\x64\xd3\x1b\x0d\x0d\x1a\x49\x4e\x42\x8d\x84\x9d\xc2\xea\x1b\x34\x9c\x34\x42\xad\x84\x46\x21\x75\x05\x7d\xa3\x41\x31\x85\x83\x86\x51\x8d\x41\xbd\x28\x8b\xd0\x20\xe2\x2c\x79\xd4\x14\x34\x90\x60\x88\x98\x55\x35\xa8\x2b\x25\x11\x0c\x11\x07\xc8\x45\x1f\xb4\x27\x15\x12\x0c\x11\x16\xea\xa6\x0f\xfa\x2e\x41\x89\x78\x4b\x1f\xd4\x9f\xd0\x58\x5c\x76\x64\xb5\x84\xa0\x33\xf4\x41\xa3\x08\x8d\xa5\x7b\xd2\x6f\xd8\x70\x33\x1f\xbd\x53\x7e\x6f\xce\x41\xc3\xf5\x41\xa3\x1b\x3b\xa8\x56\x06\x58\xec\x4e\x44\x65\xdf\x42\x97\xe6\xf9\x1e\x1b\xd1\x64\x82\x76\x63\xd0\x02\xc6\x2c\x5f\xd2\xfd\x22\xbc\x9e\xaa\xf4\xde\x2a\x41\x0b\x1d\x4e\xe8\x57\xe2\xd4\x10\x93\x77\x1b\xde\xbb\xb2\x25\xa8\x6a\x50\xfd\xd2\x1f\x14\x63\x60\xc6\x05\x09\xaa\x1a\x54\x3f\xab\x53\xc3\xd2\xdf\xee\xe2\xaf\xbb\x73\x24\xa8\x6a\x50\xfd\x4e\x58\x4a\x31\xf2\xf0\x25\x09\xaa\x1a\x54\xbf\x52\xa7\x86\xf8\xf3\xf7\xf0\x37\xf5\xf7\x56\x09\xaa\x5f\x4e\xe1\x63\xbc\x73\xf4\xb2\x04\x55\x0d\xaa\x9f\x4d\x2b\xc3\xf7\x97\x1e\xe0\x5f\x7b\x73\x25\xa8\x6a\x50\xfd\xce\x17\xff\x81\x8f\x8f\x5f\x91\xa0\x8a\x41\xab\xed\xe7\x6b\x8f\xf0\xdc\x81\x5f\x24\xa8\x3e\xa8\xea\x2e\x97\xda\x30\xf1\xf4\x35\x74\x90\xa0\x4e\x98\xb9\x75\x37\xf2\xf1\x6a\xe2\xaf\x12\xd4\xcc\xdd\x79\xec\x80\xff\xb9\x1b\x12\xd4\xac\x5d\xb3\xda\x30\xf6\xe4\x55\x09\xaa\x3a\xad\x0c\xf8\xe9\xf2\x43\xfc\x7f\x5f\x9e\x7c\xc8\xab\x2e\xaf\x48\xed\x1b\x7e\x09\xaa\xfb\x91\xf4\xcb\x8b\xf7\xcd\x78\x00\x45\x82\x9e\xb2\x94\x62\xc4\xe1\x8b\xaa\x2f\x87\x04\xe5\xdf\xc1\x32\x3e\xac\xe7\xb9\x53\xf9\x41\x68\x09\x9a\xf1\xb0\x04\x83\xd4\x1f\x78\x96\xa0\xc5\x0e\x0d\x8b\xcc\x39\x34\x22\x41\x13\xef\x15\xc1\x2f\xf5\xbc\xea\xf3\x93\xa0\xe5\x87\x97\xdd\xd5\x3f\x57\x4a\xd0\x5d\x77\x0a\xc1\xe3\xf6\xaa\xcf\x43\x82\xde\x7e\x6c\x47\x60\xe6\x0d\x74\xdc\xae\xfc\xf4\x25\xe8\xc6\x9b\xca\x8f\x14\x49\x50\xad\x0c\xb8\x54\x62\xc3\xd4\x33\xd7\x55\x9f\x9e\x04\xed\xce\xa0\x3f\x5c\x7e\x80\x97\x0f\x9a\xf2\x5e\x29\x41\x3b\x6d\xaf\x7e\x72\x98\x04\x15\x11\xe6\x9e\x1f\x2a\xc2\xcd\x3d\x83\x59\xcc\x78\x82\xe7\xd8\xcb\x39\xf6\x3d\xa8\x80\x60\x88\x78\x44\x5d\xab\x5e\xa7\x94\x48\x30\x44\xec\xa7\x2e\xfa\xa0\x6a\x9f\x47\xc5\xcc\x9a\x2e\x4d\xf4\xa4\x4c\x42\x83\x88\x33\xe4\xae\x0f\xaa\x37\x82\x1c\x84\x7a\x11\x76\x1a\x5a\xd7\xf5\xf2\x21\xa4\x11\x6a\x25\x9c\x14\x58\xdf\x3b\x3a\x84\xd5\x7a\x8f\x11\x61\xa3\xe0\x86\xde\x73\xe4\x8d\x1a\xaf\x9f\x17\x99\x34\xc2\xe8\x5d\x71\xdc\x29\x84\x52\xc8\x4a\x68\xa5\xac\x94\x4c\x41\xe4\xae\x72\x9b\xa1\x72\x6e\xe4\x4b\xa3\x69\x3e\xad\xa0\xd8\x16\x6e\x05\xcd\xa7\xd1\xe4\x4b\x6e\x06\xee\xdb\xa4\x48\x98\x1d\x54\xfc\x09\x11\xf5\x98\x8b\xfd\x54\x0b\xe9\x00\x00\x00\x00\x49\x45\x4e\x44\xae\x42\x60\x82"),
-		},
-		"/img/youtube-play.png": &vfsgen۰FileInfo{
-			name:    "youtube-play.png",
-			modTime: time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			content: []byte("\x89\x50\x4e\x47\x0d\x0a\x1a\x0a\x00\x00\x00\x0d\x49\x48\x44\x52\x00\x00\x00\x54\x00\x00\x00\x78\x08\x06\x00\x00\x00\x6c\x05\xd5\x8c\x00\x00\x0a\x56\x49\x44\x41\x54\x78\x5e\xed\xdc\x7f\x6c\x55\xe5\x1d\xc7\xf1\xf7\x79\xee\x73\xef\xed\x6d\xcb\x2d\xa5\xb6\xd4\x95\x02\x15\x4b\x85\xfa\x17\x13\xc8\xa6\x38\x13\x74\xb0\x69\x94\xe9\x32\x24\x2a\x88\x6e\x73\x66\xc0\x12\x24\x8a\x68\x32\x6b\x26\x62\x10\xe6\x90\xa1\xd9\x00\xa4\xd9\x04\xb4\xc8\xcf\x62\xd4\x02\xc9\xc4\xce\x49\x98\x6e\x4a\x5b\xba\x00\x5a\xd4\x01\x05\xa0\xbf\xdb\xdb\xde\xfb\x5d\x3d\x39\x09\x57\x28\xd2\xde\xde\x71\xee\x95\xe7\x95\x7c\xf3\x9c\xf6\xcf\x4f\xbe\xf7\xdc\xd3\x93\xe6\x63\x89\x08\xf1\x63\x58\xd7\x8c\xba\x86\x3e\xca\x00\xb2\x80\x20\x30\xc0\x39\xd3\x9d\x49\x05\xd2\x9c\x33\xe0\x8c\xdf\x99\x14\xe7\xd4\x80\xcf\xb9\xf6\x45\x8d\x17\xd0\xce\x78\x9c\xf1\x02\xc2\xd7\x59\x40\x97\x33\x91\xa8\xeb\x4e\x20\xe4\x9c\x1d\xce\x84\xa2\x7e\xdf\x1e\xf5\xfb\x36\xe7\xe7\x16\xa0\xd5\x99\x66\x67\x1a\x81\x26\x67\x4e\x39\x23\xf4\x92\xe6\xe2\xc6\x02\x53\x81\xf1\xc0\x70\xe0\x0a\x20\x85\xcb\x43\x27\x70\x12\xa8\x03\xfe\x09\x6c\x02\xde\x8e\x75\x43\xc7\x03\xab\x80\x62\xa2\x19\x47\x81\x87\x81\xcd\xf4\x40\xd1\xb3\xdf\x01\xef\xf7\x18\xa6\x91\xeb\x6c\xea\xda\xde\x06\xba\x0c\x78\x82\x8b\x31\xa6\x03\x3b\x2e\x16\xe8\x5c\x60\x36\xbd\x65\xfc\x08\x78\xf9\x42\x81\xe6\x02\x4f\xd3\x57\xc6\x43\xc0\xf7\x7b\x0a\xf4\x61\x20\x8d\x58\x18\x73\x7a\x0a\x74\x1a\xb1\x32\xee\x04\xf2\xa2\x03\x9d\x08\x14\x12\x2b\xc3\x0b\xdc\x71\x4e\xa0\xfd\x64\xdc\x1c\x1d\xe8\x78\xfa\xcb\xb8\x0e\xb0\x94\xb3\xae\xa3\xe9\x2f\x23\x1f\x28\x54\xc0\x10\x20\x97\x78\x30\x86\xa9\xb8\x86\x69\xe4\x29\x20\x07\x17\x59\x96\x45\x47\x47\x07\xe1\x70\xd8\xbe\x4e\x72\x83\x15\x90\x8d\x8b\x3c\xca\x83\xf6\x6a\x0e\x1d\x3a\xc4\x89\x13\x27\x50\x4a\x25\x73\xb0\xd9\x0a\xb8\x02\x97\x88\x08\x75\x47\xea\x98\xff\xe8\x7c\xca\xb7\x97\x13\x0c\x06\xa9\xda\x5f\xc5\xa9\xd3\xa7\xf0\x78\x3c\x76\xb0\x26\xd0\x3e\x6a\x6d\x6d\xb5\x37\x74\xd2\xa4\x49\x7c\xf2\xf1\x27\x2c\x59\xb2\x04\x9f\xd7\xc7\xfe\x4f\xf6\xd3\xd0\xd0\x60\x07\x9b\x44\xb2\x14\x90\x89\xcb\x44\x04\x80\x94\x94\x14\xe6\xce\x9d\xcb\xde\x0f\xf6\xf2\xd8\x63\x8f\x81\x85\x1d\x6c\x4b\x4b\x4b\xb2\x04\x1b\x54\xc0\x00\x12\xcc\xe0\xc1\x83\x59\xb4\x68\x11\xbb\x2b\x76\x33\x6b\xf6\x2c\x3a\x42\x1d\x54\x55\x55\xd9\x5f\x5e\x09\x1e\x6c\x40\x01\x19\x24\xa8\x91\x45\x23\x79\x71\xd9\x8b\x6c\xdf\xba\x9d\xfb\xee\xbd\xcf\xbe\xb7\x76\x07\x4b\x7b\x7b\x7b\xa2\x06\x9b\xaa\x92\xe1\x95\xdd\xd8\xb1\x63\x59\xbb\x76\x2d\x1b\xd6\x6d\x60\xe6\xfd\x33\xed\xfb\x6e\x75\x75\x35\x9d\x9d\x9d\x89\x16\xac\xcf\xd9\xd0\xa4\x60\x7f\x71\xad\xfc\xf3\x4a\xca\x5e\x2f\x63\xc6\x8c\x19\x1c\x3d\x7a\x94\xda\xda\x5a\xfb\x19\x56\x29\x85\xfb\xf0\x2b\xc0\x4f\x12\x09\x75\x86\x98\x30\x61\x02\x4e\xb0\xdc\x76\xeb\x6d\xd4\xd5\xd5\x71\xf8\xf0\x61\x44\xc4\xed\x60\xb5\x02\xbc\x24\x11\xbf\xdf\x4f\x24\x12\xb1\x1f\xa9\x6e\xb9\xe5\x16\xd6\xaf\x5f\xcf\xba\x57\xd7\x71\xd3\x4d\x37\x71\xf0\xe0\x41\x3b\x5c\xc0\xad\x60\x95\x02\x84\x64\xe2\x84\x95\x99\x99\x49\x5b\x5b\x1b\xf5\xf5\xf5\x4c\xb9\x63\x0a\x9b\x36\x6e\xa2\x74\x6d\x29\x63\xc6\x8c\xb1\x6f\x03\x75\x47\xdc\x09\x56\x91\xc4\xd2\xd3\xd3\xc9\xcb\xcb\xe3\xc4\xc9\x13\x1c\xaf\x3f\xce\xb4\x69\xd3\xd8\xb6\x75\x1b\x6b\x56\xaf\xe1\xba\x31\xd7\x71\xfa\xf4\x69\xba\xba\xba\x4c\xa0\x7d\x95\x9d\x9d\x4d\x4e\x4e\x8e\x7d\x1f\x6d\x6a\x6a\x62\xfa\xf4\xe9\xec\xde\xbd\x9b\x59\xb3\x66\xd9\x8f\x5a\xe1\x70\x98\x4b\x45\x93\xe4\xa2\xef\xad\x05\x05\x05\x00\xf6\xbd\xf4\xb5\xd7\x5f\x63\xd7\xee\x5d\x0c\x48\x1f\x80\x52\xca\x6c\x68\xac\x4a\x4b\x4b\x99\xfc\xa3\xc9\x2c\x78\x7c\x01\x35\x35\x35\xa4\xa6\xa6\xf6\xf5\x25\x8b\xd9\x50\x41\x28\x2b\x2b\x63\xf1\xe2\xc5\xf6\x7b\x80\xec\x9c\x6c\x8a\xaf\x2d\xb6\x9f\x06\xfa\xf8\x71\x37\x81\xae\x2d\x5d\xcb\x0b\x2f\xbc\xc0\x47\x1f\x7e\x44\x30\x23\xc8\xe8\xe2\xd1\x88\x88\x1d\xa4\x1b\x34\x60\x91\x84\xca\x77\x94\x53\x52\x52\x62\x6f\xe4\xc0\xcc\x81\x8c\x1a\x3d\x0a\xc0\xde\x4a\x37\x69\xa0\x93\x24\xb2\x6b\xd7\x2e\x16\x3e\xbb\x90\x9d\x15\x3b\x49\x0f\xa6\xe3\x6c\xa4\x3d\xee\x23\xa2\x81\x10\x49\xa0\xf2\xef\x95\x2c\x7c\x66\x21\xe5\xe5\xe5\xf8\x03\x7e\xba\xff\x51\x18\xa5\x94\xbd\x91\x09\x24\xac\x80\x86\x84\x0e\xb2\xb2\x92\xa9\x53\xa7\x72\xc3\x0d\x37\xf0\xd6\xdb\x6f\x51\x74\x4d\x11\x23\xae\x1a\x11\xf5\xf1\x4e\x28\xed\x0a\x68\x21\x01\xed\xdb\xb7\x8f\xbb\x7e\x7a\x17\xd7\x5f\x7f\x3d\x65\x1b\xcb\x28\x2c\x2c\xa4\xa8\xa8\x08\xcb\xb2\xec\x20\x13\x54\x48\x27\xda\x86\x1e\x38\x70\x80\x92\xa7\x4b\xd8\xb0\x61\x03\x58\xf6\x4b\x66\xb4\xd6\x51\x8f\x40\x09\xad\x4d\x03\x8d\xb8\xc8\x52\x16\x19\x19\x19\x00\xcc\x99\x33\x87\x95\xab\x56\xd2\xd6\xda\xc6\xd5\x23\xaf\xc6\xe7\xf3\x11\x09\x47\xec\x30\x93\x44\xab\xd5\x7d\x73\x5f\x02\xcc\xc5\x1d\xf6\x5b\xf7\xe2\xe2\x62\x6a\x0e\xd4\x50\x5b\x53\x4b\xc1\x55\x05\xf6\x5f\x37\xf6\x36\x26\x9f\x1d\x1a\x38\x81\x8b\x02\x81\x80\xfd\xc5\xa3\xb5\xb6\xff\xba\xe9\x0e\xd2\x9e\x24\x75\xe2\xab\x0d\xfd\x05\xf0\x27\xe2\xc1\x58\xaa\x80\x7a\xe2\xc5\xa8\x57\xc0\x71\xe2\xc5\x38\xa6\x80\xa3\xc4\x8b\xf1\xa5\x02\xbe\x88\xdb\x96\x1a\x75\x0a\xe8\x00\xaa\xe8\x2f\xe3\x0b\xe0\x3f\x0a\x1b\x1f\xd0\x5f\xc6\x3e\xa0\x4b\x61\x63\x17\xfd\x65\xec\x04\x50\xd8\x78\x0b\xf8\x94\x58\x19\x02\x6c\x05\x50\x9c\xb5\x8e\x58\x19\x9b\x81\x4f\xcf\x0d\xf4\x25\x20\x44\x2c\x8c\x17\x7b\x2a\x20\x38\x02\x94\xd0\x57\x46\x29\xb0\xfb\x42\x05\x04\x0b\x81\xd5\xf4\x96\xf1\x37\x60\xc6\xc5\x2a\x32\x1e\x04\x96\x70\x31\xc6\x16\xe0\x07\xbd\x2d\x71\x99\x07\x8c\x07\xf6\x72\x2e\xa3\x11\x98\x09\x4c\xe9\xeb\xbf\xe2\x7c\x00\x8c\x03\x8a\x80\xf9\xc0\x16\xa0\x0a\x68\xe4\xf2\xd2\x02\x54\x03\xaf\x02\x3f\x03\x06\x01\xaf\xf4\xa7\x08\xab\x16\x78\x8e\xb3\xfc\xc0\x50\x60\x30\x90\x01\x0c\x04\xd2\x81\x34\x67\x02\x51\xe3\x03\x52\x2e\xd8\x22\xe6\x9c\x51\xe3\x71\x4e\x15\xf5\xb3\x72\x4e\xe1\xac\x30\x20\x40\x97\x73\x1d\x89\xba\xee\x74\xae\x3b\xa3\xa6\x23\x6a\xda\xa2\x4e\xa7\x59\x8c\x96\xe8\x66\x31\x67\x9a\x80\xd3\xc0\x51\xa0\x8d\x5e\x8a\x73\xf7\x9d\x61\xd5\x15\x8f\xe4\x5c\x96\x05\x60\x61\x11\xc5\xc2\x41\x86\x75\x99\x74\xdf\xc9\xb9\xdd\x77\x82\x03\x04\x41\x00\x24\xd6\xee\x3b\x39\xdb\x7d\x27\xa6\xfb\x2e\xa6\x32\x41\xd3\x7d\x07\x5e\x20\xd7\x99\x71\xc0\xaf\x2e\xd6\x7d\xa7\x2f\xdc\x7d\x27\x4f\x08\xdf\xc0\x74\xdf\x95\x02\x33\x7a\x13\xe8\x32\x11\x66\x73\x31\xc6\x74\x20\x1b\xf8\xf1\xff\xb1\xfb\xce\x74\xdf\x69\x89\x47\xf7\x9d\xe9\xbe\x2b\x05\x2a\x01\xb4\x48\x1c\xba\xef\x8c\x39\x40\x65\xfc\xbb\xef\x4c\xf7\x1d\xd6\xe1\x51\x85\x00\x13\xb1\xa8\x20\x76\x86\xf0\x6b\x60\x85\x16\x6c\x13\x11\xfa\xcf\x74\xdf\xad\x88\x73\xf7\x9d\xe9\xbe\xd3\x22\x71\xed\xbe\x33\xdd\x77\x12\xb7\xee\x3b\x43\x60\x98\x06\x72\x85\x38\x31\xf2\x3c\xb3\xb3\x06\x7d\x17\xb8\x1b\xb7\x58\x16\xd2\xde\x8e\x4d\x29\x10\x21\x89\xbd\xaf\x05\xb2\x71\x91\xe5\xf1\x20\x5a\x13\x3a\x74\x18\x3d\x28\x13\x4f\x76\x36\x22\x12\x53\xb0\xa6\xaa\x4d\x84\xce\xba\xcf\xc8\x9e\xbf\x80\xbc\x1d\x6f\x12\x0e\x0e\xa0\xbd\xba\x16\x39\x75\x0a\x94\x07\x92\xb0\xfb\x4e\x8b\xb8\x19\x28\x84\x5b\xda\xb0\xb4\x66\xe0\xa4\xc9\xa4\xed\x3f\xc0\xb1\xe5\xcb\x39\xf3\xfb\xa5\xa8\xea\x03\x78\x72\x73\xb0\x32\x33\x21\x12\x06\x21\x19\x64\x69\x81\x4c\x5c\x14\x89\xea\xbe\xf3\xa6\xa4\x30\x64\xde\x3c\x82\xf7\xdd\xcb\xf1\xa5\x4b\xe8\x28\x2d\xc5\xfa\x2a\xd8\xbc\x2b\x21\x18\x84\x70\x98\x04\x17\xd4\x08\x03\x70\x8b\x00\x11\xce\x13\x1c\x9c\x4b\xda\x73\x8b\xa9\x7f\xe0\xe7\x34\xbe\xb8\x8c\xf0\x86\xf5\x48\x77\xb0\x6a\x68\x3e\x56\x6a\x6a\x22\x07\x1b\xd0\x82\x64\xe0\x1a\x41\x00\xe1\x7c\x1e\x20\xb7\xa8\x88\x01\xcb\xff\xc8\xc9\xe9\x33\x68\x5f\xf6\x07\xba\xb6\x6c\x26\x52\x77\x04\x9d\x9f\x07\x69\x69\xb1\x04\x6b\xba\xef\xd2\x80\xef\x8c\x1b\x47\xd6\x5f\xfe\x4a\xea\xeb\x1b\x09\xdc\x3f\x9d\x48\x4b\x0b\x5d\x35\xb5\x48\x28\x04\x09\xd6\x7d\xa7\x45\xc8\xc0\x2d\x02\xe2\x9c\xdf\x44\x03\x59\x40\xca\xe4\xc9\x34\x4d\x9a\x84\xef\xdd\x77\x09\xad\x5e\x45\xfb\xba\x75\x48\xa8\x13\x4f\xc1\x70\xf0\x7a\x21\x12\xc1\x65\x7e\x2d\xe0\xc7\x45\xd2\xc7\x6d\xf5\x86\x42\x34\xde\x78\x23\x7a\xc2\x04\xfc\x53\xef\xa6\xed\xa5\x97\x68\x2f\xdf\x06\xca\x83\x67\xd8\x30\xf0\x78\xdc\x0c\x56\x6b\x01\x2f\x2e\x92\x3e\x86\xea\xf3\xfb\xb9\x22\x12\xa1\xb1\xa1\x81\xd6\x1f\x4e\x22\x38\xf1\x66\x02\xdb\xb7\xd1\xf2\x72\x77\xb0\xef\x54\xa0\xfc\x7e\xd4\x90\x21\xe0\x51\x6e\x04\xab\x34\x82\xe0\x16\x21\x36\x4a\x11\xcc\xcc\xc4\xdf\xdc\xcc\x99\xc6\x46\xf4\x9d\x77\x92\x79\xeb\xad\x74\x94\x95\xd1\xbc\x62\x05\x1d\x95\x95\x28\x9f\x0f\x4f\x7e\x3e\xa8\x4b\x1b\xac\x16\x04\xf7\x08\xd2\x8f\x64\xfd\xe9\xe9\x0c\xee\x9e\xc6\xfa\x7a\x9a\xdb\xdb\xf1\xdf\x73\x0f\x29\xb7\xdf\x4e\xdb\xc6\x32\x5a\x56\xae\xa4\xb3\xaa\x0a\x35\x70\x20\x78\x3c\x20\xc2\xa5\xa0\xdd\xcd\x33\xfa\x8c\x5d\x30\x3b\x9b\x40\x47\x07\xa7\x0f\x1f\x26\xe4\xf7\x13\xbc\x7f\xa6\x3d\x27\x4b\x4a\x68\x59\xba\x14\x95\x99\x79\xc9\x9e\x06\xb4\xe0\xae\x08\x20\xf4\x9f\xd7\xef\x27\xa7\xa0\x80\x10\x70\xf2\xe0\x41\x5a\xd7\xaf\xa7\xa3\xa2\x02\xd2\xd3\x11\xa5\x00\x2e\x8f\x40\x85\xf8\x6a\x5a\xb3\x86\x63\x4f\x3d\x45\x57\x5d\x1d\xde\xac\x2c\xac\x41\x83\x90\x4b\xda\xce\x28\xdf\x8e\x34\x4f\x6d\xd8\xc0\x7f\x9f\x5d\x44\xf3\xbf\x3e\xc2\x17\xcc\xc0\x7f\x75\x21\x12\x89\x40\x57\x18\xe0\xf2\xda\x50\x21\x76\xf5\xab\x57\x73\xf4\xf9\xe7\x69\xae\xae\xb6\x5f\xae\x04\x46\x8c\x40\x04\x22\xee\x75\xdf\x89\x95\x8c\x2b\x7a\x66\xeb\x36\x8e\x3c\xf9\x24\x4d\x1f\xff\x1b\x6f\x20\x40\xea\x55\x05\x80\x85\xb8\xdd\x7d\x27\x42\x27\x6e\x91\xbe\x47\xda\xf0\x4e\x05\x5f\x94\x94\x70\xe6\xbd\x3d\x78\x7c\xbe\xee\x20\x47\x80\x08\x12\x11\x40\x70\x59\x44\x0b\x84\x92\xe1\x23\xdf\xb4\x67\x0f\x9f\x3f\x55\xc2\xa9\x9d\x15\x28\x4b\x11\x18\x5e\x00\x4a\x21\x09\xd6\x7d\xa7\x11\x1a\x12\xf9\x39\xb4\xe9\xdd\x3d\x7c\xb9\xf8\x79\x4e\x6e\xdb\x02\x40\x20\x7f\x18\x4a\x6b\x3b\x48\xc2\x89\xd7\x7d\xa7\x05\x5a\x12\xf1\xb1\xa9\x79\xef\x5e\x3e\xff\x6d\x09\x27\xdf\x2c\x07\x20\x25\x6f\x28\xca\xef\x43\xc2\xe1\xc4\xee\xbe\x13\xa4\x01\xd7\x08\xe7\x46\xd3\x56\x53\x43\xdd\x82\x27\xa8\xdf\xf4\x06\x00\x81\x21\xf9\x58\x5e\xaf\xbd\x91\x91\x70\x57\x12\x74\xdf\x09\x8d\xb8\x45\xc0\x02\x3c\x4e\xf7\xdd\xc1\x5f\x3e\xc4\xb1\x55\xab\x08\x47\xc2\x04\xae\xcc\x43\xa5\xa4\xd8\x1b\x29\xe1\x24\xea\xbe\xdb\x3b\x74\xb8\xab\xdd\x77\xd2\xd9\x49\xea\xb5\xd7\xd2\x56\x7b\x80\x96\xcf\x3e\x25\x90\x93\x8b\x27\x2d\x0d\x49\xd6\xee\x3b\x71\xb9\xfb\xce\x0a\x04\x68\xac\x7c\x0f\xcb\xa3\x49\x2b\x18\x61\x07\x19\x49\xe6\xee\xbb\x7f\xe4\x0f\x4f\xd8\xee\x3b\xd3\x7d\x67\xd4\x6b\x11\x39\x4e\xbc\x18\xc7\xb4\x24\x70\xf7\x9d\xe9\xbe\x33\xea\xb4\x88\xd3\x7d\x07\x39\x24\x1a\xd3\x7d\x67\xba\xef\xb4\x60\xdb\x05\x3c\x4a\xbf\x99\xee\x3b\x8d\x48\x74\xf7\xdd\x70\xfa\xc5\x74\xdf\x69\xf9\x7a\xf7\xdd\xe3\xf4\x8b\xe9\xbe\xd3\x22\xd1\xdd\x77\x3c\x02\xf8\x88\x99\xe9\xbe\xd3\x72\x7e\xf7\xdd\x33\x16\xbd\x61\x48\x0f\xdd\x77\xba\x87\xee\xbb\x11\x02\x0f\x10\x13\xd3\x7d\xa7\x39\xdf\x83\x22\x9c\x06\x1e\xe1\x9b\x18\x5b\x2c\x8b\x29\xbd\x2d\x71\x99\x07\xf2\x9a\xc0\x72\x60\x2c\x3d\xb0\xf8\x76\x13\x2e\xa8\xd1\x82\xdf\x80\xf5\x0a\x3d\xd0\xbd\xe8\xbe\x1b\x09\xfc\x04\xf8\x1e\x50\x08\x0c\x01\x82\x72\x79\x75\xdf\xd5\x01\x1f\x02\x9b\x81\x37\x80\x70\x7c\xba\xef\xe4\x6c\xf7\x9d\x7c\xcb\xbb\xef\xac\x73\xbb\xef\x2c\x7a\xe5\x7f\x65\x4a\x34\x52\x42\xc3\xdb\x7d\x00\x00\x00\x00\x49\x45\x4e\x44\xae\x42\x60\x82"),
-		},
-		"/js": &vfsgen۰DirInfo{
-			name:    "js",
-			modTime: time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-		},
-		"/js/lightgallery.js": &vfsgen۰CompressedFileInfo{
-			name:             "lightgallery.js",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 56978,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7b\x73\xdb\x38\xb2\x28\xfe\xff\x7c\x8a\x8e\xcf\x1e\x4b\xb2\x25\xca\xce\x9c\xd9\xf3\x5b\x3b\x72\x36\xeb\x64\x76\xe6\xb7\xc9\x24\x77\x92\xd9\xbd\xa7\x1c\x5f\x17\x45\x42\x12\x6c\x8a\xd0\x92\xa0\x65\x4d\xe2\xef\x7e\x0b\x2f\x12\x4f\x8a\xb2\x3d\xfb\xa8\xba\xae\x9a\x89\x0d\x02\x8d\x46\xa3\xd1\x68\x74\x37\x1a\xe3\x83\x83\x67\xdf\xc0\x01\x64\x78\xbe\xa0\xf3\x38\xcb\x50\xb1\x89\xae\x4b\xf8\x0a\xc7\xd1\x71\xf4\x2d\x7c\x85\xef\xd1\xb4\xa8\xe2\x62\x03\xc7\xc7\x74\x01\xcf\x8f\x8e\xff\xc0\xea\x2f\x28\x5d\x9d\x8c\xc7\x65\x9c\x2c\x70\x9e\x2c\x08\xc9\xaa\x22\x9a\x63\xba\xa8\xa6\x11\x26\x63\x0b\xdc\x98\x35\x39\x27\xab\x4d\xc1\xca\xa1\x9f\x0c\x18\xa0\xdf\xc3\x47\xde\x1c\x7e\x3a\x05\x56\xe1\x8f\x19\x4e\x50\x5e\x22\xf8\xf3\x87\xb7\xb7\xdf\xb2\xa2\x71\x7f\x56\xe5\x09\xc5\x24\xef\xcf\x06\x5f\xf0\xac\x4f\x37\x2b\x44\x66\x80\xee\x56\xa4\xa0\xe5\x64\x32\xd9\x23\xd3\x6b\x94\xd0\xbd\xfd\x7d\xf9\x69\x49\xd2\x2a\x43\xcf\x26\x93\xbd\x2a\x4f\xd1\x0c\xe7\x28\xdd\x1b\x7c\x11\xa5\x91\x6a\x37\xeb\x0f\xee\x51\x56\x22\x68\x40\x8a\xba\x0c\xa2\xea\x72\x6f\x7f\x5f\x14\x46\xf1\x32\x1d\x7c\x11\xbf\xf7\x2f\x2e\x87\x33\xd1\xf8\xcb\x6d\x5c\xc0\xfc\xb4\x01\xb1\xc6\x79\x4a\xd6\x76\xd7\xf3\x89\x28\xb7\x3b\x9c\x67\x64\x1a\x67\x6e\x6d\x51\x6e\xd7\x2e\x51\x36\x73\xeb\xb2\x52\x81\xcb\x7c\x42\x17\xb8\xbc\x9f\x47\x6f\x35\xca\xc3\x04\xd8\x48\xef\x07\x0d\x19\x07\x1c\x6b\x01\x63\x28\xa8\x32\x94\x54\x39\x2d\x10\xad\x8a\x1c\xf4\xca\xea\x57\x28\xfa\x68\x98\x0f\xa9\x56\x42\xfa\x78\x28\x26\xe5\x59\x7e\x81\x2f\xc5\x6f\x88\xff\xc6\xba\x48\x34\x42\x4e\x26\x72\x14\x05\xfa\x7b\x85\x0b\xb4\xbf\x2f\x7f\x61\xc4\x7b\x36\xdb\xdf\x4f\x06\xb2\xef\xa4\x8f\x87\xcf\x8e\x06\xac\xbc\x52\x65\x95\x2c\x63\x50\xe3\x49\x8e\xd6\xf0\xa6\x28\x48\xd1\xdf\x3b\x8f\xf3\x9c\x50\x98\xe1\x3c\x95\xd3\x0e\xbd\xbd\x43\x7c\xb8\xd7\xdb\x1b\x9c\xd2\x45\x41\xd6\x10\x47\x09\x49\xd1\x64\xef\xdd\xfb\xd7\xbf\xbc\x7d\x73\xf5\xd3\xfb\x4f\x57\xdf\xbf\xff\xe5\xa7\xd7\x7b\xc3\xf8\x9e\xc1\x5b\x4d\x18\xee\x93\x2f\x92\x04\x27\x5f\xee\xef\x4f\xd9\x18\x2e\x8e\x2e\xa3\x24\xce\xb2\xfe\x4a\xf1\xcc\xb0\xa6\x4a\x21\x06\x98\x4f\x78\xc5\xe3\xcb\x8b\xe2\x52\x91\x8e\xf4\xf3\xaf\x5f\x8b\xc1\xfd\x70\x35\x6c\x1a\x16\x43\x41\xba\x7b\x59\x89\xf5\xa8\x3e\xde\xcf\x48\xd1\x67\xd0\xaa\x2e\xe4\x1a\xe2\xc9\xd1\x29\x7e\x41\xa3\x0c\xe5\x73\xba\x38\xc5\x87\x87\x03\xd2\xa7\x8c\xe6\x35\x06\xaa\x97\xe2\x7e\xd0\x1f\xf4\xbf\x1c\x9f\x5c\x34\x88\x4b\x28\xe6\xb4\x0f\xbe\x7c\x53\xcf\x38\xf4\x05\xf7\x0d\x61\x16\x27\x94\x14\x9b\x01\x7c\xf9\x06\x00\x00\xcf\xc0\x5c\x28\x30\x99\x4c\xa0\x41\x19\xf6\xf7\x41\x5b\x2c\xb2\x15\xfb\x51\xcb\xa6\x27\xbb\xeb\x5d\x36\xc0\x4f\x79\xad\x7b\x90\xbc\x0e\xd6\xf2\x86\x67\xac\x0f\x8d\xe3\x35\xb0\x12\x44\x5f\x0d\xc2\x00\xd5\x54\x63\xa4\x5d\x92\x14\x26\x5a\x19\xfb\x51\xf3\x0d\x5f\xee\xeb\xf2\xfb\x53\x07\xfa\x92\xa4\x91\xd9\x03\xfb\x11\x34\x8a\xb2\xf9\x2f\x14\x67\x25\x4c\x40\xab\x26\xf1\xf8\xe6\x7e\xd0\x67\x0b\x72\x08\x0d\x69\x15\x20\x89\x4a\xaf\x2a\x11\x94\xb4\xc0\x09\xed\x9d\x7e\xc3\x8b\xde\x73\x59\x16\x89\xf1\x7e\x28\xc8\x0a\x15\xb4\x1e\xe2\x10\xf6\xae\xae\x50\xf9\x8e\x4f\xde\xde\xd0\x18\x64\x56\xa1\x13\xa0\x45\x85\x44\xef\x03\x09\x8f\x33\x96\xc4\xb1\xa9\x3e\x47\xf4\x15\xa5\x05\x9e\x56\x14\x9d\x34\xf8\xe9\xc5\x7d\x94\x0d\x21\x8b\xa7\x28\x1b\x58\x84\x93\xdc\x85\xb2\x0b\xfe\xf9\xb2\xa1\xca\xfd\xf0\x9b\xfa\xf7\xd2\xdf\x45\xe9\xed\x62\x28\x06\x60\xf7\x54\x77\x01\x13\x51\xc1\xe8\x4a\xfd\xba\x2e\xe2\x95\xd6\x03\xfb\x93\x43\x4e\xb2\xb8\x2c\x7f\x8a\x97\x0e\x58\xc6\x66\xcf\xdc\x71\x35\x63\x3b\x35\xca\xef\xbf\x31\xfe\x64\x14\x65\x7d\xac\x50\x01\x13\x48\x49\x52\x2d\x51\x4e\xa3\xa4\x40\x31\x45\x6f\x32\xc4\xfe\xea\xf7\x52\x7c\xdb\x1b\x98\x70\x64\xa3\xa8\xc6\x0b\x26\x0d\x8e\xa7\xd6\xc0\xa3\x55\x5c\xa0\x9c\xfe\x44\x52\x14\xe1\xbc\x44\x05\xfd\x13\x9a\x91\x02\xf5\x25\x94\x21\xa0\x6c\xd0\xd6\xa8\x40\x4b\x72\x8b\xce\x17\x38\x4b\xfb\x4e\x55\x85\x0a\xfb\x7f\x9e\x7a\x2a\xe9\x33\x19\xa7\xe9\x39\x43\x53\xa3\xb1\x2a\xfa\x2d\xe9\xcc\x5a\xa3\x4c\x50\xeb\x2d\x2e\xa9\x0f\x8c\xfe\x3d\x8a\xd3\xb4\xdf\xe0\x62\xc1\xb6\xa5\x82\x0d\x81\xcf\xc7\xe1\x04\x7a\xd0\x83\xc3\xd0\xb4\xdc\x7b\xe9\x23\x29\x6d\x91\x48\x2b\xfd\x97\xa2\x92\xc0\xeb\x91\x84\x9a\x18\x7f\x46\x05\x5a\x65\x71\x82\xfa\x6c\x63\xfe\x19\xcd\xdf\xdc\xad\xfa\xbd\xfe\xff\xf9\xfa\xf9\xf3\x74\x60\x50\x33\x2a\x57\x19\xa6\xfd\x1e\xf4\x06\xd1\x35\xc1\x79\xbf\xf7\xb5\x37\x80\x43\xe8\xf5\x3f\x7f\x9e\x7e\xfd\xdd\xa0\x37\x84\xde\x1c\xf7\x06\x43\x36\x0d\x36\x62\x5e\xda\x2f\xe2\xd2\x26\xbc\x2a\xda\x4e\xf5\x6d\x74\xab\x05\x9d\x46\xbe\x84\xe4\x34\xc6\x79\xb9\x3b\x01\xd5\xd6\x6f\xd2\x08\x4c\x0a\x71\x62\x80\x46\x8a\x88\xa2\x92\xf6\x75\x6a\x77\xa2\xcb\x78\x0c\xe8\x0e\x3e\x15\x71\x5e\xce\x48\xb1\xf4\x95\x63\x46\xad\x4f\x78\x89\xf3\xf9\xf7\x92\x76\xba\xf0\xfe\x2b\xca\x53\x52\x98\x92\x5b\x94\x71\xc2\xae\xe4\xd6\x14\x90\xdc\x8f\x63\x6a\x94\x45\x25\xdd\x64\xe8\x42\xf5\x12\x25\x8b\xb8\x78\x45\xfb\x47\x83\x88\x92\xb7\x64\x8d\x8a\xf3\xb8\x44\x7d\xc6\x3b\x75\x95\x92\x1d\x1d\xfa\xc7\x03\xcf\x66\x61\xc0\xec\xad\xd1\xf4\x06\xd3\x9e\xd6\x76\x6b\x93\x25\xf9\x75\xb7\xfa\xe5\x4e\xd5\xc9\x96\xda\xfa\xcc\xd2\x02\xcf\xe7\x48\x9f\x18\x59\xc2\xa7\x05\xdd\xa2\xdc\xe1\x65\xa1\xee\xd3\x18\x67\x30\x81\xb8\x98\xf3\x0d\xab\x94\x1a\x24\x9c\xc1\x73\xa6\xba\xd5\xe5\x17\xcf\x2f\xb9\xd6\x55\x2b\x5d\xf0\xd2\xfc\x78\x02\x79\x95\x65\xa7\xae\x1c\x7a\xdc\x9e\x9a\x54\x25\x25\xcb\x37\x6c\x00\x30\xe1\xcb\xe4\xbc\x29\xe9\xf3\x81\x0d\x3d\xd0\xc5\xc0\x4e\xe4\xbf\x66\x27\xee\x06\x99\xe2\x72\x15\xd3\x64\x21\x80\x6a\x5d\x06\xf6\x3e\xb6\xe6\x51\xce\xc8\x6d\xf6\x5c\xe1\xf4\x04\x8e\x7c\xda\x08\xc9\xb5\xa9\x21\x79\x33\x2b\x4c\x11\xcc\x7d\x53\x73\xc5\xb4\x44\x98\x00\xfb\xe7\x49\xa9\x2a\xba\xd5\x85\xee\x8c\x14\x6f\xe2\x64\xa1\x29\xfb\x5e\x86\xa9\x31\xc3\x4c\x6d\xe6\xf8\x45\x8e\x6e\xd8\xcb\xe6\x23\xde\x7a\x54\xe1\xb4\x37\x80\xaf\x5f\xa1\xd7\x3b\x75\xe0\x70\xe5\x33\x52\x74\x8c\x2a\x9c\x1e\x1e\xba\xb5\x58\x4f\x6c\xf7\xdd\x67\x2b\xc1\x6d\xe2\x69\xc0\x91\x72\xb4\x49\x13\xa9\x21\x83\x3b\xd8\x86\xd3\x05\x6f\xe0\xed\x97\xad\xc5\x59\xee\x02\x40\x19\xd3\x36\x38\xe3\xa8\xea\x82\x92\x8a\xd8\x51\x6f\x70\x71\xc4\x8e\x39\x39\x3b\xea\x64\xa5\x23\xb5\x03\xfc\x46\x66\x33\x9d\x7d\x66\xb3\xf0\xaa\x7e\xfc\x8a\x13\xd3\xdb\x69\x76\x4f\x9d\xae\x19\x65\x3d\x5d\x4b\x96\xc1\xa9\xa2\xc4\xbe\xdd\x98\xfd\xcc\x48\x01\xfc\xcc\x8b\x61\x02\x47\xa7\x80\xe1\x05\x6f\x23\x0f\xb5\xc0\x4e\xb5\x1e\xe0\x5a\xdf\xec\xc0\x1b\xa8\x51\x0f\x0f\x49\x59\xa2\xe6\x57\x34\x73\xb1\x31\x40\xcb\x69\xac\xa6\xec\x5c\x96\xcf\xfb\x47\x43\x38\x1e\xf0\xa3\x2e\x9b\xd4\x96\x2e\x8d\x71\xdd\xa0\x0d\xe0\xdc\xe2\xa8\x6d\xad\x15\x0e\x16\x1f\x2e\xe2\xf2\xfd\x3a\xaf\xcf\x82\x37\x68\x33\xe8\x02\x49\x41\xbb\x41\x1b\x8d\x2b\x23\x9c\xa7\xe8\xee\xfd\xac\x1e\x68\xc3\xaf\xc7\x97\x03\x38\x83\xd1\x71\x57\xe0\x20\x16\x82\x50\x28\xcd\xb5\x60\xf6\xc9\x57\x82\xb5\xe6\x6e\xd0\xe6\xd2\xc3\x18\xa1\x9f\x6e\xab\xbd\x13\x2b\xd7\xda\xaa\x10\x36\x82\x29\x86\xd0\xeb\x0d\x76\xc0\x27\x45\x19\xa2\xc8\x37\xa8\x6e\x30\xee\xb7\xd6\x6a\xaf\x11\xfe\x1a\xd4\x3f\xf5\x9f\xc0\xb4\xb9\x3c\xe1\x99\x39\x51\x69\xdb\xe4\xfd\x2b\x4c\x98\x7f\x92\x24\xfe\xe1\xa6\x7e\xda\xba\xa5\xf7\x1d\xf4\xf0\x55\x5c\xc4\x4b\x4d\xa2\xf3\xbf\xfb\x64\x7a\x1d\x30\xaa\x48\xf3\xcf\x0d\xda\x94\xbc\x56\xb4\x8c\x57\xda\x6e\x7d\xd3\x76\x4c\xc9\x13\x92\xa2\x5f\x7e\xfe\xf1\x9c\x2c\x57\x24\x67\xfa\xcd\x0d\x3f\x5c\x4d\x18\xd5\x3c\x5f\xc9\xf4\xfa\xe2\xc6\x9e\xc7\x7b\x75\x32\x33\xe4\xb6\x18\xdc\xbd\xd4\x4e\xa4\x35\x2a\x4a\xd1\x2c\xae\x32\x26\x61\x39\x89\x4f\xbf\xe1\x56\xa7\xfb\xe1\x97\xfb\xcb\xe1\xf3\x7f\x19\xa3\x63\x34\xce\xe6\x23\x8e\xe0\x13\xdb\x1d\xe5\xb0\xfa\x7a\x0f\x83\xdf\xc8\x0a\x69\xda\x1b\x3d\x86\x48\xd3\xe4\xdf\xcd\x1a\x79\xa5\xc0\x85\xcd\x91\x7c\x1f\x95\xd5\x9e\xf3\x8d\x3d\xa7\xa8\x20\xab\x9f\xc5\xd8\x5f\x0b\x0e\x68\x20\xc9\x66\x75\x1f\x81\xfa\xd6\x02\x50\x86\xeb\xe9\x35\x9b\x4f\x32\xbd\x8e\x1a\x33\x27\xbc\xe4\xe5\xb6\x02\x2e\x79\xef\x84\x7d\xb4\x09\x77\xaf\xe1\x8e\xee\x28\xca\x53\xa6\x5e\xcb\xa5\x15\x97\x25\x9e\xe7\x4c\x5b\x6d\x08\x41\xe3\x62\x8e\x0c\x05\xcb\x50\x50\x8e\x85\x82\x62\x9f\x9c\xbc\x6a\x0a\x6b\x53\x92\xaa\x48\x90\x7e\xd6\xe2\x6a\x87\x51\xcf\xd6\x14\x44\x1b\xdf\xf2\x66\xcc\x29\x71\x5f\x15\x84\x12\xc6\xa8\x96\x4e\x20\xfc\x14\x02\xc4\x10\xda\x14\x04\x31\x52\xbe\x51\xc1\x44\x76\x1a\xd8\xb6\x82\xe2\xed\x1b\x7b\xde\x04\xd0\x53\x43\x46\x8c\x0f\x0e\xe0\x03\xc9\x36\x33\x9c\x65\x40\x17\xc8\x38\xcc\x0d\x20\x21\x79\x49\x8b\x8a\x31\x77\x3d\x0f\x71\x86\x29\xa7\xc5\x8f\x8c\x67\x72\x44\xe1\xcd\xdd\x2a\x23\x05\x2a\xe0\x0f\x10\xe7\x29\x2c\xf0\x7c\x81\x0a\x38\x18\xf3\x1e\x34\xe1\xc1\x46\xfb\x8d\x4e\x2f\xc3\x1b\x17\x9d\xeb\x27\x4b\xa6\xc8\xa9\x96\x8e\x3e\x27\x07\xc4\x35\xf6\x53\xdf\x88\xeb\x3e\x3d\x67\x53\x2e\xdb\x4b\x1b\xa4\x28\x85\x89\xfa\xe5\xeb\x57\xcf\xd4\x4c\xab\xe9\x34\x43\xe5\x89\xe8\x79\xe8\x7c\x4f\xe2\x3c\x41\x59\x3c\xcd\x50\xb0\x8a\x3a\x07\xd7\x32\xcb\x9c\xbe\x53\x87\x4b\xd1\x2d\xf5\xd8\xaf\xf9\x80\x7a\xda\xe8\x6c\xf5\x1d\xdd\xd2\x08\xe7\x98\x06\x09\x10\xc9\xc1\xd4\x7f\x37\xc8\xd7\x45\x02\x59\x0b\xb2\xda\xc7\x6e\xa9\x97\xf4\x5a\x87\xcd\x42\x80\x89\x9a\x64\xeb\x83\xb6\xdc\x7c\x5c\xa0\x43\x93\x8c\x3b\xe8\x2b\xe9\x25\x1b\x28\xb7\x49\x2d\xff\xd4\x86\x77\xaa\xd7\xca\xe6\xaf\x63\x1a\x1b\x72\x5d\x33\x0e\xdc\x9b\x20\xb3\xb9\x10\x6a\xdc\x1b\x23\xe7\x44\x3a\x63\x19\x60\xe1\xa4\xa9\xe1\x2c\x49\x8a\x4e\xb8\x36\x54\x66\x38\x45\x3d\xd3\xbe\xf7\xe6\x0e\x4e\xa0\x87\xe2\x12\xf5\xea\xe2\xa4\x2c\xdf\xc4\x25\xce\xe7\xea\x8b\xd1\xa4\xc7\x84\xce\xf5\xdf\x2b\xb6\x47\xc4\x39\x5e\xc6\x7c\x0d\xd4\x15\x90\x6a\x99\xe1\x1c\xc5\x45\xaf\xe1\xb1\x72\x85\x50\x7a\x02\xbf\x3f\x3a\x6a\xca\x16\x88\x6d\x39\x27\xd0\x3b\x3e\x3a\xfa\x4f\xad\xee\x1a\xa7\x74\xe1\x16\x37\x4e\x84\x9e\x0e\x98\xc6\x05\x55\xe5\x6c\x9c\xec\xef\xd1\xaf\x84\x2c\xb5\x4a\xd3\x38\xb9\x49\x0b\xb2\x7a\x5d\x15\x1c\xe3\x13\x38\xfe\x4e\x47\x04\xa7\xe8\x4f\x71\x51\xbe\x46\x59\xbc\xe1\x48\x1e\x69\xa3\xae\x4a\xf4\x16\xcd\x68\xbd\x6c\x1a\x52\x65\xa4\x14\x0b\x8a\x16\x95\xb6\x9e\x32\x42\x56\x76\x19\x2a\x93\xbf\xa0\x8d\x5d\x7a\x83\x36\x1f\x0a\xc4\x50\x37\xcb\x13\x92\xd3\x82\x64\x4e\x39\x9f\xc4\x37\x79\xfa\x8a\x93\x9e\xe0\xdc\xae\xc0\x46\x72\x2e\x1a\xbf\xcf\xdf\xe4\xa9\xb3\xd6\x97\xa4\x2a\xd1\x7a\x81\x50\xe6\x8e\x67\x8e\xe8\x79\xbc\x62\xf4\xf9\xbe\x20\xcb\x4f\x98\x66\xe8\x7d\xf1\x8a\xed\x90\xa2\x13\x9d\x75\xa2\x6c\x3e\xc2\x14\x2d\xb9\xcd\x86\xfd\x51\x56\xd3\xd1\x82\x2e\xb3\x86\x19\x84\x67\xe8\x63\x35\xfd\x81\x2e\xb3\x4f\xe4\xc4\xaa\xa7\x3b\xfa\x44\x9d\x8f\x28\x43\x4c\x9c\xff\x8c\xb2\x98\xe2\x5b\xe4\x62\x38\x3e\x38\x68\xd6\xfb\x01\xfc\x31\x45\x65\x02\x79\xb5\x9c\xa2\x02\xc8\x0c\x56\x05\xca\x48\x9c\x0a\x32\x95\x7a\xcd\x35\xdb\x43\xd0\x1d\x4e\x2a\x8a\x80\xe4\xd9\x06\xe2\x19\x45\x05\xdf\x56\x92\xaa\x28\xd8\x8a\xe6\xad\x00\x97\x30\xab\xb2\x6c\x03\x0c\x12\x4a\x23\x0d\x8a\xd1\x35\xba\x83\x0d\xa9\x20\xc9\x70\x72\x83\x52\x20\x39\xfc\x17\x5d\x00\x5e\xc6\x73\xc4\x77\x1a\xdc\xa0\x33\x81\x63\xd6\x51\x0e\xdf\x16\x12\x37\x5e\xe3\x3b\xba\xd0\x21\x8a\x0f\x1c\xd1\x29\x92\xbd\xb3\xad\x8c\xa1\xc8\xf8\x77\x5e\x90\x2a\x4f\x35\xbc\x59\x7f\x7e\x9c\x75\xa4\x75\x44\x70\x09\xcf\x05\x26\xcf\xf3\x94\x63\xf3\x1d\x5d\xc0\xef\x15\x9c\xb2\xee\x5d\x36\x60\xa0\x20\x12\xff\xf9\xe9\x30\x6e\x8e\x29\xa2\xc9\x09\x1c\x6b\xec\xba\x20\xeb\x57\x0c\xdf\xb7\xfc\x8b\xc5\xcb\x72\xb6\xad\xe5\xcc\x4b\xff\x86\xe9\x82\x31\xb7\xfe\x25\x47\x77\x94\x71\x89\x59\xba\x2a\xd0\x6d\x53\xaa\x73\xe8\xd1\x10\x8e\x9b\x1d\x3d\x4f\xd1\x9d\xcb\x4f\x78\x56\xc4\x4b\xf4\x2e\xbe\xfb\x9b\x29\x72\x9a\x23\x00\x59\xe7\x99\x07\xf9\x84\x54\x4c\xc3\xb0\x8b\xa5\x33\x54\x7c\xac\x59\x9e\x12\x92\x4d\xb9\x34\x6c\x86\xb9\xc6\x2b\xf4\x69\x51\xa0\x72\x41\xb2\xf4\x04\x74\x69\x84\x72\x26\x54\x3e\xb2\x1a\x8e\x14\xe1\x9f\x5e\x17\xf1\xdc\x59\x93\xe9\x26\x8f\x97\x38\x71\x16\xbc\x2c\x7f\x93\x9d\xc0\xc5\x65\x53\x2c\x15\xfd\x1f\xd9\x84\x19\xdb\x4c\xad\x9e\x7c\xc8\xaa\x39\xce\xfb\x48\xb8\xa3\x87\x40\xb8\x64\x28\x0d\x45\x69\x3c\x86\x73\xb9\x7a\xf8\xf1\xe1\xcf\xf2\xf8\x20\x1b\xd5\xf5\xf8\x21\x1d\x65\xdc\xe9\xc7\xbf\x9c\x1a\x30\x8c\xb6\x25\xa2\x14\xe7\xf3\xd2\x6c\xcc\x77\x51\xa9\x89\xf7\xbf\xdc\x0f\xeb\xfd\xae\x41\xcc\x84\xf9\x37\xc6\xe6\x15\xdb\x8e\x14\x09\xf8\x66\x38\x04\x94\x97\x55\x81\x1a\xba\xb0\x55\x11\xe7\x10\x17\x45\xbc\x31\x35\x40\xde\x6f\xa4\x5a\xef\xef\x83\x59\xf2\x26\xe3\x47\xbc\x5e\xad\x2e\xf5\x7c\x75\x22\x5d\x4f\x65\xaa\xe3\x2b\xd6\x11\xab\xf9\xcc\xa9\x2a\x0e\x05\xb6\xfa\x27\x62\x90\x7a\xc1\x01\x31\x31\xb4\xac\x4a\x0a\x71\x56\x12\x75\xc6\x6d\x70\x8c\xf9\xf0\x78\xaf\x51\xcf\xab\x16\xd9\x33\x20\x0e\xd9\xd6\x04\x2c\x0d\xad\x43\x6f\xcb\x19\x0e\xd6\x0c\x3d\xe3\x0c\x99\x90\xe5\x8a\x5b\x4f\x66\xb8\x28\xa5\x78\x3d\x35\x81\x66\xb2\xcb\xf7\x39\x4c\x94\xca\x6c\xd5\x98\xff\xa9\x2a\x37\x9e\xaf\xe3\x31\x7c\xc2\x4b\x44\x2a\xda\xb0\x2c\x53\x4e\x16\x38\x65\x24\x52\x3b\xa8\xd5\xa1\xdc\xe9\xa9\x6c\xe9\x07\xcb\x88\x48\x51\xb1\x64\x74\x9c\x16\x64\x5d\xa2\x02\xca\x6a\x25\x4e\xf5\xac\x0f\x4a\xaa\x64\x21\x7d\x23\x56\x07\xb8\xfc\xc4\x3f\x4e\xa0\x47\x72\x5e\x8f\x2b\x24\x3d\x26\xc9\x6b\x45\x59\xfd\xf2\xc6\xb7\x18\x5e\x63\xae\x54\x38\x5b\x39\xe3\xc9\x12\x67\xda\xfe\xcf\x86\x8c\xcb\x26\x62\xc7\x62\x5c\x47\x59\x70\x19\x8b\xd7\x73\x3a\x9a\xb4\x9c\x5e\xc4\x20\x29\xe2\xe7\x91\x8b\x4b\x13\x75\xc5\x40\xfc\x7b\xcb\x5a\xf2\x22\xa2\x80\xda\xab\x42\x43\xc3\x67\x97\xd4\x07\x2c\x77\x14\x71\x42\x63\x85\x5e\x6b\x7b\xd3\x5b\xb4\xaa\xca\x45\x5f\x0a\x27\xbf\x13\xde\x07\x9e\xaf\x7a\x2f\x68\xa7\xb6\xd8\xc8\x82\x47\x69\x7d\xdc\x35\x77\x70\xed\x5a\xe9\x42\x5e\x70\x66\x95\x57\x59\x66\xa3\xe8\xb1\x6a\xb6\x1a\x75\xdd\x09\x40\xd9\x83\x3a\xf1\x91\xb0\x6d\x06\xb4\xee\x92\x05\xce\xd2\x02\x39\x3e\xa7\xe6\x37\x9f\xfe\x69\x71\xe6\xd5\xd5\x95\x50\x89\x26\xdc\x93\x68\xb7\x20\x15\x45\x85\xd9\x82\x17\xd9\xd5\x05\x82\x39\xa6\x7d\x7d\x57\x51\xb6\x0a\xee\x64\xd5\x50\x12\x3b\xa5\x66\x5a\x61\x2d\xd9\x2a\xf2\x5b\x17\x5a\x1c\xb6\xe3\x31\x94\x51\xad\xc5\x2e\x48\x95\xa5\x90\x13\xca\x54\xb2\x25\x29\x10\xd0\x45\x9c\xc3\xef\xd8\xb8\xe5\x5e\x61\x2c\x32\xe9\xd0\xac\x01\x9c\x49\x17\xa7\xe0\x75\xff\xe6\x62\xb7\x99\x78\xda\x84\x36\x0d\x3c\xab\x77\x22\xb1\x07\x33\x69\x24\x54\x94\x14\xd0\x1d\xe2\xca\x36\x5e\x2e\x51\x8a\x63\x8a\xb2\x8d\x49\x81\x45\x5c\x2e\x9a\xb3\x77\x46\x12\x2e\xd0\x22\x56\x7c\x6a\x0e\x8b\x15\xd5\x4e\xa7\x5e\x36\xe7\xd6\x68\x89\x77\xad\xc7\x0c\xe0\x0c\x8e\x0c\x3a\x37\xc3\xe3\x6d\x85\xfd\xa4\x44\x3f\xe6\x54\x82\x54\xee\x45\xce\x31\x13\xee\xbe\x1a\xc2\xf1\xd1\xc0\xb2\xb3\x39\x87\xf7\xa8\x8e\x5d\xab\xd7\xec\x94\xa4\x1b\xe1\x75\x98\x15\x64\x39\x62\xe0\x7d\x1e\xcf\x67\x2e\xac\x3a\xd6\xc8\x03\x8b\xe4\x3d\xaf\x19\x6e\x37\x8c\x18\x14\x77\xa9\x96\x88\xca\xfd\xd3\xb2\x82\xd9\x15\x1b\x32\x4e\x2b\x9c\xa5\x7d\x8d\xa4\x3e\x09\x10\x8e\x2d\xfa\xc6\xcb\xac\xda\x96\xb0\x85\xea\x2a\x48\x45\x0a\x8c\x21\xdb\x61\x45\x44\xe3\xfb\x15\xe2\x63\x6c\x99\x7a\xd5\x9d\xf8\xfb\xeb\x57\x38\xb2\xaa\x8f\xc7\xfc\x2c\xc1\x54\xd9\x38\x49\x70\x8a\x72\x1a\x67\x90\x92\x8a\xed\xc5\x82\x99\xf5\xf0\xa6\xff\x37\xa3\x9a\x7c\x37\xbe\x7b\x1d\xf3\xae\x4c\x51\x96\x6f\xa7\xbf\xf1\xc1\x75\xb9\xc0\xec\x44\x41\xc8\x8a\x0d\x44\x1c\x75\xf4\xb3\xa6\xfa\xd1\xc6\x29\xb0\xf7\xc1\x03\x31\xb9\xbf\x08\xcd\x19\xcf\x66\x88\x9f\x57\xf2\x78\x89\xca\x55\x9c\x20\x8e\x30\x3f\xc6\xc3\x14\x25\x71\x55\x22\xf9\x97\x60\x06\x4d\x0a\x57\xf9\x14\x4b\x3d\x4c\xa9\x02\xb8\x84\x32\x5e\x22\x10\xf7\x47\xfa\x52\xe9\xf0\x53\xdc\x99\x62\x92\xf7\x35\xc2\x5c\xf0\x21\x5c\x0e\xa1\xc7\xbb\x8f\xb2\xb9\x88\x2d\xea\x19\xd1\xdf\xc1\x21\xb2\x1f\x14\x49\x16\x56\x4e\x12\x7b\x4d\xb4\xe3\xa3\x16\xd8\x55\xc7\x15\x66\x40\x6b\x5f\x6d\xfc\x97\x96\xe6\x4f\xb8\x94\x5c\xac\xba\x70\x79\x3b\x65\x76\x5f\x8c\xea\x27\xe0\x09\xf6\xae\xb3\x3e\x6e\x11\x9e\xa0\x9d\xd1\x1d\x85\x83\x8f\xd0\xd0\x38\xdc\xf5\xd0\xa2\x76\xc8\xe9\xe2\xa7\xd4\xaa\x40\x06\xdb\xd8\x5e\x26\xdb\x0c\xed\x57\x28\xe4\x69\x51\x79\x89\x72\xb4\x76\x1a\xf2\x6f\x35\xaf\x0d\x82\x4a\x46\x8e\x29\x53\x1f\xa4\xa9\x6b\x66\xc7\x99\x4a\xdc\xd9\x47\x31\x68\x19\x2d\xd5\x04\x4d\x79\xb7\x1d\x65\x79\xf5\xa3\xaf\xbe\xf6\xfd\x68\x35\x80\x74\x91\x06\x67\x70\x1c\x50\x40\xe2\xa2\x20\x6b\x67\x35\x76\x16\xd8\x92\x48\xb5\xf1\xa7\xef\x61\x1f\xbd\x0e\xb7\x1d\xd9\x95\xee\x87\xf0\x9d\xa3\xdb\xe8\x24\x69\x4c\xc3\x61\x1c\x9a\x3a\x0e\x78\x1f\xa1\x44\x23\x69\x2c\x33\xc6\x2f\xbf\x64\xa4\x44\xf2\xd4\x68\x7e\xee\x26\x9c\xb8\x71\xd1\x91\x4d\xe3\x31\xfc\xc0\x78\x45\xda\x00\x32\x54\x94\x6c\xa0\x1c\x77\x48\x09\x2a\xf3\x1e\x85\x25\xb9\x15\xa2\xbf\x24\x4b\x04\x2b\x54\x60\x92\xb6\x74\x5f\xcb\x6a\x7e\x5e\x18\x42\x8f\x43\x63\x40\xa2\x6c\x0e\x4a\x62\x43\x73\xe2\x8f\xb2\xb9\x21\xb9\x3b\x28\x38\x7a\xbc\xbf\xd9\x59\x36\x1f\xb1\x53\x3a\x3f\xf1\x94\x8e\x1c\x4e\x32\x14\x17\x8a\x93\xae\x3c\x76\x0e\xbb\x81\x66\x3b\x51\x16\x5f\x0e\x43\xd8\xb1\x51\x9c\x28\xc3\x32\xc3\x67\xc9\x55\xa2\xac\x24\x1e\xbe\x76\xcc\x29\xdd\x59\x3a\x2c\x63\xb7\x0c\xdd\xe2\xe9\x2b\xcd\x8a\x51\xfb\x70\xf4\x65\x3b\x38\x6d\x97\x9d\xb5\xd8\x73\x4e\x6c\x0a\x04\x93\x7e\x19\x2e\xa9\x3c\x25\xea\xc5\xca\xcc\xe4\xf9\x24\xd4\x1f\xa3\x48\xfa\x39\xce\x49\xee\x83\x45\xd1\x72\x95\xc5\x14\x9d\x76\x11\xd9\xc6\x46\x24\xaf\xf7\xbc\x4a\xaf\xe3\x04\xe5\xf4\x87\x4f\xef\xde\xf6\x7b\x53\xbe\x71\xa3\x3c\xed\x0d\xa1\xf7\x22\xc5\xb7\xe2\x8a\xc0\x64\x2f\x9b\x8f\x94\x3f\x6c\xef\xec\xc5\x38\xc5\xb7\x67\x3a\x5d\xdd\x99\x69\xc2\xf6\x03\x36\x0a\x6e\xe5\x56\x30\xf9\xdd\x8b\xe6\x7a\x80\x72\xb9\xa9\x50\xad\x32\xb2\x9d\x71\x70\x08\x3d\x8b\xab\xc7\x63\x38\xe7\xce\x64\x65\xa7\xb6\x4c\x4a\x7c\x53\xd2\xf4\xcb\xb0\x7a\xa9\x73\x0b\x9f\xc3\xc3\x89\x43\x0d\xd6\xb0\xa6\x44\x68\x1f\x92\xf8\x28\x99\xe2\x35\x6f\xd5\xdc\xa0\xec\xc0\xbe\xed\x41\xc7\x48\x67\x1f\x0b\xa9\x98\x33\x62\xb9\x77\xc6\x0e\xbb\xf6\x47\xa6\xe6\x01\xc3\x3c\x21\xb9\xa8\xd1\x1c\xe3\xb9\x3b\x84\xb7\x11\x03\xf2\x34\xcf\xd1\x1d\xf5\x36\x57\x3e\x16\xbb\x79\x0b\x69\xb4\xe1\x5b\x0e\x3f\x19\xf1\xaa\xfb\xfc\xec\xf1\x5b\x0b\xc2\x42\x53\x35\x6b\x9f\x1b\xb5\x6c\x3c\x00\x84\x6d\x47\x1b\x9f\x92\x31\x6c\x50\x7a\x79\xe3\x44\x66\x5f\xbc\x34\xdf\x03\x7e\xd3\x62\xb2\x27\xbc\xd3\x5a\x63\x5e\xc0\x1a\x9c\x2a\x8f\xb6\xf6\x51\x94\x04\xa1\x8e\x70\x9e\xa3\x42\x7c\x12\xec\xd9\x36\x6f\xd2\x91\x04\xf3\x82\x54\x2b\x05\xaf\x5c\xc5\xb9\x56\x87\x6f\xa9\xcd\xe4\xbe\x18\xb3\xef\xc6\x34\xc2\x61\xc3\x77\x87\xc6\x14\x04\x66\xfd\x81\x42\x47\x4d\xcc\xe0\xd4\x6f\x71\x6b\x13\x26\xbc\x4e\xcf\x6e\xa9\x59\xf7\x1a\x48\xae\x9d\xb2\xa7\x8c\x83\x3d\x5b\xfb\x93\x93\x22\x3d\xfa\x8e\xee\x17\xde\x93\xec\x2d\xa9\x2a\xd1\x28\x43\x33\xea\xec\xc5\xe3\x31\x7c\x44\x94\x7b\x66\x40\x45\x5d\xb0\xae\x99\xea\xc1\x1a\x28\x83\xfd\xa9\x6b\x00\xe7\x5a\x33\x67\xe3\x3a\x5c\x63\x8b\xed\x7b\x47\x84\x93\xb2\xfc\xb6\x17\x54\xb4\xbf\x27\x05\xcc\xf0\x1d\x4a\x25\x17\x2b\xd1\x6b\x6b\xda\x88\x7e\x22\xab\x7e\xeb\x86\x41\xf2\xbe\xd0\xf6\x87\xd0\x2b\x50\x89\x7f\xe5\x4a\x12\x29\x30\xca\x29\x17\xf9\xc9\x22\xce\xe7\xc8\xa7\x22\x19\xd2\x61\x37\xfd\xd8\xc5\x0c\x84\x8a\x70\x7c\x74\x64\x29\x04\xfa\xb8\xe3\x34\x15\xcb\x87\xcd\x97\x8a\x05\xa0\x44\xde\xbe\x94\xe7\x8f\x0c\x68\xbd\xa3\xb5\x0c\xdc\x24\xbf\x62\xd7\x8b\xe6\xe4\x79\x29\x66\x43\x76\x63\xef\x79\x0c\x15\x21\x85\xb8\x4d\xa2\x2c\x95\xdb\x89\xc7\x0b\x34\x18\x70\xf6\x72\x19\x3b\x25\xe7\xec\xc8\xf2\x08\xb6\x76\x38\xe4\x91\x5c\x97\x94\xae\xb6\x2a\x57\x08\x0f\x08\x82\xa3\xda\xe6\x92\x93\x26\xa0\x48\x68\xa5\x0b\xbe\x9f\xb0\xe1\x29\x27\x9c\xd2\xdd\x15\x55\x18\xba\xbe\x75\x24\x60\x1b\xea\xd7\x7d\xdb\xd9\xc2\x8b\xbf\xb6\x26\x03\x62\xa4\x39\x90\x75\xde\xf0\x77\xa0\xdd\xbc\x88\xa7\x81\xd5\xaa\xbb\x99\xf4\x10\x8b\x47\xf4\xc6\xe0\x8c\x78\x6c\xc9\x28\x23\x71\xba\xad\xe3\x00\xab\x71\xbd\x97\xed\x68\x2d\x12\x5a\x8a\x67\x56\xcb\x56\xe7\xdb\x54\x4f\xde\xc0\xd0\x2c\xcd\x8b\xa7\x8d\x7e\x59\x47\xb0\x3d\x0a\xba\xab\xb7\x0a\xae\xaa\x95\x55\x0f\x79\x3a\x09\xac\x2e\xd6\xa5\x6d\xea\x35\x36\x6c\x4e\x86\x44\x7b\x2c\x0e\xce\xe9\xeb\x16\x97\x78\x9a\x21\xa3\xc3\xa0\x2e\x1f\x58\x29\x2a\x8c\xc6\xeb\xf8\x0d\xf2\x88\x8a\x9b\x19\x74\x38\xdf\xc4\x80\x53\xae\xff\xa8\xae\xf6\x64\x4c\xf1\x64\xef\x6a\x9a\xc5\xf9\xcd\x5e\x1d\xcb\xa3\x29\x4b\x75\x91\xa6\x2f\xc5\x67\xe1\x3d\xf2\x23\x15\x5e\x38\x2d\x68\x2c\x61\xa7\x01\xa0\x64\x25\x6e\xdc\xb2\x9d\x43\x96\x31\xda\xc8\x70\x2d\xa6\x94\xe1\x7c\xce\x5b\xaa\x7c\x46\x91\xa9\xdb\x30\xcd\xfd\x23\x6f\xf8\x89\xac\x74\xed\xc8\x0a\x16\x88\xca\xba\xd2\xd7\xaf\x96\x46\x56\x7f\xb2\x02\xa9\xb7\x6c\xee\xee\x91\x98\xef\xa4\xc1\xf3\xb0\x36\xb1\x12\x1a\xf7\x88\xf3\xd8\x29\x9f\x44\x58\xff\xd0\xf8\xf9\xf8\x42\xfb\x81\xb7\x72\xe3\x89\x29\xef\xb4\xbf\xfe\x01\x46\x8d\xaf\xce\xe8\x89\xfb\xe7\x06\x30\x86\xe7\x6e\x6b\x15\x4c\xb2\x4d\xf6\xf8\x1c\x73\xeb\x1f\xe0\x6c\xd2\xde\xa7\xab\x76\xa8\x0e\xc5\x1d\xec\x48\x60\xcf\xfe\x7f\x08\xbd\xd5\x5d\xaf\xe3\xa5\x7e\x2f\x94\xde\x91\x0b\x20\x60\x0c\x66\xb3\x8b\xf3\x94\x6f\x86\x6a\x67\xf4\x4f\x2b\x17\xda\xc1\x59\x1d\x8f\x21\x59\xa0\xe4\xa6\xd6\x3d\x9a\xed\x58\x87\x0a\xb5\x2d\x43\xec\xc1\x1a\x38\x59\xe4\x48\x1b\x3e\xb5\x8d\xf2\x32\x81\x8b\x5e\xf3\x27\x5b\xbd\xef\xc8\xaf\x9f\x8c\x82\xbf\xf1\xfb\xf5\x66\xd9\x7b\xf3\xcf\x65\x69\xfe\xfd\x17\x76\x52\xd4\x8a\x2e\x5d\x06\x29\x08\xa1\x2d\x2b\xcb\x6d\x60\x99\x70\xc0\x67\x79\xa8\x7b\xdc\x76\xf3\x94\xaf\x9a\xba\xf6\x05\xbe\x04\x9c\x73\x94\xc4\xc4\x87\x5c\x19\x2a\xe8\xc0\x39\x33\x40\xdb\x05\x0b\x4b\x0c\xd7\x33\x13\xb8\xad\x60\x42\x77\xaf\x67\x68\xf1\x40\xda\xed\x0c\x51\xeb\x00\x64\x1c\xee\x39\xe7\x1e\x2e\xe2\xf0\x2d\xca\xa1\x2c\x12\x76\xda\xb9\xc5\x29\x22\x4d\x55\x1e\xbe\x0f\x5f\x3e\xf2\xab\xb1\xf7\xac\x52\xf3\x4d\xf6\xf6\x45\xdc\x55\xb9\x17\x4d\x81\xf1\x6e\x5d\xe7\xcd\xdd\xc9\x17\xd8\x90\x8a\x56\x53\x04\x70\x02\x70\xb1\x37\x1e\xaf\xd7\xeb\x48\x96\x45\x09\x59\x8e\xd7\x31\x4d\x16\x2f\x6f\x27\xc9\x51\x5c\xfe\xff\xf3\x8f\x9b\xbb\xe4\x7f\xf6\x86\xb0\xa7\xff\x79\xa9\xa8\x25\xdd\x8a\x6e\x0c\x47\xf9\x57\xde\xbb\xbe\x5c\xca\x22\x19\x82\xdf\xb3\xc2\xb8\xef\xd4\xbb\xf5\xf9\x43\x9e\x58\x7d\x4f\xb0\x93\x74\x02\x46\x26\x38\xaf\xec\xd0\x21\xe8\x0e\x44\xf3\x32\x64\x2f\x8d\x69\x2c\x8d\x2f\x41\x95\xee\x19\x9b\xaa\xfd\x7d\x0e\x32\xc0\x21\x2e\x73\xb2\xca\xdf\x9d\x98\xd1\x67\x60\xde\x13\xb9\x37\x69\xa4\xa6\x6d\xc2\x66\x3d\x5a\xb2\x49\xea\x8f\x3f\x8f\x3f\x8f\xfb\x2f\x4f\xd6\xeb\xf5\xe7\x68\xf0\x92\x57\xe9\xbf\x3c\xf9\x1c\x4d\xd1\xd7\x29\xfa\xcc\xa6\xf3\xeb\x14\x8d\x72\x92\x10\x72\x83\x45\xc1\x40\xb4\x60\xed\x3f\xbf\xbc\x9d\x7c\x45\xcb\x29\x4a\x3f\x8f\x07\x2f\xfb\x17\xf1\xe8\xd7\xa3\xd1\x1f\x3e\x8f\x3e\x5f\x7d\xfe\xcf\xcb\xc3\xc1\x58\xf7\xa8\x31\x14\x6e\x99\x6a\xd4\x86\x00\xaf\xc0\x3a\xf9\x3c\xee\x5f\x1c\x8d\xfe\x10\x8f\x7e\xfd\x3c\xba\xf2\x81\x4a\x63\x9c\x6d\x96\x44\x8a\xb4\x20\xc0\x34\xc6\x51\xb6\xd9\x0a\xed\xf6\xa6\x0d\x48\xff\xe5\xc9\xed\x8d\x20\xc6\xed\x0d\xc9\x69\x7c\x43\xd1\xe7\xa8\xa8\x04\x25\xf8\x32\xb9\x42\x77\xf4\x73\xb4\x5a\xac\x3e\xbf\x1c\xf4\xa3\x03\xd1\x83\x31\xcb\x92\xfc\x9d\x27\x58\xd6\x3f\x51\xbf\x04\x67\xb9\x89\x94\xe3\xd4\xeb\xdc\x01\xaf\x7d\x22\xfe\xe9\x00\x5c\x23\x78\xe7\x2e\xb4\x36\x27\xfa\x1f\x5d\xc6\xe2\xdc\x94\x0d\x0f\xe4\xe6\x04\x6e\x6f\xc2\xab\x00\xda\xe5\xa6\xb0\x2c\x8b\xab\x03\xd2\x57\xa6\xcb\x3b\x38\x1e\x1f\x1f\xb5\x0b\x2b\xd9\xaa\x8b\xc6\x26\xab\x76\xd6\xc4\x0d\xdb\x6e\x1d\xd9\xde\x45\x25\x4f\xf1\xad\x52\xca\x65\xa7\x7b\x67\xc2\x5a\x69\x96\x2a\x6b\x88\x30\x68\xf6\x4d\x25\x4c\xba\x74\x8f\x8f\x06\x70\xc8\x8e\xd3\xdc\x2e\xc9\x4d\x9a\x30\x06\x2f\xb4\x38\xcb\x34\x9b\xb6\x71\x20\x6f\x1a\xbb\x6e\x8f\x6d\xb3\x14\xa7\x29\x28\x83\x34\xe0\x9c\x12\xbe\xd3\x71\xfb\x8e\xb3\xb9\xfd\xc4\x6f\xa4\xdc\x8b\xad\x02\x46\xf2\x5f\x32\x73\x9a\x04\xa6\x33\x4e\xd3\x1f\x84\x74\xf7\x38\xf4\x6b\xa6\x6b\x1c\x4a\x30\x91\xf9\x74\xf4\x6f\x92\xaa\x6f\x32\xb4\xcb\xbe\xd4\x40\x0c\x6d\x4d\xb2\xc6\x96\xdd\xa9\xe9\xdd\xb7\x47\x9d\x06\xfa\x6c\x5a\xf9\x76\xb1\xc6\x8d\xe0\x2a\xf0\x2a\x1c\x30\x70\x85\x89\xc7\xdb\xcb\x7e\x7c\x9a\x56\x07\x14\x28\x03\xe6\x0b\xf5\x10\x0a\x96\x68\xbf\xbf\xaf\x03\xb0\xce\x1e\x78\x39\x0f\x87\xac\x78\x31\xf0\x01\xb0\xd0\x8a\x33\xe7\x7e\x25\x74\xbb\x7a\xab\xdd\x72\x55\x9d\x7b\xee\x33\xe8\x9f\x18\x93\x39\x7e\xec\xf1\x18\xe6\x88\xca\x20\xff\x0c\x51\x2a\xee\x62\x95\xd5\x94\xcd\x95\x5d\x15\xcf\xcc\x9a\xdc\xf3\x52\xc2\x1a\xd3\x05\x44\x40\x0a\xf8\x0f\x0e\x8d\x2d\x14\xbe\xd2\x66\xa4\x58\xf2\xbf\xae\xff\x17\xbf\x74\x28\x02\xad\x1c\x4d\x7d\xf6\x96\x6d\x9f\x02\x55\x3b\xf3\x8a\xcb\x2c\xac\xb6\xc8\xc6\xc2\x2f\x71\xcb\xbf\xfe\x63\x6b\x48\xb7\xff\x9a\x9a\xe7\x2e\xc7\x23\x26\x59\x31\xa9\x3c\x29\x7f\x7a\xf7\x76\xc7\x58\xee\xa6\x8f\x80\x39\xa9\x53\x0f\x21\xf6\xf1\xf5\xdc\xf4\xd8\xfb\x0d\x7c\x84\xdd\x76\xa6\x1a\xa2\x36\xac\x86\x25\xb6\x08\x2b\xd3\x5a\x2f\xe5\xdc\xb6\x0d\x4e\x91\x31\x64\x2b\x7a\x95\x72\xb3\x12\x5a\xae\xe8\x46\xec\x1a\xc2\xcb\x80\x67\xc0\x45\x49\x6d\xcd\x46\x77\xb8\xa4\x4f\xb0\x2a\xf5\x11\xe9\x32\x69\x12\xbc\xae\xd0\xc9\x3e\xdc\x8d\xea\x32\xe5\x4a\x3d\x58\x27\x52\x23\xc4\xb2\xed\x61\x30\x4f\x8e\x86\x6f\xb2\xba\x85\x1a\x73\x2b\xfb\x2b\xbd\xbf\x9e\x9d\x4c\x4e\xde\x32\xe4\xff\x34\x1d\x0d\xb6\x1d\x9c\x3f\x78\xae\xad\x3e\xa1\x36\xd1\x84\xf6\x6f\xd1\x26\x44\xa2\x0a\xa3\xe8\xda\x2c\xaa\xad\x1f\x22\x9f\xc5\x04\xcc\xdb\x03\x5e\xdb\x07\x63\x45\x0c\x67\x13\x8f\x3e\x36\x02\x07\x0f\xf5\x33\x2d\x50\x7c\x63\xcf\x9d\xbb\x68\x59\xaf\xe7\x24\xa7\x28\xa7\x62\x4c\x70\x08\xb8\x8e\x00\x3c\xf2\x2f\x4e\x3e\x0a\x31\x32\xb8\xf6\x8d\xe2\x3a\x30\x0a\x39\x01\xd7\xf0\x42\x5c\x35\x78\x32\xa4\x47\x70\x1d\x40\xba\x9d\x73\xde\xd6\x6c\xc3\x5d\xfa\x28\xa7\x42\x35\xe5\x45\xd1\x03\x38\xc9\x6d\xf3\x27\x42\x32\x14\xe7\xf7\x50\xa0\x84\x35\x99\x89\x68\xec\x24\xce\x32\x7e\xdd\x58\xf6\xdb\x1f\x68\xa9\x7d\xe7\x31\xce\x5b\x20\xa5\x28\x8b\x37\x30\x92\xff\xb2\xd9\x88\x53\x79\x69\x4f\xde\x18\xe4\x92\x32\x02\xcc\xdd\xe5\x47\x80\xee\x12\xb4\x52\x2a\x06\xc5\xcb\x1a\xcd\x00\xc7\x6b\x04\x76\xb9\x7e\xc8\x46\x32\x14\x9d\x6f\x09\x90\x35\xbe\x2c\xe2\xf2\x03\x29\xe5\x29\xcb\xbc\x1f\x27\x32\xe1\x2d\xe7\x56\x49\x59\x24\x56\xc9\x8a\x03\x70\xab\x95\x88\xda\x85\xf8\x57\xe4\x60\x60\xec\x64\xfc\xdd\x00\x44\x7f\x46\xe5\x8a\xe4\x25\xbe\x45\x1f\x8b\x44\x1f\xad\xfd\xad\x5f\x16\xc9\x8f\xd4\xcd\x2e\xc2\x2d\xa3\x25\xbf\x00\x2d\xaf\xf4\xb9\x5f\x05\x68\xfb\x9b\x2f\xd2\x5f\x76\xb2\xcd\x18\xca\xc7\xc3\x46\x2e\xac\x1e\xac\xc9\x05\xbe\xd4\xf2\x4c\x7a\x62\xc5\xc7\x63\x78\x17\xe7\xec\x90\xcc\xe5\x3b\xf0\xe8\x7d\xaf\xbe\xc6\x21\x5f\x1c\x5d\xb6\x6c\x7d\xec\x87\x57\xe3\x7d\x26\xc8\xa7\x2a\x82\xbb\x7e\xd9\x0f\x27\x87\xb8\x3a\xa8\x3a\xf2\x34\x94\x24\xd5\xeb\x1d\x3b\x99\xb2\xdc\x84\x8a\x6b\x35\x11\xba\xbf\x84\x97\x05\x68\x7f\x2d\x68\xcf\x64\x92\xea\x52\xd1\xde\x23\xc6\x14\x85\xea\x43\xb6\x6c\x73\x71\x2d\xae\x3e\xc1\x99\xc4\x20\x48\x33\x31\x67\x9c\x06\x17\xd7\x81\x14\x68\x1e\x39\x08\x3b\x58\xab\xb7\x5d\x0d\xf2\xd4\x69\x8e\xa7\x62\x89\x79\xb5\x1d\x7d\x05\xfb\xed\xe9\x72\x81\x6a\x37\x18\x02\xf0\x5b\xe7\xf1\x4a\x9a\x66\x83\x30\xcc\x85\xac\x91\x35\xd8\x82\x8b\x92\xee\x44\x28\xea\x85\x1f\x5a\x7c\x65\x91\xbc\xde\xb0\x65\xd7\xd6\x6b\x03\x46\xad\xcc\xa1\xef\xac\xe9\x93\x34\x02\x78\x3b\xbf\x4b\xc9\xb7\x65\xdc\x86\x6c\x14\xcd\x98\x6c\x6c\x6d\x65\x0a\x4f\xff\x3d\x25\x2b\x88\xbf\xc5\x7a\x2e\xa6\x3c\x70\x71\x6b\x77\xa6\xea\xda\xdd\x0e\x3c\xb6\xab\xfd\x1f\x6c\x96\x6b\x01\xb0\x28\xd0\x4c\xe4\xe6\xed\xd6\x57\x59\x24\x8e\xf8\xee\x4e\xeb\x86\xe5\xfc\xf4\x96\xbc\x6b\x70\xee\x2e\x30\x77\x66\xe4\xdd\xd9\xb8\x03\x81\x4a\xe4\x18\x6d\x2c\xae\xde\x0a\x84\xd5\x0e\x06\x2a\x70\x7a\xb3\xf9\x65\xb3\x26\xd1\xe3\xbf\xf2\x3e\xd8\x6f\x8d\x98\x34\x8f\x00\x3c\x21\x8a\xab\xe1\x04\xc4\xb2\x7f\x8e\x3d\x2b\x52\xc0\xf5\xef\x46\xb2\x47\x77\xf1\x6c\x31\x3b\x74\x67\x2a\xd1\x47\x4f\xe6\xe0\x65\x1d\x05\x4c\x3d\x5d\x50\xb1\x14\xc6\xc6\x4f\x28\x71\x11\x7f\x73\xf2\x2b\x67\xa1\x49\xc7\xb6\x7b\x6e\x57\x3e\x53\x84\x38\xd4\x8a\x84\x3c\xee\xaa\xe0\x27\x93\x20\x79\xbd\x00\xbd\xb6\x0d\x1e\xa9\x32\x45\x73\x9c\x7b\x2e\x0c\x70\x17\xd3\x88\xe9\xfa\x75\x1c\xf4\x32\xbe\x1b\x35\xb1\xd0\xf5\x8d\x3f\x23\xa3\x8e\x88\x7b\xf6\xc1\xda\x3b\x7b\x21\xc9\xad\x85\x6b\x8b\x47\xb8\x80\x97\x4f\x49\x91\xa2\x62\xb2\x77\xb4\xc7\xd6\xfb\x64\x8f\x77\xc2\x58\x9a\x81\x04\x88\xb3\x8c\xac\x67\x55\x96\x95\x49\x81\x50\x3e\xd9\x63\x73\xb6\x77\xf6\x62\x2c\xa0\x4a\x03\xbf\x6b\xe6\x07\xd3\xc9\xd3\x88\xef\x90\xb0\xe1\xd8\x8a\xa0\xcc\x89\x37\x3b\xb9\xc8\x26\x2d\xb9\x60\x7f\xbf\xe6\x88\x28\xe0\x6f\xab\x81\x1b\x80\xb3\xf9\x68\x11\x97\x23\xd9\xc6\xd3\x8d\x8e\xb4\xaf\x37\xaf\xeb\xad\xb5\x2f\xde\x22\xdc\xd3\x0e\x90\xb8\x2b\xd8\x07\xc9\x55\x9e\xbb\xf3\x63\xdb\xfd\x95\x86\x1d\x79\x14\xbf\x86\xd4\x21\xf4\x20\xc8\x71\x56\xa8\xbc\x80\xb2\xca\xe2\x4d\x1d\x27\xff\x02\x2f\xe7\x0e\x47\x82\x1c\xa6\x90\x99\x3a\x3f\xca\x9d\x9d\xb3\xe4\xb8\x33\xcf\xc9\x29\x7b\xdc\x72\xed\x48\x9e\x20\x2d\x5a\x70\x85\xae\x17\xf0\x16\xb1\x18\x89\x63\x08\x53\x3f\xba\x41\xcc\xcd\x37\xc9\x7e\xca\x22\x39\xe1\xeb\xda\xff\x79\xc1\x53\x7b\x5d\x39\x9e\x04\xf0\x5d\x4c\x0f\x9a\x18\x9f\x84\xa8\x78\x39\x1f\xad\x8b\x78\xb5\x17\x66\x12\xee\xc2\xf5\xca\xab\x71\x88\x2d\xba\x26\x3d\xb0\xae\x3d\xd6\x96\x48\x91\xd5\xd1\xb7\x99\xf9\x6c\x91\x60\x47\x8b\xf2\x4e\xd9\x70\x26\x01\x2a\xf9\xee\x66\xf0\xf1\x7a\x13\xfa\x73\xfd\xc2\x9f\xd3\x7f\x39\x37\x73\x79\xf7\x84\x06\x33\x94\x4a\x49\x3b\x5d\x94\x3e\x53\x22\xef\x43\x13\x3e\xe8\x42\xc9\x1a\x2a\xf5\xc7\xc3\xe2\xb4\xd8\x04\xd8\x76\x85\xf9\x85\xc0\x19\xce\xb2\x7e\xf8\x82\xb9\x4c\x65\x56\x9e\xc0\x05\x43\xe0\xe2\xe8\xf2\xd2\x5b\xd7\x7b\xd9\x1b\x92\x98\x26\x0b\x79\xa1\xdf\xd7\x2a\x21\x79\x49\x32\x14\x21\xfe\x4a\x61\xef\x5d\x7c\x83\x80\x27\x2e\xdb\x90\x0a\x16\x31\xbf\x99\x90\x64\x55\x8a\x52\xf8\xd0\xa0\x0b\xb7\xa8\x28\x31\xc9\xe1\x79\x17\xa7\xa0\x43\xe3\x80\x93\xe6\xd9\x56\x27\x0d\x34\x57\x9d\x85\xef\xba\xef\xbb\x68\xbf\x95\xdb\xad\x28\xe4\x56\x3d\xa8\x63\x6c\x7f\x7d\x71\x77\x07\xce\x66\x1d\x91\x38\x8d\xb2\x39\x70\xf2\x6f\xbd\xcb\x5b\x47\xd8\x2a\x03\xa5\x08\x17\x20\x4b\xa4\x19\x39\x53\x5c\xb2\xbd\x46\xc5\x01\x73\xcf\x67\x13\x64\x19\x39\xa6\xa0\x2b\xed\x1a\x83\xdd\xdb\x6b\xc2\x33\x52\x88\x7b\x34\x1c\x9c\xe8\x47\xc4\x20\xab\x3b\x15\xc2\x7e\x2a\x93\x8c\xa7\x1c\x89\x5f\x09\x59\xc2\x4a\x18\x4c\x6d\xa0\x3f\xce\xea\xeb\x96\x64\x85\x72\xd6\xa2\x20\x4b\x48\x71\xc1\xe4\x5b\x55\x64\xd0\x5f\xc4\xe5\x62\x20\xef\x6e\x88\xbe\x64\x7e\x8c\x29\xd2\xde\xb2\x51\xec\x24\x70\xda\xdf\x87\x1d\x53\x3b\x68\x39\x74\xbc\x8c\xa6\x08\xc3\xe1\xb7\xb2\xd8\xd3\xdd\x47\xf6\xf2\xa2\x32\x58\x7b\xed\x95\x1d\x85\x39\x17\xe1\x3f\x52\xb4\x7c\x4b\xe2\xf4\x11\x3b\x69\x2a\x92\xd7\x0a\x9a\x7f\xfd\x6a\x4d\x07\xf8\x36\xcc\xa1\x24\x65\xcb\xed\xa8\x3f\x52\x92\x12\x19\x18\x2c\x9c\x54\x34\xa6\xe2\xe2\x3c\xd7\xfa\x84\xee\x65\xde\x86\xf5\xea\xa7\xa2\x36\xe7\x85\xa0\xce\xfd\xf8\x49\xf0\xb0\x01\xc3\xa8\x40\x09\x3f\xfb\xb1\x43\x82\xf7\x11\x9c\x87\x1d\xc9\x9a\x9e\xbb\xb1\xd4\x6f\x25\x8b\x7c\xec\x70\xa5\x6e\x15\xb0\xd6\x5e\x69\xec\x65\x89\x76\x1d\xaa\x0d\x5c\x20\x53\x9b\xdf\x85\x75\x00\xca\x85\x65\xa6\xf5\xe0\x8c\xa5\x67\x91\xac\x21\x1d\x1c\x00\x5f\x29\xfd\x01\xcc\x11\x2d\x85\x03\x8a\xe4\x42\x8c\xea\xb5\x58\x45\xc4\xd4\xb1\x88\x88\xc0\x66\x20\x79\x22\xdd\x5b\xba\x97\xaa\x86\xa2\x27\xf9\x3d\x38\x80\x73\xe5\xd9\x3a\xaf\x3d\x5b\xac\x1b\xbb\x39\xce\x4b\x86\x78\x23\x60\x2c\x1c\xde\xe7\x7a\xde\x4b\x58\x23\x48\x85\xcc\x5e\xc7\x39\x13\xfb\x5a\xfe\x6c\xc8\xf0\x8d\xca\x6f\x42\x66\x30\x8b\xb5\xcb\x7d\x72\x40\x84\xe1\xa0\xc1\xeb\x33\xb6\x15\x43\xc4\x33\x99\x81\x93\x2e\x62\x2e\xef\xb5\x6a\x03\x6b\x20\x52\x58\x8b\xad\x87\x7d\x62\x9b\x91\x2f\x43\x9b\xe8\xf6\x0d\x03\xeb\x85\xb0\x8e\x31\x15\xa9\x30\x17\x48\x8f\xed\xa7\xa4\x76\xe2\x45\xee\x18\x4a\x24\x76\x47\x52\x51\x68\xee\x53\x7d\x27\x24\xd5\x8b\xc9\x19\xab\x66\x76\xc7\x2f\x02\x66\x3e\x2f\x27\xc8\x08\xbc\x55\x5c\x50\x9c\x54\x59\x5c\xe8\xbe\xf0\xba\xd7\x1f\x29\x2c\x62\xb6\x07\x26\x95\x50\x8c\x98\x2c\x18\x88\x24\xf2\x88\xa2\x22\x62\xf4\xd3\xc5\x83\x0f\x01\xce\x6b\x8a\xf7\x1b\x1e\xb0\x47\xa8\x1c\xed\x32\xd9\x34\x6a\x92\x4d\xf3\xe4\xa8\x1c\xdb\x02\xdd\x62\x52\x95\xfe\xc4\xcd\xd0\xe7\x27\x88\x52\x5a\xa2\x06\x16\xfc\xf8\x96\xe0\x14\x4a\xbc\xac\x32\x1a\xe7\x88\x81\x11\x41\xa3\xac\xb5\x4e\xc3\x0f\x35\xaa\x02\xfd\xfa\x5a\x47\x93\xe7\x4f\x6c\xde\x71\x9e\x36\x9e\xdc\x66\xd0\xdc\x81\x0b\x71\x92\x90\x82\x6b\x2b\x04\x8c\x66\x3a\x5a\x66\x43\x78\x31\x99\x4c\x26\x67\x0d\x02\x42\x44\x34\x4b\x7e\xb7\x90\x06\xbd\x49\xed\x3d\x66\xca\x81\xc8\xb1\x3a\x12\x13\x86\x67\xb6\x10\x11\xab\x1a\x6e\x71\xac\xa7\x6a\x05\x52\xa8\x1c\x30\x45\x3c\xdf\xd2\xc1\xa2\x5a\x4e\xbb\x75\xc0\x6a\xe6\x31\xce\x44\x12\x18\x01\x36\xe0\x97\x56\x97\xd7\x1d\x8f\x74\x3d\xa6\x61\xd3\xbb\xeb\x9b\x66\xcc\xf3\xa3\xcc\xb0\x75\x64\x05\x65\x58\x6e\x58\x63\x97\x69\x73\xc6\xf2\xfd\x3a\xbc\xf9\x59\xbb\x95\x7d\x7d\xd9\xbb\xed\xe9\x68\x62\x77\xcb\xf1\x06\x49\x34\xbf\x99\x43\xf6\x27\x3f\x19\x8f\x19\x87\xf1\x39\xc5\x33\x60\xcb\x01\xaf\x32\x11\x93\xa0\xd7\xf9\x59\x57\x7c\x17\xe5\x42\xea\xbd\x1e\xa3\xb6\x96\x87\x98\xe9\x2c\xda\x08\x26\x13\x7f\x8c\x8a\xfd\x3e\x9e\x8d\xb8\x8c\x70\x99\xf8\x67\xc2\x1a\x24\xe6\x56\x67\x07\x95\x97\xe6\xcd\xd3\x13\x3b\x9d\xcc\x15\xcf\xe3\xe1\x8f\x46\xe0\x19\x42\xdc\xf4\xc6\x42\xd9\x11\x1d\xf1\xcc\xca\x5e\xe7\xea\x96\x5b\x9b\xe0\x0d\x70\x70\xa9\xba\x35\x1c\x72\x9b\xd7\x53\x21\xf0\x4b\x21\x22\xdd\xc4\x3e\xb7\xbf\xdf\xe2\x68\xd0\x9b\xd4\x2e\x2b\xbf\x63\x71\xd7\x24\xb9\x5d\x1d\x66\xdc\xe5\xa0\xf0\x18\x55\x05\x3b\x2d\xf3\x13\x34\x47\xbf\xa7\xe1\xbf\x23\x90\x2e\x2e\xb8\x07\x79\xeb\xba\xc5\x3d\x28\x23\x4c\x68\x36\xeb\x83\xdc\x1c\xa9\x2b\x75\x7f\xda\xfc\x98\xf6\x7b\xda\x45\xdb\xde\xc0\xb2\xd3\x70\x7c\x85\x95\x26\x90\x45\xef\x21\x79\xb2\x9a\xee\x76\x9d\xe3\x5d\xd3\x52\xb5\xf6\xf4\x04\xa6\x3e\x91\x7e\x31\x6c\xe6\xab\x45\xd5\x89\x26\xb6\xdc\x03\x62\xfb\xf1\xb1\xde\x7e\x4e\xb4\x9d\xc8\x5f\x8b\xed\x4c\x27\xcd\xaf\x5b\x2c\x8b\x9a\xa0\xa9\xdd\x6a\x46\x8d\x87\xa4\x2e\x7b\x95\xa6\x32\x82\x16\xcf\xa0\x25\xac\x58\xb3\x57\x75\x35\x74\xf9\xa3\x91\x1d\x4a\x8c\xc7\x8d\x02\xac\xde\xf3\x50\xba\xbc\xa6\x01\x3b\xed\x1e\x90\xa1\xb5\xcd\x9c\x06\x2a\x03\x1a\xf6\x3c\xe9\x6d\xfc\x29\x40\x15\x05\x59\xcb\xec\xf2\x35\x3c\xf7\x28\x5c\x73\x40\x68\xe8\x32\x31\x0a\x53\x1b\x1b\xcd\xbf\xdc\xdd\xa2\xa2\x2d\xa5\x9c\x8c\x38\x28\xaf\x1d\xc5\x17\xea\xe4\xd7\x6f\x02\x01\x4f\x7e\x6c\x9c\x30\xe3\x5a\xcd\xb9\x96\x6a\x0e\x5b\x4f\x32\x11\xcf\x83\x64\x93\x1f\x2a\xdb\xb4\xc3\x50\x03\x72\x57\x28\xc8\x2f\xb4\x35\x1e\x1c\xa8\xdc\xf9\xfd\xb1\x28\x26\x3c\xc6\xee\x47\x1e\x8d\xe7\xaa\x8e\xcf\x3d\xe6\x16\x1b\x43\x27\xf5\x81\xd4\x3b\xb6\xb4\x11\xa7\x9a\x54\x5a\xc2\xf8\x79\x9e\xc9\x6c\x9c\x67\x02\xf1\xb3\x2e\x84\xd8\xda\x91\x49\x08\x6b\xd0\x16\x49\x8e\x76\x27\x44\xb8\x63\x1d\xbd\x16\x72\xf9\xde\x0b\xf5\xef\xca\xac\xc7\x96\xe4\xc7\xec\xb3\x79\x38\x36\x30\xe9\x9a\xc6\x48\x37\x7d\x3d\x64\x6d\x04\xc0\x36\x94\xee\xb6\x42\x34\x4f\x2a\xab\xd9\x96\xf4\x99\xd3\xf8\x89\x47\xde\x8e\xdd\xe3\x46\xde\x4e\x55\xcf\xec\x8f\xc7\xfc\xb2\x3d\x7c\x77\x04\x4b\x91\x35\x4a\xe5\x4a\xa2\x04\xe2\x34\x1d\x4b\x61\xcd\xbd\x95\x8f\xdc\x8f\xba\xea\x61\x3e\x6b\x66\x7d\x62\x0c\xe7\xbf\x32\x87\x25\xe0\xfd\x4e\xc8\x77\xf4\xf7\xbe\xb6\xde\x8d\x7e\x2d\x68\x3b\x4e\x47\x9b\x6d\x77\x2b\x8a\x50\xa0\x12\xd1\xad\xfb\xe0\x4e\xb4\x73\xb7\x43\x87\x09\x64\x1a\x5f\xa3\xcc\x1b\xe0\x08\x75\x56\x95\x2a\x59\x7c\x10\x32\x49\x19\x5c\x8e\x5d\xc0\x75\xd5\x9f\x84\x60\x52\xd7\x29\x8e\x3d\x04\xd8\x69\x0b\x79\xc4\x02\xd5\xf1\x39\xf2\xcf\xae\x3e\x3a\xad\xd3\x56\xb9\xd1\x59\xe8\xb7\xa1\xce\xa5\xfc\x3f\x08\xf5\x2e\xce\xa5\x1d\x56\xa3\x2e\x66\xba\x08\x9e\xdf\x68\xe9\x3f\x69\x37\xba\x58\xee\x22\xa8\xbb\x4b\x85\x7a\x9a\x3a\x09\xe9\x1d\xe1\x32\x0e\xe9\xb4\xb1\x3c\x5a\x8a\xb5\x30\x94\xcf\x10\xe6\xbd\x25\xbd\xfb\xf9\xc5\xb9\xeb\x34\x14\x0f\xd7\x81\x2d\xc3\xc0\xc8\x2b\xd6\xf8\x06\x7c\xf2\xf7\x21\x78\x58\xef\x88\x79\x6b\xee\x90\x74\x3c\x7c\x22\x57\x3f\x1d\x4f\xe6\xea\x67\xbb\x83\x17\x3a\x9f\xd4\x8d\xda\x6d\x27\x76\xf5\xe3\x0d\x51\x31\x27\x63\x37\x47\x61\x70\xda\x95\x41\xae\x2d\x71\x9c\x05\x6a\xcb\xcc\x3d\xdd\xac\xed\x30\x63\xdb\x67\xab\xfb\x4c\x75\x9a\x25\xf7\x15\x16\x9f\xa5\x45\x7f\x4e\xcf\x63\x6d\xd9\x9e\x06\x44\xd5\x6a\xb3\xe5\x59\x69\x3b\xc2\x29\x0d\x76\x02\x62\x5c\xdb\xd6\x74\x0f\x87\x10\x41\xcf\x01\xb4\x5e\x95\xfc\x33\x61\x2a\xb1\xad\x6f\xf8\xae\x27\x3e\xd8\xc1\x24\x41\x06\x9c\x3f\x73\xf2\x89\x30\x69\xff\xd1\x71\x02\x19\x16\x17\x35\x9e\xf0\x6d\x44\xaf\x0d\xdf\x9e\x40\xfd\x3d\x96\x43\x38\xae\xdf\xe0\xb1\x6c\x26\xe1\x38\x2e\xde\xf4\xf0\xf0\xc1\xeb\x4d\x18\x2e\xeb\x01\x6f\x0b\x6c\xd1\x3a\xdd\xca\xfb\x60\x3d\xfb\xa1\xb5\x35\x5d\x69\xf2\x01\x10\x03\x56\x48\x6e\xe9\x6e\x8b\x8c\x90\x55\xfb\x76\x82\x5d\x37\xdc\x6f\x48\xa3\x8e\x74\x0a\xd1\xea\x31\xf4\x02\xeb\xc4\xad\xb6\x84\x6d\x6f\x4b\x86\x29\x11\xb6\x02\x16\x78\xbe\xa0\x23\x94\x7b\x8d\xe9\xb0\xeb\xbe\xef\xef\xbd\xf5\xdc\xb5\x15\x81\xfb\x21\xfc\xd7\x91\x57\x73\x79\x94\x54\x32\xa3\x02\xfe\x29\x92\x89\xe9\xb7\xff\x78\xc9\x74\xe6\xbf\x47\xaf\x55\x19\x8d\x1e\x29\x81\xea\x81\xed\x20\x81\xba\xef\xe8\xff\x4e\xb2\xca\xf3\x62\x91\xf7\xb4\xf9\x1b\x90\x78\x17\x32\x77\x26\x75\x88\xdc\xf0\x6f\x22\xee\x32\x34\xfb\xa7\x4a\xbb\x6d\xfd\x3f\x52\xd8\x39\x62\x46\x3d\xaa\xd5\xfa\xce\x4e\x58\xb0\xb8\xdc\xdb\x29\xbf\xba\xfe\x16\xc1\x0d\xda\x54\x2b\x3b\x80\x32\x70\x2b\xb1\xed\x85\x2f\x1f\xb5\x58\x0b\x3e\xc6\x73\xfe\x88\xc3\x64\x02\xdf\xfe\x77\xdb\x64\xf9\xde\xea\x0b\xd5\x15\x98\x18\x62\x3a\x54\xdd\x63\x2a\x0a\x60\xf7\x87\xa7\xc7\xae\xd6\x64\xc2\xd8\xb5\x97\xdc\xef\x10\xd5\xaf\xcf\x6a\x4a\xd6\xf9\xd6\x79\xd5\x17\x34\x2a\x93\xbf\xa0\x4d\x13\xf4\xb7\xbf\x0f\x26\x79\x9e\x7b\x27\xaf\x0b\x59\xba\xc5\x13\x6b\xeb\x90\x47\x90\x8d\x08\x7f\xc5\xac\x5d\x92\xa7\xa8\xa4\x05\xd9\xf8\x7a\xdd\x31\xaa\xa1\x55\x30\xe8\x08\xed\xb0\xfa\xb7\x3d\xb4\xc5\xfd\xcf\x0f\x58\xfc\xde\xd9\xdf\x6e\xe0\xe4\x66\x40\xf5\x30\x5b\x6b\xc8\xf4\x96\xe5\x75\xdf\xfe\x30\xdd\x56\x64\xd8\x99\xf3\x21\xc8\x78\x57\x53\x37\x2a\xab\x37\xe4\xdb\x5f\x84\xd4\x1e\x9b\xe7\xad\x78\x6e\x32\xcf\x7b\xf0\xbe\x37\xe6\x9f\x69\xea\x47\xfd\xfa\x85\xdb\xd6\x97\x6c\x46\xf9\x65\x3b\x10\xed\xd4\x69\xac\x7c\xab\x1d\xa6\xdf\xbd\x9f\xa6\x1f\x87\xbb\x9e\x86\x19\x22\x72\xb9\xe8\xb1\x53\x82\x6e\xde\xbd\xb3\x7d\xb5\x31\x78\x43\x08\x02\x08\xae\x63\x8e\x87\x19\x3b\x55\xc3\x68\x81\xe7\x47\xa8\x56\x4a\xb6\x61\xe3\xda\x8f\x5a\x15\x77\x46\xf8\xa7\x24\x16\x83\xf7\x10\x62\x71\x3c\x9e\x9c\x58\xdb\xb0\xe9\xaa\x08\x31\xc5\xae\x88\xf3\x52\xbe\x5a\xa6\x6d\x59\xd9\x10\xee\xfe\x1a\x67\x15\x1a\xc2\x86\xff\x6b\x25\xe8\x97\x49\x30\x65\x3a\xf7\x12\x5e\x55\x94\x2c\x63\x8a\x13\x38\xff\xf8\x91\x8d\x7a\x86\xef\x70\x3e\x87\x12\xe7\x49\x9d\x32\xf3\x38\xfa\xff\xa2\x23\x57\x95\x0a\x3e\x83\x85\x32\xf9\x14\x01\x7f\xaf\x6a\x22\x31\xf2\xa4\x53\x69\x27\x5b\xf3\xb8\x0a\x3f\x2c\xf0\x11\xcf\x48\xb1\x64\x13\x40\xd5\xf0\xbf\x4d\xfb\x3d\x38\x94\x5d\x88\x77\x13\x86\xfc\x4a\xf7\xc6\x28\x39\x5a\xdd\x0d\xc2\xc9\x7a\x1d\x02\xf3\x93\xef\x3b\x72\x6b\x52\x57\x3c\xf2\x46\x48\x91\x96\x43\xe0\xc9\x8c\xd9\xaf\x4e\x98\x75\x8a\x4b\x1a\x33\xf2\x4d\x9a\x4a\x30\x02\xad\xb5\x15\x4f\xfb\x2e\xa6\x8b\x28\x9e\x96\x7d\xd5\x72\xc0\xf4\xc4\xef\x6c\xb2\xd6\x0e\x63\xb2\x8a\x13\x4c\x37\xf6\x9b\x4e\xa9\xb4\x84\x6f\x21\x6b\xf0\x05\x9f\xb4\x88\xe7\x73\x9c\xcf\x7d\x0f\x2e\x89\x18\x00\xf5\x64\x89\xe3\xb3\x14\xfc\xa0\x71\x65\xdb\xfb\x55\x6a\x94\xc2\x85\xe3\xed\x89\x4b\x78\x36\xbc\xc6\x47\x2a\x32\xbc\xee\x82\x42\xdb\x63\x38\xa6\x03\x73\x14\xc4\x36\x4a\x32\x8c\x72\xaa\x72\x51\x98\xa8\x3f\xbc\x7b\xd3\xd7\xf8\xb8\xde\xbb\xb0\x32\xdb\x84\x75\x4e\x6e\x18\xad\x4d\x7f\xd2\x05\xc7\x0d\x42\x2b\x27\x54\x91\x67\xde\xcb\x37\xe2\xb1\xba\xf5\x02\x67\xe2\x66\xc4\x7c\x5c\xae\xf1\xca\xdc\xee\x95\xde\xcc\xab\xf2\x60\xe6\xfa\x8d\xba\xc7\xbc\x2d\xe4\x78\x3b\xed\x2c\x8d\x8f\xb9\xd6\xd0\xa6\x84\xd3\xf6\x7b\x0d\xdb\xee\xac\xfa\x9b\xeb\x5c\xf9\x30\x08\x3a\x63\xf9\xf6\x57\xbb\x95\x14\xd4\x4a\xa2\x4c\xa0\x77\xd4\xf2\x6a\x8c\xce\x10\xfc\x2a\x56\x8b\xf0\x79\xe0\x33\x52\xad\xe7\x0a\x5d\x42\xd9\x93\x55\x4b\xdd\x17\x22\x9c\xc4\x2b\x54\x6b\x73\x0c\xe3\xcf\x4f\x8b\x02\x95\x0b\x92\x79\xaf\x27\xf8\xb4\x68\x7e\xf9\x33\x98\x95\xa3\x46\xe0\xec\x89\x11\x68\xce\x14\xed\x08\x78\x3a\x7c\x01\xdf\x85\x82\x70\x3f\x09\x73\x9c\xb8\x74\xc4\xda\xd7\xf8\xe3\x12\x32\x54\x96\x40\x17\x71\x0e\xdf\xc1\x0a\xbb\x8e\x82\x5d\x6e\x26\x9f\xb3\x0e\xb6\xe8\x85\xbe\xa5\xea\x75\x37\x05\xf3\x41\xda\x21\x0c\x97\xae\x16\xc9\x59\xbd\x45\xe7\xb2\x2e\x2e\xcb\xc0\x37\x79\x6d\x90\xe7\x86\xe1\xf7\x40\x19\x13\x0a\x01\xc7\x28\xa5\x42\xb5\x53\xf1\x40\xb8\xb8\x92\x97\x13\x7b\x87\xea\xb4\x1a\x76\x3e\xf8\x37\xeb\x81\xc7\x1e\x75\x17\xb1\xfe\x39\x6c\x5d\x7b\xde\xb8\x12\x8d\x7a\x8d\x67\x5e\x85\x5e\x34\xaf\x66\x06\x37\x27\xed\xb5\xf9\x07\x9c\xeb\x79\x2a\xb7\x46\xab\x72\x9f\xcb\x6e\xd4\x2f\xe7\x13\x2e\x99\x7a\x97\x06\x6e\x38\xd5\x56\x1e\x0d\xbf\x9a\xc2\xb8\x14\x2e\x94\xba\x40\x7b\xcb\xf0\x89\xb9\x7a\x7c\x70\x5d\x2e\x70\x4e\x81\x9d\x92\x19\x79\xc4\x73\x39\xca\x11\xd3\x3e\x9d\x9e\xcb\xe2\x6c\x97\x08\xbf\x30\x1f\x4c\x25\xb2\x33\x63\xfe\x4a\xc8\x92\x9d\x6f\xc4\x26\xd6\xe2\xcf\xd1\x7f\x76\x37\x19\x2e\x79\x36\x57\x3e\x3d\x02\x91\x96\x16\x26\xa3\xa0\x48\x3c\xee\xc7\x67\x12\x95\x17\x47\x97\xd1\x2a\x9e\xa3\xff\xdd\xd9\xea\xd8\x51\x9e\x69\x37\x0e\x02\x33\x1f\xb8\x72\xf0\xc4\x33\x7f\x5d\xcf\x3c\x5b\xe2\xbf\xfd\xc4\x3f\xd5\x3c\xeb\x2b\x78\xb7\x39\x83\x9a\x4b\xea\xc3\x5c\xe8\x00\x17\x06\xd0\x48\x89\x9d\x6e\x1b\x74\x64\x8e\x1b\xc1\x1c\x37\x61\xe6\xb8\xf9\x87\x30\xc7\x4d\xcd\x1c\x28\x4f\xbb\xa6\x87\xf8\x4d\x58\x83\xdb\x87\x04\xcd\xdb\xaa\x81\x31\x35\x5b\xee\x8b\x80\xc9\x08\x6f\xf2\xb4\x1f\x38\x96\xb7\x30\x42\xab\x75\xbc\xee\xe5\xb1\x5a\x91\xd1\xe3\x83\x99\xcd\xfc\x6d\xcb\xde\xcb\x1f\x1f\xfe\x47\x6f\xbd\xac\x53\x9c\xcf\xfd\x37\x8f\x83\x13\xeb\x6e\xcc\xea\xe5\xe4\x67\xdb\x37\x66\x9d\x44\xff\x9a\xfb\x32\xcf\x2c\xd0\xc9\x0f\x55\x63\x31\x36\x93\x44\x90\x1c\x9a\xc4\x2f\xff\xd8\x45\xdb\x02\x53\x89\x6d\x01\x50\x65\xa5\xe1\x17\x8c\xbb\x36\x6a\xf2\x2c\xb6\x63\x02\x81\xdd\xa5\x5d\x90\x6c\x8b\x39\xf1\xfd\xec\xae\x7f\xa8\x1f\x5b\x0f\xd9\xb2\x85\xd5\x58\x6a\x8b\xc6\x13\x98\xe9\xfb\x19\x8f\xe1\xe0\x00\xbe\xc7\x77\x9c\xe1\xd7\xfc\x45\x54\x48\xaa\xa2\x24\x6c\x91\x95\x15\x82\x05\xa5\xab\xf2\x64\x3c\x4e\x48\x8a\xa2\x39\x21\xf3\x4c\x3c\x7f\xb9\x1a\x27\x8b\x82\x2c\x71\xb5\x1c\xf3\x8a\xe5\x38\x45\x34\xc6\xd9\x4b\x9c\x4e\x9e\xff\xfe\xbf\x9f\x7f\xdb\x91\x3e\xc2\xbf\x22\x1e\x15\x7e\x8b\x66\x14\x0e\x27\xa1\x78\x91\x0e\x8d\x47\x13\xef\x55\x12\xdf\xb0\xb7\xd7\xda\xf1\xf4\x63\xbf\xa5\xde\x1d\x6c\xd8\x76\xc5\x60\x4e\x7d\xf6\xd6\x6e\x80\xbd\x7b\x0b\x63\x12\xce\x61\xdb\x90\xf5\x6f\x2f\xe1\x2f\x3b\x6b\x39\xed\x2e\x77\x2e\xec\x3a\xab\xa2\x42\x2d\x90\x0b\x20\xa8\x90\x74\xd0\xd5\x4c\x75\xb2\x65\xe9\x3d\x4a\x73\xdc\x61\xae\x18\x09\x3a\xf8\xc8\x9d\x7b\xf1\xdb\x89\xbb\x43\x9c\xca\x16\x8d\xab\x93\xa6\xf5\x28\x0d\x6b\x07\x82\x05\xc2\x8d\xf4\x90\xab\x7f\xfa\x56\xf4\x24\x7a\xa0\xff\xae\xa6\xca\x5a\x23\xf6\x7d\x36\xad\x24\xd7\xf2\x06\xb9\x33\xbb\x75\xd1\x04\x75\xb1\xf6\x11\x6d\x95\x96\x53\x8f\x9d\x36\x0c\xae\x5d\x4a\x76\x5b\x21\xe6\x97\xa0\xde\x6b\x6f\xda\xad\xda\xaf\x7e\x09\x8f\x1a\x01\xf1\xa7\x6e\xbd\x0f\x5a\x68\x81\xe7\x9e\x24\x7f\x62\xbe\x5b\x56\x1f\xcd\xc7\xea\x0b\xcf\x6c\xa2\xd9\xcc\x9b\x86\x36\x8d\x74\xac\x42\x01\x9b\xda\xca\xb1\x40\x9a\x17\x30\xbd\xb0\xfd\xd7\x13\xbd\x2e\x83\x47\x79\x63\x76\x49\x93\xe0\x73\x85\x9c\x3e\x1a\x9a\xff\xce\x9e\xfd\x44\x61\x4d\xef\x33\x18\x75\x08\x2d\x0c\xdc\xea\xee\x76\x5f\xb0\x35\xbc\xad\x0d\x72\xeb\x8d\xc1\xf0\xb2\x61\x9b\xca\x7a\x81\x50\xf6\x54\x11\x58\xe6\x52\x6f\xe0\x7b\xf7\x2d\xd7\x50\x8e\xa2\x14\x65\x34\xfe\x1f\x1f\x73\xda\xc9\xb5\xc0\x1f\x8a\xa2\x40\xb4\x87\x91\xb7\x86\x4d\x6e\xb9\xc6\xd6\x1a\xd4\x68\x61\xd4\x66\x17\xdb\x1a\xb5\x95\x64\xa4\x44\xf2\xf6\x96\x33\x41\x1d\x0f\xf4\xf5\x09\xd4\xdd\x0c\x1e\x10\xb5\xc6\x31\xda\x35\x6c\xcd\x13\xa0\x78\x6f\x3f\x43\xae\x6c\x00\xac\x83\x78\x9a\xb9\xec\x21\x32\x11\x6f\x48\xc5\x7d\x35\x4d\x4a\x40\x1e\x51\x50\xa0\x0c\xc5\x25\x02\x52\x51\x9e\x7f\x53\x25\x2c\x16\xd9\x3c\x19\xce\x7c\x4b\x65\xe7\x1f\x64\x43\x65\x32\x4c\xce\x91\x48\xc2\x8c\x4b\x99\x1b\xb1\x21\x1d\xeb\x43\x2a\x60\xb0\xe0\xe9\x81\x50\xaa\x4e\xe6\x98\xa2\x25\x90\x02\x98\xf2\x51\xd5\x8f\x74\xb7\x91\xd8\xb3\x46\x82\x26\x02\xaf\x06\xd0\x5d\x1b\xaa\xf8\x9b\x41\x3b\x29\x43\x6c\x3c\xbb\x36\x91\x49\xf0\xc3\xda\x93\xce\x85\x7e\x4d\xbe\xd5\x18\xd7\xc2\xc4\x75\xfb\x07\x28\xd7\x9e\x89\x08\x68\xd8\xff\x66\xd3\x00\xfb\xfb\x0d\xcd\x9e\xcc\xd8\xdb\x78\x26\x5b\xef\x1b\x6c\x0b\x4a\x76\x67\xcb\x3f\x7f\xe6\x97\xa0\x90\x94\x3d\x99\xa1\x30\x5b\x04\xa4\x21\x7a\x9e\x39\x87\xa4\x9d\xee\xb9\x9c\x0b\x91\xe8\xdd\xc3\x8d\x2c\xe6\xd2\xec\xf1\x89\xac\xea\x6b\x37\x4c\xee\x7c\x54\xa5\xa7\x6e\x33\xf5\x8b\xbc\x27\xbb\x1d\x42\x0d\xa2\xbe\x54\x06\xfc\xd6\x18\x9e\x01\x8f\xd8\x15\xb9\x12\x49\x01\xf5\xc3\xc1\xa0\xe8\xc7\x93\xc2\x72\x93\xa3\x10\x98\x4c\xc2\xda\xe9\x96\xe1\x40\x66\xcb\x2c\x01\xe7\x32\xb2\xa0\x40\xcb\x98\x15\xf0\x50\x2e\xd6\x48\xbe\x80\xa0\x35\xf2\x61\xc2\x4d\xab\x46\xdf\xca\xeb\x9e\x6d\x94\x9b\x9e\xe7\xc6\x35\xb3\x73\xc2\xc1\xd8\x9c\x3d\x67\xf2\x34\xd3\x5f\x6b\xaa\xc9\xf1\x58\xcb\xc2\x9b\x13\x0a\x55\xc9\xe4\xbf\x6c\x51\xbb\xfd\x7f\xc7\xaf\x7a\x40\x0c\xd7\x7c\x3b\x84\x84\x64\x6c\x43\xc4\x24\x6f\xc9\x49\x66\xab\xc3\xfa\x75\x91\xa0\xfd\xd9\xcf\x79\x64\x36\xd3\x2e\xb9\x08\xd5\x95\x89\xa8\xce\xa7\xb0\x00\x80\xa4\x2a\x29\x59\xee\x9c\xa0\x90\x9f\x77\xe6\x15\x4e\x6b\x0e\x44\x99\x95\x41\x32\x9b\x8f\x2a\xec\x1c\xe8\x53\xc4\xdf\x70\x95\x0f\x58\x66\xf3\xd7\x31\x8d\x2f\x38\x24\xeb\xb9\xc8\x1a\xaa\x13\xeb\xe1\x02\x36\xa3\x97\x7e\xc9\xa7\x38\x4f\x79\xea\x1d\xbe\x9d\x97\x10\xa7\x29\x4a\x61\xba\x11\xc9\xc3\xff\x6c\x71\x73\x0b\xad\xf8\x0a\x8f\xb2\x39\x5d\xf6\xac\x08\x92\xd7\x58\xb0\x2c\xcf\x8f\xac\x41\x65\x0c\x53\x65\xa8\x74\xcf\x46\x37\x68\x03\x38\x6f\x46\xfe\x4e\x54\x0c\x5f\x44\x91\x90\x2e\x6e\xd0\xe6\x32\xac\xc1\xea\xb5\x6a\x71\xeb\x24\x64\xf0\x91\x4a\x98\xc5\xf5\xac\x00\x76\xb8\x44\x97\x04\x8c\x06\x34\xf3\x6b\x27\x55\x73\x36\x6b\x8c\x5b\x16\x43\xb7\x9f\xe5\x3c\x4f\x43\x90\xfc\x51\xcd\xb5\x97\x25\xbc\x6a\x29\xdf\x03\x1f\x19\xe6\x76\x8b\x4b\x3c\xcd\xba\x1f\xf6\xbc\x28\x7b\x34\x72\x95\x32\x83\x2b\xe5\xd8\xa0\x43\xa7\xf8\x24\xff\x7b\x37\x5b\xc6\x6e\x72\xa2\x38\x2d\xac\xe2\x02\xe5\xf4\x27\x92\x22\x85\xfb\x02\x67\xa9\x01\xa5\x93\x41\xcc\x48\x34\xd1\x3a\xe0\xad\x19\x26\x5a\x5b\x87\x10\xee\xd8\x77\xe7\xb1\xb8\xea\x45\x4d\xbd\x6e\x6a\x06\x57\x30\x78\xa2\x92\x0e\xd2\xba\x7e\x41\xa8\x60\x93\xe6\x0d\xe5\xb2\x93\xac\xa8\x84\x3a\xa0\x69\x59\x4a\x58\xe9\x02\xce\xbe\x93\x40\x56\x3c\xb5\x99\x3e\x3a\x71\x86\xcf\x76\x48\x8b\xed\xb2\x9f\x84\x11\xd8\x54\x42\x59\xa7\xc5\x8e\xd4\xcb\xe6\x3d\x38\x34\x37\x99\xa8\xc2\xa9\x2f\x63\x85\xb9\x13\xb1\x7d\x08\x26\x90\xa3\xb5\xd4\x30\x8d\x21\xba\xad\x51\x66\xdd\x24\x91\x08\x0e\x19\x26\x5d\xcd\x09\xe1\xb7\xa6\x4c\xe4\x82\xd4\xb8\x8c\x70\x8e\xbd\xb1\x36\x16\x23\x78\x3b\xb1\x1e\x93\x32\x26\x7b\x11\x8b\x88\x47\x06\x1f\xc7\x14\xa5\xb0\x2a\xc8\x0a\x15\xd9\x66\xa7\xdb\x7f\x2d\x58\x3c\xbe\xf7\xfa\x60\xc0\x4f\x7b\xf7\xc3\x2f\x7b\xd1\x98\x51\x86\xad\xa9\xbd\x93\xe3\xfb\xcb\xfb\xe1\x97\xfb\xe1\xc5\xf3\xcb\x41\xff\xf9\x80\x57\xfa\xbf\x01\x00\x00\xff\xff\xd8\x3b\x43\xfb\x92\xde\x00\x00"),
-		},
-		"/js/lightgallery.min.js": &vfsgen۰CompressedFileInfo{
-			name:             "lightgallery.min.js",
-			modTime:          time.Date(2019, 3, 25, 11, 30, 12, 452710423, time.UTC),
-			uncompressedSize: 24552,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7c\x7b\x93\xdb\x36\xf2\xe0\xff\xfb\x29\x28\xdc\x2d\x4d\x44\x10\x87\x72\xce\x7b\x15\x6a\x38\x3a\xc7\x76\x76\x53\x6b\xc7\xa9\x8d\xb3\xb9\x2b\x8d\xce\x45\x91\x90\x84\x18\x22\xb4\x00\x38\xe3\xb1\xc4\xef\x7e\x05\x80\x0f\x90\x22\x35\x1a\x6f\xf6\x7e\xff\xcc\x88\x78\x34\x1a\x8d\x46\xbf\xd0\xc0\xd5\x37\xdf\x8c\xfe\xe4\x7c\xe3\x50\xb2\xd9\xca\x4d\x4c\x29\xe6\x0f\xfe\xef\xc2\x39\x3a\x53\x7f\xea\x7f\xeb\x1c\x9d\x1f\xf0\x8a\xe7\x31\x7f\x70\xa6\x53\xb9\x75\x9e\x07\xd3\xef\x54\xfb\xad\x94\xfb\xf0\xea\x4a\xc4\xc9\x96\x64\xc9\x96\x31\x9a\x73\x7f\x43\xe4\x36\x5f\xf9\x84\x5d\x75\xc0\x5d\xa9\x2e\xaf\xd8\xfe\x81\xab\x72\xc7\x4b\xa0\x02\xf4\x17\xe7\x17\xdd\xdd\xf9\x69\xe6\xa8\x06\xff\x8b\x92\x04\x67\x02\x3b\x7f\xfd\xf9\xed\xdd\xb7\xaa\xe8\xea\x4f\xa3\x75\x9e\x25\x92\xb0\xcc\xc3\xf0\x40\xd6\x1e\x60\xab\xdf\x71\x22\x41\x14\xc9\x87\x3d\x66\x6b\x07\x7f\xde\x33\x2e\x85\xeb\x82\x3c\x4b\xf1\x9a\x64\x38\x05\xa3\xaa\x72\xc7\xd2\x9c\x62\x68\xfe\xf9\x65\xd3\x08\x7b\x70\x86\xa9\xc0\x8e\x82\x57\xc1\x6f\x20\x1a\x28\xae\x6b\xfe\xfb\xf1\x2e\x85\xe6\xa7\xb7\x58\x22\x6c\xba\x1e\xee\x62\xee\xc8\x99\x8c\xfa\x46\xbd\x27\x59\xca\xee\xe7\xe6\x5f\xd8\xd7\x62\x43\xd9\x2a\xa6\x73\xf3\xaf\xb7\x85\xc0\x74\x3d\x57\x7f\x42\xb9\x25\x02\x49\xff\xad\x45\x51\x35\x83\xa2\xf0\x6a\xd2\x40\x8d\x0e\x46\x12\x89\x19\xc7\x32\xe7\x99\x63\xd5\x55\x3f\x1d\xec\x49\x24\x10\xb5\x4a\x88\xc7\x51\xaa\xe9\x3a\x12\x0b\xbe\x34\xbf\xa4\xfe\xa5\x20\xc6\x51\x0f\x79\x38\xfe\x57\x4e\x38\x76\xdd\xf2\xc7\x4c\xf5\x49\x5d\x37\x86\xe5\xd0\xb1\xc7\xd1\x28\x80\xaa\x9c\x55\x65\xac\x2c\x53\x50\xb3\x28\xc3\xf7\xce\x1b\xce\x19\xf7\xc0\xab\x38\xcb\x98\x74\xd6\x24\x4b\xcb\xd5\x72\x9e\x81\x31\x1f\x83\x67\x00\xce\xe4\x96\xb3\x7b\x27\xf3\x13\x96\xe2\x08\xbc\x7b\xff\xfa\xd7\xb7\x6f\x3e\xfe\xf4\xfe\xc3\xc7\x1f\xde\xff\xfa\xd3\x6b\x80\xb2\x42\xc1\xcb\x23\x85\x7b\x74\x28\x97\x37\x3c\x14\xc5\x4c\xcd\x61\x11\x2c\xfd\x24\xa6\xd4\xcb\xab\x95\x47\x36\x33\x95\x98\x11\x4f\xb7\x9d\x2e\x17\x78\x79\x3c\x62\x58\xa0\x1c\x35\x1d\x34\x4d\x11\x85\x45\xd9\x5a\x8d\x54\x55\x16\x6b\xc6\x3d\x85\x00\xbb\x84\x4c\x88\x47\xc1\x8c\x5f\x53\x9f\xe2\x6c\x23\xb7\x33\x3e\x1e\x43\xe2\x51\x45\xeb\x6a\xcd\x48\x35\x0a\x2e\x3c\xe8\x1d\xa6\xe1\xa2\xc6\xb7\x5c\xb8\x91\x5d\x60\xf6\xc3\xe9\xc8\xd8\x75\x0d\xd7\x62\x6f\x01\x4a\x5c\xc1\x12\x89\x86\xe9\xef\x18\x49\x9d\x60\x14\x45\x14\x0a\x8f\x5a\x1c\x4d\xda\x54\x14\x1e\xa9\x26\x0b\x91\xf4\xe9\xe6\x57\x49\xa8\x88\xea\xc2\xa2\xf0\x34\x77\xda\x64\x05\xb9\xc0\x8e\x90\x9c\x24\x12\xcc\xde\xeb\xcd\xea\x1b\xee\xfe\x99\xb3\x3d\xe6\xf2\xc1\xc3\x08\x7c\xfc\x88\xc5\x3b\xbd\xdc\x00\x1d\xee\x62\x9a\xe3\x70\x14\x14\x86\x3f\x64\x74\xd8\x60\xf9\x52\x4a\x4e\x56\xb9\xc4\x61\x9b\x7f\xeb\x85\x93\x0b\xb1\x2c\x90\x18\x6e\xa9\x08\xa6\x1a\x45\xb4\x40\xf7\x3c\xde\x9f\x00\x22\x6b\x4f\x1a\x46\xa7\x51\xca\x92\x7c\x87\x33\xe9\x27\x1c\xc7\x12\xbf\xa1\x58\x7d\x79\x20\x25\x77\x00\xce\xa8\x9f\xd0\x58\x88\x9f\xe2\x1d\x8e\xd4\x66\xdc\xc7\x1c\x67\xf2\x27\x96\x62\x9f\x64\x02\x73\xf9\x3d\x5e\x33\x8e\x3d\x8a\x24\x6c\x57\x73\xbc\x63\x77\xf8\xd5\x96\xd0\xd4\x93\x10\x51\x3f\xde\xef\x71\x96\x56\x05\x45\x81\xe2\x34\x7d\xa5\x80\x9f\xa0\x27\x5d\xd7\x93\x66\xe0\xb7\x44\xc8\xb9\xf5\xdb\x8f\xd3\xd4\x13\x30\x94\x0d\x5e\xe3\x08\x38\x60\x2c\x60\x81\xca\x21\x9f\x0c\xd3\xf4\xeb\x80\x8d\xac\xdf\x3e\xc7\x7b\x1a\x27\xd8\x53\xfb\xf7\x1f\x78\xf3\xe6\xf3\xde\x03\xde\xff\x3d\xde\xde\xae\x20\x18\x0b\x5f\xec\x29\x91\x1e\x70\x00\xf4\x7f\x67\x24\xf3\xc0\x11\xc0\x31\xf0\x6e\x6f\x57\xc7\xff\x0e\x01\x02\x1b\x02\x20\x52\xd5\xb0\x40\xdb\x58\xf4\x63\x58\xad\xee\x00\x92\x09\xcb\x64\x4c\x32\xa1\xd0\xec\xa0\xe1\x28\x24\xc6\xc0\x73\x9a\xd1\x7c\x89\x85\xf4\xac\x29\x40\xcd\x31\xff\xc4\x59\xca\x78\x1f\xbb\x68\xf2\x08\xf9\x40\xf1\x42\xf8\xc9\x36\xe6\x2f\xa5\x17\x40\x5f\xb2\xb7\xec\x1e\xf3\x57\xb1\xc0\x1e\x54\x33\x55\x9a\xca\x9b\xc2\x65\x44\x51\xd5\x1e\xdc\xe3\xd5\x27\x22\xc1\x58\xb4\x4a\x77\xec\xcb\x49\x91\xe8\x96\x30\x53\x00\x0b\x24\x39\xd9\x6c\x70\x17\xb7\x8a\x4b\x63\xbe\xd1\x5c\x2a\x4a\x29\x72\xf3\xdc\x75\xeb\xcd\x5c\x57\x2e\x9e\x2f\xe7\xf6\x47\x98\xe5\x94\xce\x1a\x6e\x27\x5a\x00\xbf\xca\x85\x64\xbb\x37\x77\x8a\xcd\x05\x3a\xa4\x58\xc6\x84\x86\xb4\x80\x33\xe9\xa7\x44\xec\x63\x99\x6c\x4d\x2d\x51\x6c\xaa\xa8\x8f\x33\xcc\xc3\x43\x4e\xd2\x30\x28\x10\xcb\x6c\x24\x05\xa2\x88\x18\xf0\x2c\x52\x42\x61\x26\x5c\x97\xda\x2c\xb1\x66\xfc\x4d\x9c\x6c\x3d\x5b\x58\x98\x59\x31\xdf\xde\xef\x9e\x40\x80\x6e\x26\x58\x0d\x3d\xc9\x49\x0a\xe0\xf1\x08\xc0\x4c\x69\x40\x83\x81\x9f\x93\x74\x3c\x46\x74\x1c\x01\x17\x8c\xdb\xe5\x88\xf9\xe2\x1c\x2c\x44\x21\x6a\x7a\x2c\x70\xa7\xfb\x32\x22\x48\xa8\xcd\xa5\x27\x5e\xd5\x78\xb8\x9a\x87\x0f\xe0\x22\x58\x22\x82\x46\x53\x58\xc0\x02\xb1\xf5\xba\x43\x04\x2d\x55\x44\x45\x67\x45\x88\x47\x26\xa7\xd6\x85\xc0\x03\x89\x48\x35\x88\x0b\xe0\xac\x51\x2d\xc1\x8c\x5d\x93\x4a\x69\x30\xa5\x34\xd6\x1e\x59\xb0\x52\x43\xf3\x88\x8e\xd5\x97\x82\x02\x7c\x10\x45\x11\xf7\x45\xbe\x52\xf2\x37\xdb\x78\x01\x9a\x42\x58\x81\x4a\x1d\xa2\xb6\x55\x35\x29\x68\xcd\x7c\x1b\x8b\xf7\xf7\x59\x2d\x9f\x53\xe8\xba\xa9\x35\x65\x9f\x64\x29\xfe\xfc\x7e\xed\x71\x9b\x0e\xd3\x25\xbc\x99\x4c\x5d\xd7\x13\xa5\xe4\x68\xd3\x2c\xed\xd0\xcc\xa2\x7a\xba\x84\x48\x53\xe6\xfc\x52\x5d\x40\xbc\x5a\x22\x29\x4e\x50\x74\x40\x00\x40\x88\x52\x4c\xb1\xc4\x4e\x7b\x48\xa3\xfd\xfa\x91\xe5\xc3\xc8\xf2\xff\xff\xc8\xf2\x65\x51\x14\x05\xda\xc7\x3c\xde\xb5\x04\x41\x2d\x1d\x4b\xbd\xfa\x09\x3f\x08\x4f\x42\x7f\x17\xef\xbd\x1e\xd3\x06\x67\xca\x70\xfa\xf5\x1f\x3f\xbe\x62\xbb\x3d\xcb\xd4\x56\xc6\x70\x0c\x22\x30\xee\xa9\x91\x0b\xbc\x84\x45\x25\xb9\x5d\x00\x8b\x62\x86\x95\xe6\x8e\x73\x2a\x23\xa9\xb8\xfd\x50\x2c\xd1\xf3\x73\x46\x89\x50\x22\xe0\x02\xa3\xc4\xbf\xa2\x9b\x49\xae\x6c\x09\xb0\x44\xa4\xd7\x2e\x21\x9e\x5a\x8d\xa6\x1d\xb4\xac\x14\xd6\xb6\x52\x88\x27\x2a\xd3\x04\x22\xe1\xdb\xae\x47\xc4\x2e\x36\x55\x6a\x3a\x4b\x9b\x82\x0a\xeb\xc6\x56\x99\xe3\xf0\x50\x52\x24\xc4\x45\x51\x77\x11\x1e\x46\xd2\xd8\x13\x8a\x0d\x30\x8d\x70\xc9\x33\x11\xf1\x0e\x05\x62\xda\x2c\xd0\x05\x7e\xfa\x90\xc5\x3b\x92\x74\xfc\x95\xa8\x5d\xfb\x86\xba\x6e\xb7\x44\xa9\x3e\x21\x79\x9e\x48\xc6\xa3\x28\x7a\xc9\x79\xfc\xe0\xba\xa3\x93\x66\x46\x54\x40\x6d\x3b\x83\xdf\xb6\x38\x73\x72\x41\xb2\x8d\x53\xb6\x50\x56\x36\x46\xce\x03\xcb\x9d\x5d\x2e\xa4\x13\x53\xc1\x4a\x97\xc7\xa9\x81\x38\xb1\x70\xe2\xcc\xd1\x63\xf8\xa0\xb2\x4f\xf5\x50\xc6\x48\x17\xd1\xa1\x30\x33\xa2\x7f\x35\xa4\x7e\x9f\x45\xa3\x69\x59\xb4\xf9\x3e\x17\x0f\xf5\xe7\x96\xa4\xf8\xfb\x98\x4b\xb2\xc3\x2c\x97\x75\x31\x11\x1f\x58\x9e\x6c\x23\xc0\x32\xa9\x7e\x08\x19\x73\x09\x48\xe6\xd4\xa6\x58\xf5\xa3\x34\xc6\x2a\x0a\x0a\x4a\x52\xfc\x26\x4b\x5f\x66\x64\x17\x4b\x46\x32\xa5\xb4\x4d\x95\x1a\xea\x15\xcb\x24\x67\xf4\x7d\xf6\x26\x4b\xa3\xd1\xb4\x24\x3c\x91\x78\x27\xa2\xc5\xb2\xb3\x0c\x73\xab\xb2\x4b\xc9\x10\xa8\x12\x25\x53\xab\x81\x31\xc5\x8a\xfa\x56\x27\x7f\x9f\x8b\x6d\xb5\xea\x30\x04\xd6\x52\xb6\x5b\x57\xdf\xbf\x11\xb9\x25\x99\x3d\x6c\x3d\xdd\x7f\xe5\x98\x3f\xfc\x52\xf6\xf2\x7a\x7a\xc1\x76\x93\x97\x94\x7a\x9d\xb1\x60\xd8\x9d\x0f\xa6\x5f\xd7\x29\x51\x06\x2a\xc7\x99\xa1\xd7\xc7\x8f\x1f\x35\xd5\x23\x50\x4a\x3a\x96\x4b\xcc\xeb\x2f\x92\x11\xe9\x19\x42\x17\x46\xa5\xab\x4d\x84\x48\x54\xca\xa9\x58\x08\xb2\xc9\x8e\x47\x7b\xf3\x55\x6a\x49\x46\xd3\x99\xbc\xee\x1a\x36\x33\x39\x1e\x1b\x0d\x27\x2c\xbb\x46\x2e\x6b\xc5\x48\x95\x36\x13\xb0\x1c\x60\xcf\x99\x64\x4a\xd2\x74\x54\x99\xf1\xff\x94\x94\x72\x5d\x0f\x2f\xe8\x32\x12\x0b\xba\x84\x8d\x9f\x35\x1b\xf5\x3b\xca\x7a\x3f\xcb\x48\x1e\x8f\x87\x55\xbe\x5a\x51\x2c\xc2\xd1\x14\x25\x71\x96\x60\x1a\xaf\x28\x56\x5f\xa5\xc5\x64\x84\x56\x31\x33\xc8\x76\xfd\x08\x6d\x40\x01\xcb\xd6\x02\xb5\xbb\x67\xe8\x66\x9b\x61\x18\x49\xbf\x1c\x0e\x49\xbf\x19\x0d\x29\x17\x4a\x0d\x06\x91\x28\xfa\xe5\xab\x09\x36\xf8\x16\xb4\xd2\xf5\x1e\x4d\x67\xb8\xa1\x4f\x54\xb6\xd3\x2d\x9a\x62\x74\xda\x3d\x52\x4e\x68\x55\xae\x65\x70\x44\x2b\x75\x50\x15\xd3\xcd\xeb\x58\xc6\x51\x65\x11\xd6\xa5\xef\x6a\x19\x31\x2b\xe5\xb5\x12\x3a\xa1\xd2\x87\x9a\x8d\x00\x4a\x84\x78\x13\x2b\xb1\x14\x02\x1c\x0b\x0c\x10\x2e\xbf\x28\xc9\x70\xcc\x01\x12\x7b\x8c\xd3\xf0\x2f\x41\x80\xb6\x58\x09\xf4\x10\x4c\x83\xe0\xcf\x00\xdd\x93\x54\x6e\xab\x8f\xda\x75\x02\x00\x69\xf1\x51\x7e\xa9\x71\xd4\xe7\xe4\x0b\x63\x3b\x80\x56\x71\xf2\x29\xe5\x6c\xff\x3a\xe7\xb1\xa2\x5a\x38\x7d\x11\xa0\x52\x2a\x89\xd7\x98\xc6\x0f\xe1\x5f\xf0\xb7\x28\x17\xf8\x2d\x5e\x4b\xbd\xd0\x94\x09\xb3\xcc\x01\xa2\x8c\xed\xd5\x7f\x2c\x92\xbf\xe3\x07\xf5\xeb\x13\x7e\xf8\x99\x63\x21\xd4\xef\xc4\x48\x1b\xfd\xfb\x44\x30\xa9\xc2\xae\x48\x52\xe0\x77\x2c\x17\xf8\x7e\x8b\x31\x55\x5f\x1b\x2c\x5f\xc5\x7b\x85\xd8\x0f\x9c\xed\x3e\x10\x49\xf1\x7b\xfe\x92\x4a\xd5\xdb\x78\x8b\xbf\xe4\xab\xbf\xc9\x1d\xfd\xc0\x42\xe0\xab\xb9\xe5\xab\xc9\x56\xee\x28\x40\xc2\x54\x54\x9b\xfb\x1f\x98\xc6\x92\xdc\x69\xee\xdc\x73\x4c\x59\x9c\x86\x53\x24\xb6\xec\xfe\xe5\x5a\x62\xfe\x56\x7d\x2b\x3c\xcb\xe6\x9a\x6c\x96\x7c\x51\xdf\x19\xfe\x2c\x15\x48\xf5\x7b\xcf\xf1\x5d\xf5\x5b\x9b\x80\x0a\x2e\x59\xf3\x78\x87\xdf\xc5\x9f\x7f\xb3\x57\x22\x65\xf7\x19\x2d\xc1\x27\x2c\xcf\x24\xe6\x0d\xfa\xaf\x4c\x41\x85\xbe\x64\x8c\xae\xf4\x1a\xdf\x93\x3d\xfe\xb0\xe5\x58\x6c\x19\x4d\xc3\x17\x01\xc2\x99\xa2\xfa\x2f\xaa\x5c\x93\x5c\x7f\xbe\xe6\xf1\x46\x7d\x95\x82\x59\x6f\xbd\x5a\x46\x2f\x96\xa8\xd4\xf5\x3f\xa6\xe1\xb4\x98\x09\x4b\x16\xa8\xed\x15\x75\x83\x64\xc6\x39\xc1\xbe\x6a\xa8\x29\x74\x83\x4b\x51\x6e\x24\x8f\x12\x14\x4d\x65\xd4\xae\xac\x02\x14\x15\xa7\xb3\x44\x33\x94\x92\x3a\x5b\xed\x60\xd5\xa6\x32\xa0\x9b\x08\x8c\x4b\x49\x5b\x63\x08\x6f\x02\x0d\x5f\xb7\x8a\xf6\x31\x17\xf8\x47\x65\x7b\xd5\xa6\x7f\x29\x65\x95\x75\x8d\xa6\x01\x44\xf5\x96\xf3\x2b\x5e\xf7\x6a\x09\xb3\x62\xe9\x83\xb6\x31\xd7\x9c\xed\x26\x0a\x05\x60\x77\xa8\x3c\xec\x9e\x0e\x2c\x53\x4e\x95\x77\x11\x70\xd5\x56\xf9\xcc\x1f\x8c\xfa\xb6\x83\x8e\xd8\x5f\xe5\x84\xa6\xd5\x7c\x60\x01\x21\x44\xb8\x51\xa1\xb0\x19\xa0\x74\x6b\x2b\x4d\x89\x00\xcb\x4c\xc8\xe4\xfd\x1e\xab\x01\x2a\x92\xa8\xde\xfa\xd7\xf1\x18\xfc\x57\xcf\xc5\x98\xa5\x95\xc2\x11\x51\x30\x13\xd7\x6d\x76\x98\x89\xf1\x18\x5a\x31\x39\x78\x68\xd0\x50\x5a\xce\x34\x5e\xc8\x25\x02\x09\x25\xc9\x27\x9f\x6e\x12\x2d\x61\x41\x63\x87\x0a\x78\xd0\xdc\xa6\x64\xee\x6b\xd3\xd7\xb3\x97\xb1\xa2\x1c\xbe\x90\x6c\xf2\x49\x64\xeb\x4e\xfb\x32\x86\x53\x9d\x95\xff\xeb\x09\x58\x20\x7b\xc7\x69\x60\x51\xd7\xc3\x97\x66\xcf\x49\xdf\x98\xaf\x39\xc7\x5e\xe3\xe1\x0a\xa5\xc8\xbb\x9a\x03\xca\xca\xce\x5c\x88\xa5\x8e\x56\x74\x5b\x2c\xc4\xd2\x93\xca\xe6\x52\x60\xd5\xa6\xf1\x30\x1a\x4d\x91\xb6\xf7\x7c\xe1\x57\x22\xda\x75\x65\xfd\x5b\x59\x28\xad\xe5\xbb\x99\xea\x70\x4f\xcc\x39\xbb\xf7\x86\x18\x43\xfa\x8d\x14\xd2\x10\x2c\x19\xe5\xc1\x02\xbd\x08\xcc\x88\x8d\x4c\x57\x63\x36\x5f\x1e\x54\xf5\xa5\x4c\xd4\x00\x94\x72\xc1\xa5\xd5\xdc\xbb\xd4\xb2\x5c\x6a\x2d\xb4\xcb\x95\x6e\x31\x96\x34\xf6\x17\x02\x7a\x18\xe5\xce\xfa\x74\xe3\x54\x2c\xe6\x34\xe6\xb4\x4f\x37\x16\xab\xd9\xec\x69\x05\x09\x1b\x70\x74\x33\x51\xea\x6a\xa2\x89\x04\x20\x4a\x28\x8e\x79\x45\x13\xd9\x31\xe4\xd5\x54\x3a\xa6\x7d\x3f\x05\x7b\x58\x6a\x68\xc4\x42\x93\xb2\xa5\x9a\x75\x9c\xc5\xe6\xb1\x9a\x89\x4e\x45\xbb\xb2\x47\xd5\x1f\x11\x05\x88\xa8\x1f\x0c\x71\xc3\x7b\x8a\xdb\x5a\x7c\x5c\xc6\x6d\x5f\xa6\xbf\xc7\x09\xce\xe4\xdf\x3e\xbc\x7b\xeb\x81\x95\xde\x5b\x38\x4b\x01\x7a\x76\x9d\x92\x3b\x47\xc7\x0d\x23\x85\x65\x65\x43\x80\x9b\xeb\xab\x94\xdc\xdd\x3c\xb3\x97\xa4\x0e\x29\x7a\x03\xc6\xbc\xd6\x7b\x35\x08\x88\xc0\x07\x1e\x67\x82\x28\xe4\x2b\xa3\x04\x54\x9e\x49\xd7\x5a\x19\x03\xbd\x16\x46\xfe\x58\x9e\x87\x25\x82\xf0\x38\xea\xe2\xab\xda\xd4\xb8\xce\x2a\xcf\x54\xf8\x95\xbd\x52\xfa\x97\xa7\xdb\xe1\x04\x52\xac\x69\x2c\xc0\x4d\xa7\x5c\xc9\x2c\x47\x8d\x94\xb0\x0c\xdc\x3c\xab\x74\x5d\x65\x34\x8c\x9f\x99\xc1\x3b\xbd\x94\x7d\xd1\xd3\xab\x32\x3b\xc6\xa0\xec\xa5\xff\x2a\x4a\xb5\x0c\x9e\xc6\x17\xeb\x18\x46\xae\xeb\x91\x13\xcc\xeb\x6e\xcd\x9a\xb1\x93\x46\x9a\x11\x9d\x1a\x91\x8a\x45\xc7\xc0\xa9\xd5\x77\x63\x5a\x8e\x9f\x75\xc9\x00\x1c\x1d\xc2\x8d\x80\x31\x4c\x6b\x38\xfa\x73\x0c\x66\x4e\x65\xbe\x56\x15\xe6\xfb\x14\xd0\x84\x64\x19\xe6\x8a\x24\x78\x80\x74\xa5\xdd\xe4\x6c\x38\xcb\x15\x1f\x8a\x7d\x9c\x59\xd5\x5a\xac\x34\xa4\xbd\xbe\x52\xf5\xd5\xcc\xc7\x72\x4c\x3a\xb4\x45\x4f\xda\x0f\x0c\xda\x8e\xdf\x39\x36\xd7\x2d\x00\xec\x38\x8e\x4d\xe7\x53\x7f\x54\x77\xd3\x0c\x5b\x47\x49\x4a\x5b\x7c\xde\xa7\xd7\x1b\x50\x5a\x7e\xe4\x02\x4f\x28\x5e\xcb\xa6\xf3\x4e\x1f\x17\xd6\xce\x06\x0c\x2f\x03\x92\x08\xf1\x2d\x80\x88\xab\xed\xfc\x81\xed\xbd\x8e\xd0\x35\x1a\x08\x01\x8e\x05\xf9\xa2\x05\x2e\xe3\x04\x67\x52\x6f\xd2\x64\x1b\x67\x1b\xdc\x15\xb7\xfd\xf2\xb0\x19\xa0\x40\xd3\x20\x80\x45\xaf\xd6\x6d\x91\x6f\x51\xba\xd9\x29\xfe\xbc\xd4\xf8\x26\x39\xe7\xda\x99\x34\x73\x4e\xd9\x2b\xa5\xdf\xe6\x8f\x4f\xd4\x4c\x32\xbc\x80\xae\x89\x10\x0d\x49\xb5\x3b\x16\xf5\x1b\xa4\x56\x3f\x6b\x01\xea\xae\x8d\xfa\x1c\x90\x39\x8f\xa3\xb2\xe1\xf1\xca\xc2\xc5\xf6\x67\x2e\xe9\xae\x3a\x4c\x62\xd5\x63\xa2\x6c\xfa\x0e\xd1\x8c\xf2\x48\x07\x39\xb4\x64\x4f\xbd\x3b\xe1\xac\x57\xe8\xdb\xf2\xfc\x03\xd9\x91\x6c\xf3\x43\xe5\xa0\x57\x48\xd7\x0e\xef\x80\xde\x38\xab\x12\x34\xf5\x8d\x1e\x28\x2e\xd6\xb1\x97\xaa\x22\x92\x01\xc5\x82\x17\xc3\xe5\x16\x65\xef\x88\x20\x2b\x8a\xb5\xe2\xee\xd7\x5e\x4d\xdc\xb3\xf4\x10\x4b\x26\x18\x24\x74\xe5\x1d\xc2\xc7\x35\x74\xec\x90\x54\xef\xf3\x0a\x36\x70\x64\xcc\x37\x58\x46\xe0\xe3\x8a\xc6\xd9\x27\xe0\x54\x35\x96\x9c\xac\x8b\x2c\x51\x19\x2b\xdd\xa0\xf1\x52\xea\xeb\x97\x84\x33\x4a\x3f\xb0\x7d\x34\x14\x8a\xf4\x45\xd5\xe4\x78\x6c\x8b\xd1\xba\xa2\x63\xb4\xe8\x1d\x6f\x5b\x2c\x64\xed\x19\xdf\xb9\x89\x18\x1a\xd5\x50\x19\x33\xa5\xc5\xab\x19\xef\x6f\xba\x06\xc9\xc8\xc3\x93\xc6\x6b\xb4\x7b\x29\x6f\x11\x5e\x3d\x47\xe2\x3c\x1f\x03\x38\xc3\x37\xd1\x30\x88\xb9\x30\x47\x92\xbe\x64\xfb\x48\x8e\xc1\xfe\x33\x08\xed\x22\x10\xec\x3f\x83\xa2\x3d\x37\xbd\x8f\xec\xa9\x95\x81\xa7\x91\x15\x4f\xab\x1c\x81\x05\x90\x35\x9b\x03\x04\xde\xb1\x2f\x1f\xec\xef\xdf\xf4\x11\x6a\xab\xe8\x7d\xeb\x6b\x27\x5a\x9f\x7f\x57\xea\xdd\x2a\x59\x22\x31\xb8\x66\x88\x46\x81\xb6\x03\xd5\x7f\x7a\x2d\x2b\x03\x8a\x9a\x33\x35\xb9\xa0\x4b\x92\x39\xe5\x64\xab\xe0\x59\x50\x78\x1d\xf3\x93\x88\x7f\x92\x14\x33\xcb\xc9\x41\xe5\x92\x09\x65\x66\x75\xc3\xc8\xf3\x6e\x54\x79\x21\x97\xbe\xc2\xda\x0a\xba\xaa\xa2\xd6\xd1\x11\x48\x63\x19\x1b\xd3\x05\xa2\x11\x76\x5d\x51\xe2\x73\x50\x65\x2f\xc2\x51\x19\x6e\xa4\x11\xf6\x77\xb1\x4c\xb6\xde\xd5\xed\xd5\xed\x95\x37\x0f\xef\xef\xef\x6f\x7d\x38\x7f\x60\xb9\xcc\xbd\x79\x78\xeb\xaf\xf0\x71\x85\x6f\xfd\x84\xed\x8e\x2b\x3c\xc9\x58\xc2\xd8\x27\x62\x0a\xa0\xe9\xa1\xfa\xdf\xce\xef\xa2\x23\xde\xad\x70\x7a\x7b\x05\xe7\xde\x22\x9e\x7c\x09\x26\xdf\xdd\x4e\x6e\x3f\xde\xfe\x79\x39\x86\x57\x04\x22\x32\x34\xd6\x9d\x92\x1a\x0a\xde\xed\x95\xb7\x08\x26\xdf\xc5\x93\x2f\xb7\x93\x8f\x65\x2f\x36\xd4\x2b\x8d\x89\x4f\x1f\xfa\xba\xf0\xa1\x2e\xde\x3c\xbc\xfb\x64\xa6\x72\xf7\x89\x65\x32\xfe\x24\xf1\xad\xcf\x73\x33\x8f\x3b\xb5\x2a\x1f\xf1\x67\x79\xeb\xef\xb7\xfb\xdb\x39\xf4\xfc\x6f\x14\xbc\x2a\xda\x4a\xe7\x07\x4d\x95\x15\x0e\x69\x11\x92\xf9\x41\xe3\x1d\x92\x22\x64\xf3\x43\x1a\x13\xfa\xb0\x63\x3a\x38\xc8\x8a\x90\xcf\x0f\x77\x9f\x42\x5e\x54\xb1\xdd\x16\x03\x94\xae\x9c\xcd\xf0\xb5\x6d\xad\x6b\xce\x48\xb8\x96\xf1\x5a\x87\xc5\x2e\x10\x75\xca\x12\x2c\x85\x5d\x39\x4a\x65\x00\xb6\x4b\x6b\xc3\xe0\xe6\xd9\xd8\x6b\x6f\x73\x6d\x3d\xa8\x3d\x3e\x9e\x42\x65\x61\x6a\xf3\xd0\xb9\x72\x7a\xc1\xc4\x94\xd6\xd6\xb9\xad\xaf\x95\x0d\x69\xd9\x95\xa0\xb3\x39\xe2\x34\x55\xf6\x78\x4f\x04\x20\xcb\x29\x45\xc2\x72\x44\xea\xfd\x71\x72\xee\xb2\xc0\x4b\xbf\x0c\x6c\x86\xd5\x7e\x32\xfb\x04\x2f\x91\x8c\x44\xdf\x5e\xa9\x4d\xfd\x5a\xdf\x0c\x45\x55\x5d\x77\xa4\xb3\x3c\x4e\xe0\x48\xd5\x04\x40\xe8\xba\xa2\x2b\x38\xc9\x6e\x03\x60\xd9\xa9\xaf\xaa\x03\x29\xa6\x12\x40\x08\x51\x7d\x9c\x29\x5d\x57\xcd\x5f\xfd\xaa\x33\x03\x3a\xc7\xf4\x33\xe0\x2b\x4d\x40\x5c\x17\xfc\x37\xfd\xe3\x78\xf4\x6a\xd2\x0c\x84\x79\x4f\x4e\xff\xe6\x5d\xf4\x24\x2c\xf5\xc7\x87\x77\x6f\xc3\xa1\x83\x26\xab\x0d\x2c\x74\xcc\x4b\x39\xd1\xb3\x0b\x3d\xaf\xf9\x65\xcc\x5e\xb7\xb7\x46\x8b\x64\xd8\xb6\x72\xf1\xf2\xb1\x9d\x20\xfb\xa9\xea\xba\x1e\xd0\x28\x9e\xb7\x80\x2f\x43\xd0\x9c\xdb\xef\xf6\xf2\xa1\x64\xa9\x70\x20\x6c\xf2\xef\xc2\xed\x8d\xfd\x34\x01\x52\x6d\xe3\xbe\xb4\x81\x00\x74\x30\x71\x78\xdc\x0d\x8a\x54\xe1\xea\xd3\x8d\x37\x45\x22\x9a\x6a\xbd\x67\x4e\xda\xa2\xc6\x4f\x37\xe6\xd8\xc8\x93\x37\xd1\xc9\x46\x9f\x60\xa8\xcf\xe0\xcc\x81\x2e\x8b\xd3\x57\x2c\x93\xfa\x8c\x6a\x2c\xd1\x68\x8a\x02\x13\xc1\x53\xb0\x45\x1f\x4c\x3c\x11\xd7\x01\xd4\xd1\x89\x53\x10\x13\x61\x40\xb4\xe7\x60\x35\x69\x69\x57\xd4\x4a\xa7\x41\x2c\x1a\x4d\x11\x47\x29\x8a\x51\x86\x72\x94\xa0\x4d\xd4\xce\x91\x68\x22\xb6\x8b\x25\xa2\xea\x0f\x89\x82\x19\x69\x34\x3e\xa9\xce\x16\x59\x24\x17\x64\x69\x25\x29\xcd\x34\x17\xb1\x45\xb0\x74\x5d\xa6\xcb\x13\xac\xb7\x27\xa2\xe6\xa4\x57\x55\x41\x24\xaa\x8f\xe9\x12\xd6\x59\xa0\xbc\x65\xb1\xe9\xd3\x11\x14\x47\xc1\x2c\xbe\xae\x63\x35\xb1\x31\x35\x6a\xb9\x2c\x16\xb1\x0e\xf3\xdf\x70\x78\x48\x23\xba\x88\x97\xb3\x15\xc7\xf1\xa7\xa2\xd0\x59\x41\x56\x24\xfd\xd0\xfe\x36\x42\x72\xcf\x84\x56\x37\x1e\x8b\x46\x01\x8a\xa3\x81\x06\x10\x25\xa7\x55\x8a\x03\x51\x7a\x5a\x2e\x78\x82\x4e\x0a\x39\x16\x7b\x96\x09\x72\x87\xe1\x61\x73\x8a\x47\x53\x5d\x91\x12\x01\x08\x8b\xac\x17\xba\xc0\x12\xe5\x3d\x35\xe4\x0b\x16\x5a\xfa\x98\xa9\x56\x02\xbf\x4f\xd8\x9b\x69\x69\x89\x5c\xcf\xfc\x92\xf6\x86\x10\xe7\x5a\x96\x2a\x24\x1d\x6e\xb6\xe5\x78\x0d\xe0\xf1\x78\x1e\x8e\xe0\x09\x80\xe8\x7c\x9b\x86\x6a\x00\x1a\xb2\x5e\xda\xfa\x84\xca\x8f\xa0\x22\xb0\x04\x50\x13\xfd\x6c\x43\xb5\x02\x00\xea\x03\xfc\x75\x34\x9a\xce\xac\x35\x9a\x9f\xac\x97\x39\x06\x74\x5d\x6f\x1d\x8d\x02\x18\x02\xc9\x73\xac\xf6\xce\xf9\x31\x4c\x2f\xbd\x72\xba\x9f\xb6\x62\xb7\xaa\x93\xb1\xaa\xbd\x14\x61\x9d\x11\x37\xea\x39\xc4\x20\xb6\xa2\xd0\xc2\x54\x49\x0c\x9c\x2a\xf2\x91\xb5\xb7\x86\xe4\x51\x4d\xa2\x83\x00\x2b\xbc\x21\xd9\x69\x84\x57\x1b\x90\x93\x84\x65\xb2\x8e\xe9\xed\xe2\xcf\x93\x2a\xae\xa7\x28\xd0\x3e\xfb\xec\x89\xe1\x69\x18\xe0\xe6\xda\x34\xb4\xa3\x8c\xe6\xd6\x82\xa3\xcb\x57\x8c\xa7\x98\x47\x20\x00\x8e\xe0\x49\x04\x9e\x8d\xd3\xf1\x33\xe0\x38\x31\xa5\xec\x7e\x9d\x53\x2a\x12\xa5\xee\x22\x43\xd4\x9b\xeb\x2b\x03\xee\xc6\x0e\xdf\x3d\x6b\xb2\xa6\x98\x11\x66\x3b\xa5\xb4\x77\xd1\xd6\x75\xb7\x7e\x69\xe9\xce\x75\x7c\x3d\x16\x93\xf2\x1b\x84\xba\x56\x5b\xbe\x75\x9d\xfe\x02\x61\xf5\xa9\x3d\x0c\x80\x1e\xa7\xe5\x99\x60\x79\x43\x4a\xe7\xd9\x78\x37\x7e\xe6\x0c\x12\xaa\x13\xc3\x34\x1d\xf7\x34\x7e\x68\x02\x98\x64\xb7\x39\x21\xa4\x53\x22\x5b\xee\xec\x8a\x8c\xb1\x22\xe3\x55\x87\x4e\xc6\xa4\xd9\xce\xbd\x3f\x6a\x4a\x83\x93\x69\x0d\xdb\xa3\xdb\x89\x56\xec\xdb\xd8\xf0\x7a\xa3\xcc\x91\xe0\x49\x98\x22\xed\x15\x26\x05\x84\xe1\xbf\x85\x29\xd9\x6d\x26\xf7\x3c\xde\x83\x61\xd2\x91\x5d\xbc\xc1\x6d\xe6\xbb\x6a\xf8\x8a\xac\xbd\x21\xdc\x95\x51\x52\xdb\x24\x26\x93\xa3\xb1\x48\x10\x8f\xda\x88\xf7\x05\x87\xcd\x3e\x80\x28\x77\x5d\xde\x4e\xa9\x04\x46\x00\xa1\x1c\xa2\xac\x0c\x92\xda\x95\x46\x8c\xa1\x0c\xce\x24\x7f\x38\xec\x89\x3e\x02\x5a\x13\x4a\xbd\x03\x36\x0e\xbe\x08\x17\x7c\x11\x2c\x97\x05\x2c\x12\xed\x3f\x62\x78\x48\x58\x26\x18\xc5\x3e\x36\xb7\x4d\xde\xc5\x9f\xb0\x23\x72\x8e\x75\xfe\xdb\x36\xbe\xc3\x0e\xc9\x12\x9a\xa7\x38\x75\x7e\x6e\x40\x3a\x77\x98\x0b\x65\x4f\x3c\x07\xb0\x28\xda\x86\xf0\x68\xf8\x08\x82\x54\xfe\x8f\x87\x7b\x03\xa5\xc3\x02\xac\x68\x45\x9a\x2f\xa7\x22\x50\x00\x7c\xba\x71\xf4\xfc\xba\xe1\x67\x63\x00\x06\x33\xe1\xba\x7d\xf2\xf4\x6c\x22\x81\xf1\x76\x9e\x10\x16\x3c\x9d\x5c\xc2\x76\x7b\x8a\x25\x06\xc3\x7b\x81\x65\x9a\x8b\x7e\x94\x78\xf7\x96\xc5\xa9\xb5\x25\x52\x9d\x96\x23\x8e\xc7\x40\x99\xba\x12\x16\x10\x69\xd9\xa5\xc5\x93\xeb\x8e\x58\x6f\xe0\xf7\x2c\x12\xa3\x20\x32\xae\xc2\x45\xba\xa5\xe9\x38\x27\x95\x61\xeb\x61\xdb\x17\xf8\x03\x57\xca\x1e\xa1\x80\xdd\x03\x4f\x7d\x80\xd2\xb5\x88\x2b\xb3\x93\x44\x01\x32\x59\xe2\x2d\x5f\xaa\x93\x31\xde\x33\xe5\xb6\xeb\xc5\x3a\xe7\x0a\xf0\x40\x22\x56\x5a\xa3\xc6\xba\xd5\xe7\xa8\x4a\x35\x73\x2b\xe7\xf3\x78\x24\xa3\x28\xc2\xad\x18\x7a\x07\x05\x14\x47\x76\x8f\xb9\x1d\xd3\x0e\x03\xa4\xd3\x46\x73\x6d\x6f\x18\xd8\x3a\x79\xb4\x49\xa6\x6d\x22\xc7\x66\x90\x64\x96\x44\xdc\xb2\x4c\x46\xd3\x51\x64\x17\x18\xf3\xa4\xea\xf3\x2b\xa7\xae\xeb\x9d\xab\x3e\x1e\x4f\x6a\x05\x4f\x60\x08\xd6\x31\x15\x18\x68\xe0\xe7\x4c\x9a\x0a\xd4\x24\xe7\x54\x6f\x9b\xa7\x34\x57\x83\x3f\x62\x68\x9e\x07\xa7\x0d\x4d\x88\x92\x79\xb3\x9b\x37\xb8\x8a\x78\x7e\xff\xf0\x63\xea\xb5\xe2\xe3\xb0\x23\x55\xf5\x20\x28\xb1\x37\xa8\xed\xe1\xf2\xee\x31\x7d\x03\xa8\xf7\x50\xed\x4c\xfb\xa2\x57\xab\xf0\x56\x52\x4b\xa5\x51\xf6\x1c\xdf\xfd\xa8\xe5\x00\x41\x95\x3c\x50\xa2\x49\xe7\x0b\x87\xd2\xfc\xde\xe6\xbb\x55\x28\x94\xd6\xa9\xf3\x8d\x83\x76\xc2\x02\x3f\x49\x58\xb8\xf8\x30\x79\xe8\xe0\xae\x91\xef\x05\x8a\xcb\x50\x93\x4e\x1f\x79\x4d\x74\xbe\xa0\x12\xfc\x65\x88\x67\x13\xe1\xc9\x14\xad\x23\x3c\x9e\xce\x94\xe0\xc1\xae\x4b\xa2\x28\x4a\x27\xd3\xb9\xb7\x8e\x02\xb4\x51\x3f\x61\x88\x4d\x99\xeb\xaa\x36\x44\xdb\xc5\x55\xdd\xf9\x45\xe9\x93\x36\x8a\x70\xd5\xa1\x27\x02\xdd\x82\x27\x83\x6b\x0e\x19\xdb\x47\x8e\x4f\x06\x94\xe1\xcf\xb2\x85\x57\xab\xa0\x97\x91\x6a\xc1\xb4\x59\x9e\x9b\x49\x5f\x8f\xf5\xf2\xa9\x63\xe0\x8e\xf0\x33\x7e\xe8\x23\x0c\x9e\xb1\x89\x3e\xcb\xb0\x6e\xeb\x6c\xa3\x60\xb6\xed\x97\xc3\xdb\xf1\x18\x9e\x09\x23\xd5\xa8\x6c\xcf\x4e\xf7\xd1\x7e\xf6\xa4\x67\xf8\x9a\xcc\xbd\x5c\xed\x8b\x40\x09\x69\x23\xab\xd3\xc9\xf4\x78\x14\xc7\xa3\xaa\x98\x2a\xe9\x1b\x40\x18\xe2\x1b\xc5\x7a\xea\x03\xe1\xaa\x4d\x60\xa2\x90\x65\x53\x23\xa8\x21\x44\xf9\xf0\x99\x7c\x97\x9e\x8f\xad\x59\xbb\x13\x39\x9d\x01\x0c\xb3\x96\xba\x3e\x3f\xdc\x63\x0b\xde\x3b\x9c\x8d\xe3\xe3\xd6\xce\x1f\xb6\x71\x2e\xe1\xc2\x0b\x84\x72\xc3\x83\x3a\x11\xad\x68\x29\x5a\x6f\x48\x8a\xb5\x62\x71\x48\xb1\x47\x73\x7e\x6b\x8e\x9a\x5f\x04\x43\xb4\xe0\xd6\xed\x8e\x61\x79\xae\x43\x97\x5f\x25\xce\xdb\x88\x40\x18\x7a\x3d\xf8\xf2\xde\x83\xe6\xff\x34\x6a\x7a\x04\xeb\xb6\x4b\x80\xba\xe7\x3e\xe7\x74\x70\xf7\x80\x46\x99\x0a\x4f\x6a\x6f\xc5\xcd\xf1\x78\x0a\x8b\xce\x29\xec\x86\x7d\x60\x3f\xe1\xcf\xf2\x97\x8e\xb5\xd8\x49\xc1\x34\x24\x3a\x1e\xab\x54\xe5\xf1\xf4\x5a\x76\x85\xd5\xbc\xae\x1c\x9f\xc9\x4e\x34\x3a\xbb\x1e\xb2\xb6\xdd\xcb\xbe\x85\x4e\x8b\xd4\x99\x99\x65\x09\xc2\x48\x49\x90\x50\xfa\xc2\xa7\x8c\xed\xeb\x61\xa2\xe0\x3f\x35\x4c\xdf\x55\xa1\x47\xd2\x11\xf5\x0b\x15\x13\xe5\x59\x3f\x4d\x1c\x0c\xc0\x28\xd0\xff\x08\x02\xd8\x35\xe9\xd5\x62\xfd\xcc\xf1\xdd\x13\x17\xeb\x26\xa8\x69\x36\x99\x3c\x4a\xb3\x7a\x80\x2e\xcd\x2c\x16\xc7\x4f\x59\xa6\x76\xf6\xec\xa4\x6f\x97\xfd\xe1\x18\x7c\xc5\x0a\x52\xbc\xfe\x77\x17\xb0\x01\xd1\xbf\x7e\x55\x4a\xf1\xc0\xed\x82\x47\xd2\x9c\xac\x64\xb2\x4f\xf8\x21\xdf\xb7\xbd\x41\x09\x0f\xf8\x34\x2f\xf3\xdb\xff\xa9\xcc\x55\x35\xf0\x2b\x96\x62\x9d\xb7\x7c\x92\x34\xde\xe1\x2b\x0f\x42\xf4\xed\x77\x97\x76\xab\x77\x98\xa7\x66\x3b\x90\xfb\xf6\x09\x3f\x28\x93\xfe\x04\x61\xed\x5a\x63\x5f\xf8\xe6\x92\x8c\xeb\x3e\xbf\x00\xdd\x1e\x9f\x14\x5b\x6b\x20\x95\xdc\x9d\x30\x9d\xfe\x3c\xef\x5f\xb2\xa1\xe6\x21\xf6\x53\x2c\x24\x67\x0f\x1e\x3c\xc9\x1f\xd6\xf6\xfa\xc0\xc2\xb5\xb3\xac\xcf\xe6\x29\xa9\xe9\x28\x05\x5f\x65\x5d\xb7\xfc\xf9\x93\x95\xe8\x12\xf4\x3c\x6c\x65\xc9\x3c\x02\xdb\x5a\xae\xde\x09\x96\x0e\x49\xd4\x79\x3e\xa7\x3a\x30\x56\x1b\xbb\xbe\x62\xda\xbd\xa2\x64\x4b\xa1\x47\x91\x7c\x24\xe1\xa8\xa4\xd3\x0c\x2b\x45\xd3\x63\x18\x2b\xe9\x62\x96\xd4\x76\x6d\x0d\xf2\xe9\xb0\xed\x8b\xac\x36\x30\xf4\x64\xc7\xaf\xad\x2b\x51\x3f\xac\x46\x6e\xb4\x00\x21\xac\x24\xac\xf8\x0a\x84\x44\x07\x21\xf1\xb5\x08\xb5\x01\x15\xa7\x59\x64\xca\xdc\xa3\xb1\x3c\x0d\x08\x75\x32\x67\x71\x99\xb5\xa5\x44\x59\x24\xc3\xbe\xa4\x43\x5c\x26\x1d\xae\x19\xdf\x01\x64\x32\xb3\x14\xe8\x6f\x53\x0f\x8c\x75\xf2\x17\x72\xf4\x93\x1a\xea\x47\xb0\xff\x0c\xbb\xe9\x1e\xfa\xa2\xc1\x3b\x76\x87\xfb\xb2\xa1\x22\x39\xc1\xb3\x77\xb1\xdc\xfa\xf1\x4a\x78\x02\xde\x4c\x5f\x9c\x31\xe6\x9b\x8d\x9c\xf2\x78\xb3\x21\xd9\x06\x34\xf7\xee\xeb\x49\x9f\xc9\x8a\x15\x28\xe8\xeb\x70\x2e\x0b\xb2\xe5\x9f\x4c\x06\x41\xfb\x09\x25\x38\x93\xe6\xcc\xe7\x2b\x86\x69\xf9\x25\x97\x8f\xd2\x47\xeb\x37\xd9\xe0\xed\x9a\x26\xdf\x79\xa4\x24\xaf\x49\xc2\xed\xcf\x8e\xb5\x53\x63\x4b\x57\xb1\x7d\xcb\xa9\xd7\x83\x15\x2d\x0f\x76\x20\x8c\x28\x3a\x3e\xcc\xf1\x78\x69\x17\x7b\x31\x2e\xef\x65\xd3\x56\xd9\x49\x9d\x36\xe5\x1e\x60\xfb\x38\x21\xf2\x21\x02\x01\x80\xb3\xaf\xb5\x09\x2c\xbe\xc4\xd7\x81\xeb\xd6\xac\x8d\xe1\x8d\xb6\x54\x5a\xb7\x19\xe7\xb2\x23\xa6\x47\x81\x72\xb3\x2f\xed\xd7\xa8\x0e\xd5\xcf\xea\x72\xfd\xc2\x5e\xd3\xae\xd9\xa5\xbb\xbc\x52\x5a\xe3\x74\x4d\x7b\x17\x54\xb6\xa8\x75\x22\xf7\x34\xf9\x2e\x4a\x0f\x6e\x96\xa9\x97\x64\xc7\x63\xc3\x6d\x51\xc3\x9f\xf6\x42\x0f\xd1\xbd\x5c\x5d\x73\x35\xc8\xb8\xa7\x3a\x6b\xbe\xb5\x3b\xac\x4b\x59\xfd\xaa\x1d\xc9\x28\x28\xef\x05\x99\x50\x33\xae\x53\xd3\x75\x2f\xd7\xc5\xd5\x8b\x04\xea\x67\x9d\x1b\xde\x7e\x88\x05\xf7\xc6\xd7\x3b\x97\xfe\x5a\x31\xf5\xa1\xbb\x58\xa2\x97\x78\xb6\x41\xf3\x85\xb1\x1d\xd6\xaf\xdf\xe0\xc6\x11\xe8\xb9\x2a\x88\xfd\x5d\x9c\xc5\x1b\x33\x0d\x03\x06\xea\x34\x39\x93\x0b\xad\xa7\x84\xc5\x22\x58\xfa\xfb\x78\x83\xff\x37\x2c\x1a\xce\x30\x4f\x92\x9d\x4e\x8a\x9f\x99\x14\xaf\x26\x55\x5e\x3b\xb3\xe6\xc4\x9e\x30\x27\x8f\x9d\xce\x44\x44\x6c\x00\x69\x64\xe9\x1a\xfd\x1e\x92\x5a\xc6\xc0\x9e\x4a\x1a\x05\xb3\xb4\x67\x2a\xe9\x99\xa9\xa4\xd5\x54\x70\x96\x0e\xdf\x94\x7b\x6c\x22\x64\xee\x29\x9e\x42\x8d\x88\xf6\xc4\x44\xb6\x02\xe4\xdd\x3b\x9c\xf6\x36\x55\xe6\x5b\x1f\x2f\xbf\xe6\xf1\xe6\x02\x56\xd6\xe9\x50\x6d\x7e\x36\x57\x2d\x46\xe7\xf9\xf9\x6b\x97\x5e\xdf\x38\x3c\xf5\x01\x9e\xc2\xce\x7d\x87\x51\x15\xb7\x22\x2b\x59\xa2\x5f\x0d\xb4\x5a\x5a\xf9\x02\x10\xea\xa7\x88\x7a\xb6\x47\xbd\x79\x06\x77\x8a\xe1\x31\xa2\xc3\x9f\xa5\x25\x6b\xf2\xf8\x95\x25\x35\x8e\xa6\x3d\xa5\x93\x68\x3a\x60\x0a\xe2\xd3\x8b\x2b\x3d\x4a\xb8\xdb\x6a\x65\x34\xcb\x30\xcf\xa8\x55\x35\x4f\xa4\x68\xff\x6c\xd6\xeb\x9f\xd9\xf7\x41\xad\xd5\xe1\xf0\x40\xaa\x64\x29\x11\xf1\xa1\x3d\x75\x7e\x70\x05\x16\x0c\xbb\x86\x7a\xe8\xae\x2f\xcb\xe1\x81\xcd\x3d\xd6\xb3\x3d\x1e\x19\x4c\xfb\xde\xad\x6b\x4a\x35\x03\xf0\x8b\x59\x85\x9f\x61\x95\xcb\x36\x27\x22\xfa\x62\xe1\xe8\xd2\xb5\x3e\x59\xc5\xc1\xf5\xee\xdb\xf8\x5d\xee\xec\xdf\xfe\x55\xdc\x0e\x49\xeb\x73\x32\xad\x1c\xb1\xee\x86\x6e\x39\x7c\x5e\x50\x1d\x7a\xe9\x5e\x73\x19\x89\xc9\x34\x6c\x0a\xa2\x48\x15\xb8\xae\x87\xa3\x00\x36\xc2\xb5\xcc\x9f\xec\x03\x4f\x2e\x3c\xd7\xe8\x89\xee\x5f\x74\xae\xd1\x13\xa6\x9f\x49\xfd\xee\xd8\xe3\x77\xe6\xbe\xe6\x14\xa2\xe7\x2c\xa1\xb3\x48\xf5\xcd\xee\x4b\x62\x08\xb8\x75\x53\x5b\x77\x3b\x09\x9e\x48\x3f\xc5\x54\xc6\xff\x47\x47\x49\xcc\xcf\x9b\x60\x7e\x12\x42\x08\x4f\x1c\x7f\x74\x1a\x54\x39\x09\x06\xd8\x97\xcd\x07\xd5\xc9\x68\xfa\x94\xc8\x87\x06\x79\x26\x3c\x51\x87\x5e\x0a\xf3\x1a\x44\xf5\x98\x4a\xcb\xfb\x3b\xa1\xcd\xa9\x4e\xc1\xf0\x20\xa3\xd1\xa8\x4f\x06\xe0\x96\x0c\x30\x17\x4e\x7b\x45\x40\xab\xa1\xb9\x62\x7a\x41\xbb\x2a\x83\xea\x54\xda\xb5\x71\xee\x8a\x3b\x01\x0f\x8f\x2b\xb7\x33\xd8\x8a\x0b\xb1\x15\xfd\xd8\xba\xae\x84\xfd\xe9\x2d\x78\xc0\x28\x6f\xc7\xc9\x3a\xac\x53\x56\x0d\x39\x9c\xca\xe6\xb0\xb1\xeb\x0f\x01\xbf\x2a\x99\x65\xe0\x96\x5c\x24\xdb\x37\xee\xd0\xe3\x37\xee\x4e\xba\x54\x91\x2d\x2b\x5d\xba\xc7\x9d\x3d\xb9\x34\x6f\xad\xeb\xba\xcc\x35\x31\x99\x16\xca\xb5\xd4\x57\xe5\xd0\x63\x4d\xca\xc7\x3c\x4c\x1a\x2b\x89\xd4\xcc\x3b\x49\x1a\x74\x53\xbe\xf2\x58\xbe\xf2\xd7\x7a\x38\x49\x09\x37\xdd\xe7\xc4\xef\xaa\xba\x15\x3d\x18\x28\xea\xfa\x74\x23\x77\xf6\x33\x91\x8f\x3c\xa2\xc1\x96\xe6\x51\x8a\xea\xab\x5e\x76\x0c\x8d\x82\x68\xbf\xe2\xf6\xd8\xbb\x0f\xdb\xde\x57\xdd\xda\xb8\x56\x76\x41\x97\x92\xb6\xa8\x1f\x78\xa1\xe5\xd2\xc6\xf6\xbb\x37\xa5\xc7\x68\xab\x85\x21\x87\xb2\xbe\xb4\xfa\xd8\x40\x17\x5c\x9c\x1d\x7a\x34\x84\x3f\x1c\x6a\x8c\xca\x1f\x83\xaf\x02\x9b\x6a\x6b\x8b\x9c\x1d\xd7\x3a\x65\x3c\xdb\x6e\x68\xb8\xcb\x7a\x43\x74\x76\x6f\xeb\x5d\xad\x4f\x52\x81\x95\x6c\x59\x18\x1f\xfd\xe4\x01\x8b\x17\xca\x5b\xaf\xb8\x93\x6c\xb6\xf2\x44\x1f\x55\xcf\x27\x62\xa8\x28\xa7\xbd\x99\xfe\x7d\xa4\xeb\xdb\x9b\x68\xa8\xe9\xb2\x7c\x96\x6e\x30\x19\xd4\x46\xc5\xd9\xc6\xc2\xc9\x98\x74\x54\x1f\x12\x4b\x9c\x3a\x7b\xfd\x86\x1c\x7d\xa8\xd2\x41\xcc\x85\x4b\x40\x37\x60\xdc\x42\xc0\x3c\x47\x3b\x6b\x23\x45\xcd\xab\x35\xe6\x61\x48\x84\x3b\xd1\xe0\x12\x45\x44\x61\xf1\x07\x60\x57\xe8\xb7\x39\xed\xc7\x32\xc3\x69\xb1\x2c\xd0\xa1\x40\x8b\xe7\x4b\xe8\x3d\x57\xee\xc2\xff\x0b\x00\x00\xff\xff\x07\xef\x3d\x5f\xe8\x5f\x00\x00"),
-		},
-	}
-	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/css"].(os.FileInfo),
-		fs["/favicon.ico"].(os.FileInfo),
-		fs["/fonts"].(os.FileInfo),
-		fs["/img"].(os.FileInfo),
-		fs["/js"].(os.FileInfo),
-	}
-	fs["/css"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/css/galldir.css"].(os.FileInfo),
-		fs["/css/lg-fb-comment-box.css"].(os.FileInfo),
-		fs["/css/lg-fb-comment-box.css.map"].(os.FileInfo),
-		fs["/css/lg-fb-comment-box.min.css"].(os.FileInfo),
-		fs["/css/lg-transitions.css"].(os.FileInfo),
-		fs["/css/lg-transitions.css.map"].(os.FileInfo),
-		fs["/css/lg-transitions.min.css"].(os.FileInfo),
-		fs["/css/lightgallery.css"].(os.FileInfo),
-		fs["/css/lightgallery.css.map"].(os.FileInfo),
-		fs["/css/lightgallery.min.css"].(os.FileInfo),
-	}
-	fs["/fonts"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/fonts/lg.eot"].(os.FileInfo),
-		fs["/fonts/lg.svg"].(os.FileInfo),
-		fs["/fonts/lg.ttf"].(os.FileInfo),
-		fs["/fonts/lg.woff"].(os.FileInfo),
-	}
-	fs["/img"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/img/album.png"].(os.FileInfo),
-		fs["/img/icon.svg"].(os.FileInfo),
-		fs["/img/loading.gif"].(os.FileInfo),
-		fs["/img/video-play.png"].(os.FileInfo),
-		fs["/img/vimeo-play.png"].(os.FileInfo),
-		fs["/img/youtube-play.png"].(os.FileInfo),
-	}
-	fs["/js"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/js/lightgallery.js"].(os.FileInfo),
-		fs["/js/lightgallery.min.js"].(os.FileInfo),
-	}
-
-	return fs
-}()
-
-type vfsgen۰FS map[string]interface{}
-
-func (fs vfsgen۰FS) Open(path string) (http.File, error) {
-	path = pathpkg.Clean("/" + path)
-	f, ok := fs[path]
-	if !ok {
-		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
-	}
-
-	switch f := f.(type) {
-	case *vfsgen۰CompressedFileInfo:
-		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
-		if err != nil {
-			// This should never happen because we generate the gzip bytes such that they are always valid.
-			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
-		}
-		return &vfsgen۰CompressedFile{
-			vfsgen۰CompressedFileInfo: f,
-			gr:                        gr,
-		}, nil
-	case *vfsgen۰FileInfo:
-		return &vfsgen۰File{
-			vfsgen۰FileInfo: f,
-			Reader:          bytes.NewReader(f.content),
-		}, nil
-	case *vfsgen۰DirInfo:
-		return &vfsgen۰Dir{
-			vfsgen۰DirInfo: f,
-		}, nil
-	default:
-		// This should never happen because we generate only the above types.
-		panic(fmt.Sprintf("unexpected type %T", f))
-	}
-}
-
-// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
-type vfsgen۰CompressedFileInfo struct {
-	name              string
-	modTime           time.Time
-	compressedContent []byte
-	uncompressedSize  int64
-}
-
-func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
-}
-func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
-
-func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
-	return f.compressedContent
-}
-
-func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
-func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
-func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
-func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
-func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
-func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰CompressedFile is an opened compressedFile instance.
-type vfsgen۰CompressedFile struct {
-	*vfsgen۰CompressedFileInfo
-	gr      *gzip.Reader
-	grPos   int64 // Actual gr uncompressed position.
-	seekPos int64 // Seek uncompressed position.
-}
-
-func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
-	if f.grPos > f.seekPos {
-		// Rewind to beginning.
-		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
-		if err != nil {
-			return 0, err
-		}
-		f.grPos = 0
-	}
-	if f.grPos < f.seekPos {
-		// Fast-forward.
-		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
-		if err != nil {
-			return 0, err
-		}
-		f.grPos = f.seekPos
-	}
-	n, err = f.gr.Read(p)
-	f.grPos += int64(n)
-	f.seekPos = f.grPos
-	return n, err
-}
-func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
-	switch whence {
-	case io.SeekStart:
-		f.seekPos = 0 + offset
-	case io.SeekCurrent:
-		f.seekPos += offset
-	case io.SeekEnd:
-		f.seekPos = f.uncompressedSize + offset
-	default:
-		panic(fmt.Errorf("invalid whence value: %v", whence))
-	}
-	return f.seekPos, nil
-}
-func (f *vfsgen۰CompressedFile) Close() error {
-	return f.gr.Close()
-}
-
-// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
-type vfsgen۰FileInfo struct {
-	name    string
-	modTime time.Time
-	content []byte
-}
-
-func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
-}
-func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
-
-func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
-
-func (f *vfsgen۰FileInfo) Name() string       { return f.name }
-func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
-func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
-func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
-func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
-func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰File is an opened file instance.
-type vfsgen۰File struct {
-	*vfsgen۰FileInfo
-	*bytes.Reader
-}
-
-func (f *vfsgen۰File) Close() error {
-	return nil
-}
-
-// vfsgen۰DirInfo is a static definition of a directory.
-type vfsgen۰DirInfo struct {
-	name    string
-	modTime time.Time
-	entries []os.FileInfo
-}
-
-func (d *vfsgen۰DirInfo) Read([]byte) (int, error) {
-	return 0, fmt.Errorf("cannot Read from directory %s", d.name)
-}
-func (d *vfsgen۰DirInfo) Close() error               { return nil }
-func (d *vfsgen۰DirInfo) Stat() (os.FileInfo, error) { return d, nil }
-
-func (d *vfsgen۰DirInfo) Name() string       { return d.name }
-func (d *vfsgen۰DirInfo) Size() int64        { return 0 }
-func (d *vfsgen۰DirInfo) Mode() os.FileMode  { return 0755 | os.ModeDir }
-func (d *vfsgen۰DirInfo) ModTime() time.Time { return d.modTime }
-func (d *vfsgen۰DirInfo) IsDir() bool        { return true }
-func (d *vfsgen۰DirInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰Dir is an opened dir instance.
-type vfsgen۰Dir struct {
-	*vfsgen۰DirInfo
-	pos int // Position within entries for Seek and Readdir.
-}
-
-func (d *vfsgen۰Dir) Seek(offset int64, whence int) (int64, error) {
-	if offset == 0 && whence == io.SeekStart {
-		d.pos = 0
-		return 0, nil
-	}
-	return 0, fmt.Errorf("unsupported Seek in directory %s", d.name)
-}
-
-func (d *vfsgen۰Dir) Readdir(count int) ([]os.FileInfo, error) {
-	if d.pos >= len(d.entries) && count > 0 {
-		return nil, io.EOF
-	}
-	if count <= 0 || count > len(d.entries)-d.pos {
-		count = len(d.entries) - d.pos
-	}
-	e := d.entries[d.pos : d.pos+count]
-	d.pos += count
-	return e, nil
-}
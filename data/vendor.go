@@ -0,0 +1,54 @@
+//go:build ignore
+
+// vendor.go fetches the lightgallery.js plugins used by the default
+// templates from their upstream releases into assets/js. Run it with
+// go generate after changing one of the versions below.
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// plugins are the upstream releases that match the bundled lightgallery.js
+// 1.1.3.
+var plugins = []struct {
+	name    string
+	version string
+}{
+	{"lg-thumbnail.js", "1.1.0"},
+	{"lg-fullscreen.js", "1.1.0"},
+}
+
+func main() {
+	for _, plugin := range plugins {
+		base := strings.TrimSuffix(plugin.name, ".js")
+		for _, file := range []string{base + ".js", base + ".min.js"} {
+			url := fmt.Sprintf("https://cdn.jsdelivr.net/npm/%s@%s/dist/%s", plugin.name, plugin.version, file)
+			if err := fetch(url, filepath.Join("assets", "js", file)); err != nil {
+				log.Fatalln(err)
+			}
+		}
+	}
+}
+
+func fetch(url, dst string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, content, 0644)
+}
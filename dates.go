@@ -0,0 +1,113 @@
+package galldir
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// dateLayouts are the formats accepted for album dates.
+var dateLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+}
+
+// ParseDate parses a date in any of the formats accepted for album dates:
+// "2006-01-02 15:04:05", "2006-01-02", RFC 3339 or "2006-01-02T15:04:05".
+func ParseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised date %q", s)
+}
+
+// ParseDateRange parses either a single date or a range of dates written
+// as "start..end". The end of a single date is the zero time.
+func ParseDateRange(s string) (time.Time, time.Time, error) {
+	parts := strings.SplitN(s, "..", 2)
+	start, err := ParseDate(parts[0])
+	if err != nil || len(parts) == 1 {
+		return start, time.Time{}, err
+	}
+	end, err := ParseDate(parts[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range %q ends before it starts", s)
+	}
+	return start, end, nil
+}
+
+// formatDateRange formats the dates from start to end, leaving out the
+// parts that they have in common. If end is the zero time only start is
+// formatted.
+func formatDateRange(start, end time.Time) string {
+	if end.IsZero() || start.IsZero() {
+		return formatDate("", start)
+	}
+	switch {
+	case start.Year() != end.Year():
+		return formatDate("", start) + " – " + formatDate("", end)
+	case start.Month() != end.Month():
+		return start.Format("2 January") + " – " + formatDate("", end)
+	case start.Day() != end.Day():
+		return start.Format("2") + "–" + formatDate("", end)
+	}
+	return formatDate("", start)
+}
+
+// DateFallback selects the date given to an album that has no date set.
+type DateFallback string
+
+// The available date fallbacks. The default is the modification time of
+// the album's directory.
+const (
+	DateFromModTime     DateFallback = ""
+	DateFromNewestPhoto DateFallback = "newest"
+	DateFromOldestPhoto DateFallback = "oldest"
+)
+
+// photoDate returns the (possibly cached) time that the newest or oldest
+// photo directly in the album at path was taken, according to the
+// Provider's DateFallback, or false if no photo has a capture time in its
+// EXIF data.
+func (p *Provider) photoDate(path string) (time.Time, bool) {
+	if p.DateFallback == DateFromModTime {
+		return time.Time{}, false
+	}
+	cacheName := CacheName("photodate", strings.TrimSuffix(path, "/")+"/")
+	if cacheVal, cached := p.cacheGet(cacheName); cached {
+		date := cacheVal.(time.Time)
+		return date, !date.IsZero()
+	}
+	files, err := p.listing(path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	var date time.Time
+	for _, file := range files {
+		fileName := listedName(path, file)
+		if file.IsDir() || !hasExif(fileName) {
+			continue
+		}
+		e := p.Exif(filepath.Join(path, fileName))
+		if e == nil || e.Taken.IsZero() {
+			continue
+		}
+		if date.IsZero() ||
+			p.DateFallback == DateFromNewestPhoto && e.Taken.After(date) ||
+			p.DateFallback == DateFromOldestPhoto && e.Taken.Before(date) {
+			date = e.Taken
+		}
+	}
+	p.Cache.Set(cacheName, date, fileTimeout)
+	return date, !date.IsZero()
+}
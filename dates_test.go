@@ -0,0 +1,145 @@
+package galldir_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestParseDateRange(t *testing.T) {
+	tests := []struct {
+		date      string
+		start     string
+		end       string
+		expectErr bool
+	}{
+		{"2019-03-26 11:30:00", "2019-03-26 11:30:00", "", false},
+		{"2019-03-26", "2019-03-26 00:00:00", "", false},
+		{"2019-03-26T11:30:00Z", "2019-03-26 11:30:00", "", false},
+		{" 2019-03-26 ", "2019-03-26 00:00:00", "", false},
+		{"2023-06-01..2023-06-14", "2023-06-01 00:00:00", "2023-06-14 00:00:00", false},
+		{"2023-06-14..2023-06-01", "", "", true},
+		{"2023-06-01..", "", "", true},
+		{"26/03/2019", "", "", true},
+	}
+	format := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("2006-01-02 15:04:05")
+	}
+	for _, tc := range tests {
+		t.Run(tc.date, func(t *testing.T) {
+			start, end, err := galldir.ParseDateRange(tc.date)
+			if err == nil && tc.expectErr {
+				t.Fatal("expected an error")
+			}
+			if err != nil && !tc.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if format(start) != tc.start || format(end) != tc.end {
+				t.Errorf("unexpected range: %v..%v", start, end)
+			}
+		})
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	dateRange := galldir.TemplateFuncs["dateRange"].(func(time.Time, time.Time) string)
+	date := func(s string) time.Time {
+		d, err := galldir.ParseDate(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	tests := []struct {
+		start    string
+		end      string
+		expected string
+	}{
+		{"2023-06-01", "2023-06-14", "1–14 June 2023"},
+		{"2023-05-28", "2023-06-03", "28 May – 3 June 2023"},
+		{"2022-12-30", "2023-01-02", "30 December 2022 – 2 January 2023"},
+		{"2023-06-01", "2023-06-01", "1 June 2023"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.expected, func(t *testing.T) {
+			if r := dateRange(date(tc.start), date(tc.end)); r != tc.expected {
+				t.Errorf("unexpected result: %s", r)
+			}
+		})
+	}
+	if r := dateRange(date("2023-06-01"), time.Time{}); r != "1 June 2023" {
+		t.Errorf("unexpected result: %s", r)
+	}
+}
+
+func TestAlbumDateRange(t *testing.T) {
+	provider := galldir.NewProvider(http.Dir("testdata/manifest"))
+	album, err := provider.Album("/json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if album.Time.Day() != 1 || album.EndTime.Day() != 14 {
+		t.Errorf("unexpected dates: %v..%v", album.Time, album.EndTime)
+	}
+	parent, err := provider.Album("/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub := parent.Image("/json"); sub == nil || sub.EndTime.Day() != 14 {
+		t.Errorf("unexpected sub-album: %v", sub)
+	}
+}
+
+func TestDateFallback(t *testing.T) {
+	tests := []struct {
+		fallback galldir.DateFallback
+		expected string
+	}{
+		{galldir.DateFromNewestPhoto, "2019-06-02 10:00"},
+		{galldir.DateFromOldestPhoto, "2019-06-01 09:30"},
+	}
+	for _, tc := range tests {
+		t.Run(string(tc.fallback), func(t *testing.T) {
+			provider := galldir.NewProvider(http.Dir("testdata/sorted"))
+			provider.DateFallback = tc.fallback
+			album, err := provider.Album("/", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if date := album.Time.Format("2006-01-02 15:04"); date != tc.expected {
+				t.Errorf("unexpected date: %s", date)
+			}
+		})
+	}
+}
+
+func TestDateFallbackFilesOpened(t *testing.T) {
+	backend := &recordingFS{FileSystem: http.Dir("testdata")}
+	provider := galldir.NewProvider(backend)
+	provider.DateFallback = galldir.DateFromNewestPhoto
+	for _, refresh := range []bool{false, true} {
+		album, err := provider.Album("/", refresh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sub := album.Image("/sorted"); sub == nil || sub.Time.Format("2006-01-02 15:04") != "2019-06-02 10:00" {
+			t.Errorf("unexpected sub-album: %v", sub)
+		}
+	}
+	// the sub-albums' dates are worked out once, from one listing each
+	opened := make(map[string]int)
+	for _, name := range backend.opened {
+		opened[name]++
+		if name != "/" && opened[name] > 1 {
+			t.Errorf("opened %s again", name)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package galldir
+
+import (
+	"bufio"
+	"bytes"
+	"html"
+	"html/template"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/yuin/goldmark"
+)
+
+// RenderMarkdown converts Markdown to HTML. Any raw HTML in the source is
+// dropped and links with dangerous schemes (such as javascript:) are
+// removed, so the result is safe to include in a page.
+func RenderMarkdown(src string) (template.HTML, error) {
+	buf := bytes.NewBuffer(nil)
+	err := goldmark.Convert([]byte(src), buf)
+	if err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil
+}
+
+// PlainText returns the text of a fragment of HTML, such as an album's
+// description, without its markup and with runs of white space collapsed.
+// Block elements are expected to be separated by new lines, as they are in
+// rendered Markdown.
+func PlainText(h template.HTML) string {
+	var b strings.Builder
+	inTag := false
+	for _, r := range string(h) {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(html.UnescapeString(b.String())), " ")
+}
+
+// ParseCaptions parses the content of a .captions file. Each line holds a
+// photo's file name and its caption separated by a colon. Blank lines and
+// lines starting with # are ignored.
+func ParseCaptions(content string) map[string]string {
+	captions := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		captions[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return captions
+}
+
+// subHTML returns the HTML that lightgallery shows beneath the photo at
+// path with the given caption, which includes a link to the photo's own
+// page.
+func subHTML(caption, path string) string {
+	link := `<a class="galldir-permalink" href="` +
+		template.HTMLEscapeString((&url.URL{Path: path}).EscapedPath()) +
+		`?view=page">Photo page</a>`
+	if caption == "" {
+		return "<p>" + link + "</p>"
+	}
+	return "<p>" + template.HTMLEscapeString(caption) + "</p><p>" + link + "</p>"
+}
+
+func (p *Provider) getDescription(path string) template.HTML {
+	src := p.sidecar(path, p.manifest(path).Description, ".description")
+	if src == "" {
+		return ""
+	}
+	description, err := RenderMarkdown(src)
+	if err != nil {
+		p.logger().Warn("failed to render description", "album", path, "err", err)
+	}
+	return description
+}
+
+// loadCaptions fills in the Description of each photo in the album from
+// the album's manifest, its sidecar file (either photo.jpg.txt or
+// photo.txt) or, if the album has no manifest, its .captions file, in that
+// order of preference. files holds the names of every file in the album's
+// directory.
+func (p *Provider) loadCaptions(a *Album, files map[string]bool) {
+	manifest := p.manifest(a.Path)
+	var captions map[string]string
+	if manifest.source == "" {
+		captions = ParseCaptions(p.loadFile(filepath.Join(a.Path, ".captions")))
+	}
+	for i := range a.Images {
+		im := &a.Images[i]
+		if im.IsAlbum {
+			continue
+		}
+		name := filepath.Base(im.Path)
+		if im.Description = manifest.Captions[name]; im.Description != "" {
+			continue
+		}
+		for _, sidecar := range []string{
+			name + ".txt",
+			strings.TrimSuffix(name, filepath.Ext(name)) + ".txt",
+		} {
+			if files[sidecar] {
+				im.Description = p.loadFile(filepath.Join(a.Path, sidecar))
+				break
+			}
+		}
+		if im.Description == "" {
+			im.Description = captions[name]
+		}
+	}
+}
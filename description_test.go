@@ -0,0 +1,90 @@
+package galldir_test
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected string
+	}{
+		{"*hello*", "<p><em>hello</em></p>\n"},
+		{"<b>hi</b>", "<p><!-- raw HTML omitted -->hi<!-- raw HTML omitted --></p>\n"},
+		{"[x](javascript:alert(1))", `<p><a href="">x</a></p>` + "\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.src, func(t *testing.T) {
+			html, err := galldir.RenderMarkdown(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(html) != tc.expected {
+				t.Errorf("unexpected HTML: %s", html)
+			}
+		})
+	}
+}
+
+func TestParseCaptions(t *testing.T) {
+	captions := galldir.ParseCaptions("# comment\nfoo.jpg: A: B\n\nbar.jpg:baz\nnonsense\n")
+	expected := map[string]string{
+		"foo.jpg": "A: B",
+		"bar.jpg": "baz",
+	}
+	if len(captions) != len(expected) {
+		t.Fatalf("unexpected captions: %v", captions)
+	}
+	for name, caption := range expected {
+		if captions[name] != caption {
+			t.Errorf("unexpected caption for %s: %s", name, captions[name])
+		}
+	}
+}
+
+func TestAlbumDescriptions(t *testing.T) {
+	provider := galldir.NewProvider(http.Dir("testdata/described"))
+	album, err := provider.Album("/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	description := string(album.Description)
+	if !strings.Contains(description, "<em>trip</em>") || strings.Contains(description, "<script>") ||
+		strings.Contains(description, "javascript:") {
+		t.Errorf("unexpected description: %s", description)
+	}
+	expected := map[string]string{
+		"a.png": "Sidecar for a",
+		"b.png": "Caption for b",
+		"c.png": "Sidecar for c",
+		"d.png": "",
+	}
+	for _, photo := range album.Photos() {
+		if photo.Description != expected[photo.Name] {
+			t.Errorf("unexpected caption for %s: %s", photo.Name, photo.Description)
+		}
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		html     template.HTML
+		expected string
+	}{
+		{"", ""},
+		{"<p>A day at the <em>beach</em>.</p>\n", "A day at the beach."},
+		{"<h1>Fish &amp; chips</h1>\n<p>by the\nsea</p>", "Fish & chips by the sea"},
+	}
+	for _, tc := range tests {
+		t.Run(string(tc.html), func(t *testing.T) {
+			if text := galldir.PlainText(tc.html); text != tc.expected {
+				t.Errorf("unexpected text: %q", text)
+			}
+		})
+	}
+}
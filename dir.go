@@ -1,18 +1,36 @@
 package galldir
 
 import (
+	"html/template"
 	"sort"
 	"strings"
 	"time"
 )
 
-// Image specifies an image. An image may be the cover of a sub-album.
+// Image specifies an image. An image may be the cover of a sub-album, in
+// which case EndTime is set if the sub-album covers a range of dates and
+// Count, where known, is the number of photos it holds. Size is the size
+// in bytes of a photo's file.
 type Image struct {
-	Path        string
-	Name        string
-	Description string
-	Time        time.Time
-	IsAlbum     bool
+	Path        string    `json:"path"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Time        time.Time `json:"time"`
+	EndTime     time.Time `json:"endTime,omitempty"`
+	IsAlbum     bool      `json:"isAlbum"`
+	Exif        *Exif     `json:"exif,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	Count       int       `json:"count,omitempty"`
+	Size        int64     `json:"size,omitempty"`
+}
+
+// Taken returns the time the image was taken according to its EXIF data,
+// falling back to its Time.
+func (im Image) Taken() time.Time {
+	if im.Exif != nil && !im.Exif.Taken.IsZero() {
+		return im.Exif.Taken
+	}
+	return im.Time
 }
 
 // ImagesByName implements sort.Interface for []Image to do a case
@@ -33,13 +51,167 @@ func (a ImagesByTime) Len() int           { return len(a) }
 func (a ImagesByTime) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a ImagesByTime) Less(i, j int) bool { return a[j].Time.Before(a[i].Time) }
 
-// Album specifies a photo album.
+// ImagesByNaturalName implements sort.Interface for []Image to do a case
+// insensitive sort by Name, with runs of digits compared by their value so
+// that "IMG_9" comes before "IMG_10".
+type ImagesByNaturalName []Image
+
+func (a ImagesByNaturalName) Len() int      { return len(a) }
+func (a ImagesByNaturalName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a ImagesByNaturalName) Less(i, j int) bool {
+	return naturalLess(strings.ToLower(a[i].Name), strings.ToLower(a[j].Name))
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func naturalLess(a, b string) bool {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			var aNum, bNum string
+			aNum, a = splitDigits(a)
+			bNum, b = splitDigits(b)
+			trimmedA := strings.TrimLeft(aNum, "0")
+			trimmedB := strings.TrimLeft(bNum, "0")
+			if len(trimmedA) != len(trimmedB) {
+				return len(trimmedA) < len(trimmedB)
+			}
+			if trimmedA != trimmedB {
+				return trimmedA < trimmedB
+			}
+			continue
+		}
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) < len(b)
+}
+
+func splitDigits(s string) (string, string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// ImagesByTaken implements sort.Interface for []Image to sort by the time
+// the images were taken, oldest first.
+type ImagesByTaken []Image
+
+func (a ImagesByTaken) Len() int      { return len(a) }
+func (a ImagesByTaken) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a ImagesByTaken) Less(i, j int) bool {
+	return a[i].Taken().Before(a[j].Taken())
+}
+
+// SortOrder specifies the order in which the contents of an album are
+// listed.
+type SortOrder string
+
+// The available sort orders.
+const (
+	SortByName          SortOrder = "name"
+	SortByNaturalName   SortOrder = "natural"
+	SortByTime          SortOrder = "time"
+	SortByTimeReversed  SortOrder = "time-reversed"
+	SortByTaken         SortOrder = "exif"
+	SortByTakenReversed SortOrder = "exif-reversed"
+)
+
+// SortOrders lists every SortOrder.
+var SortOrders = []SortOrder{
+	SortByName, SortByNaturalName, SortByTime, SortByTimeReversed, SortByTaken,
+	SortByTakenReversed,
+}
+
+// ParseSortOrder returns the SortOrder named s, or false if there is none.
+func ParseSortOrder(s string) (SortOrder, bool) {
+	for _, order := range SortOrders {
+		if string(order) == s {
+			return order, true
+		}
+	}
+	return "", false
+}
+
+// Label returns a human friendly name for the SortOrder.
+func (o SortOrder) Label() string {
+	switch o {
+	case SortByNaturalName:
+		return "Number"
+	case SortByTime:
+		return "Newest"
+	case SortByTimeReversed:
+		return "Oldest"
+	case SortByTaken:
+		return "Date taken"
+	case SortByTakenReversed:
+		return "Latest taken"
+	}
+	return "Name"
+}
+
+func (o SortOrder) sort(images []Image) {
+	switch o {
+	case SortByName:
+		sort.Sort(ImagesByName(images))
+	case SortByNaturalName:
+		sort.Sort(ImagesByNaturalName(images))
+	case SortByTime:
+		sort.Sort(ImagesByTime(images))
+	case SortByTimeReversed:
+		sort.Sort(sort.Reverse(ImagesByTime(images)))
+	case SortByTaken:
+		sort.Sort(ImagesByTaken(images))
+	case SortByTakenReversed:
+		sort.Sort(sort.Reverse(ImagesByTaken(images)))
+	}
+}
+
+// Album specifies a photo album. Breadcrumbs lead from the root of the
+// gallery to the album itself. If Sort is empty photos are sorted by name
+// and sub-albums by time. EndTime is set if the album covers a range of
+// dates starting at Time. A Virtual album, such as the photos with a tag,
+// gathers images from many directories rather than being read from one.
 type Album struct {
-	Path        string
-	Name        string
-	Description string
-	Images      []Image
-	Time        time.Time
+	Path        string        `json:"path"`
+	Name        string        `json:"name"`
+	Description template.HTML `json:"description,omitempty"`
+	Images      []Image       `json:"-"`
+	Time        time.Time     `json:"time"`
+	EndTime     time.Time     `json:"endTime,omitempty"`
+	Breadcrumbs []Breadcrumb  `json:"breadcrumbs"`
+	Sort        SortOrder     `json:"sort,omitempty"`
+	Virtual     bool          `json:"virtual,omitempty"`
+	// Prev and Next lead to the albums either side of this one, for albums
+	// that form a sequence such as the months of the timeline.
+	Prev *Breadcrumb `json:"prev,omitempty"`
+	Next *Breadcrumb `json:"next,omitempty"`
+	// realPaths is set for virtual albums, such as search results, whose
+	// images keep their real paths.
+	realPaths bool
+}
+
+// RealPath returns the path in the backend of the image at path within the
+// album. The images of most virtual albums have paths beneath the album's
+// own, formed by appending their real paths.
+func (a *Album) RealPath(path string) string {
+	prefix := strings.TrimSuffix(a.Path, "/")
+	if !a.Virtual || a.realPaths || !strings.HasPrefix(path, prefix+"/") {
+		return path
+	}
+	return strings.TrimPrefix(path, prefix)
+}
+
+// Parent returns the Breadcrumb of the album containing this one, or nil
+// for the root album.
+func (a *Album) Parent() *Breadcrumb {
+	if len(a.Breadcrumbs) < 2 {
+		return nil
+	}
+	return &a.Breadcrumbs[len(a.Breadcrumbs)-2]
 }
 
 // Image returns an Image from an Album or nil if it cannot be found
@@ -63,16 +235,90 @@ func (a *Album) images(isAlbum bool) []Image {
 	return result
 }
 
+func (a *Album) sortOrder(defaultOrder SortOrder) SortOrder {
+	if a.Sort == "" {
+		return defaultOrder
+	}
+	return a.Sort
+}
+
+// byTaken returns true if the order is by the time photos were taken,
+// which needs their EXIF data.
+func (o SortOrder) byTaken() bool {
+	return o == SortByTaken || o == SortByTakenReversed
+}
+
 // Photos returns a list of images from an album that are not sub-albums
 func (a *Album) Photos() []Image {
 	images := a.images(false)
-	sort.Sort(ImagesByName(images))
+	a.sortOrder(SortByName).sort(images)
 	return images
 }
 
 // Albums returns a list of images from an album that are sub-albums
 func (a *Album) Albums() []Image {
 	images := a.images(true)
-	sort.Sort(ImagesByTime(images))
+	a.sortOrder(SortByTime).sort(images)
 	return images
 }
+
+// AlbumPage is one page of the contents of an Album. Sub-albums come
+// before photos.
+type AlbumPage struct {
+	Albums []Image `json:"albums"`
+	Photos []Image `json:"photos"`
+	Page   int     `json:"page"`
+	Pages  int     `json:"pages"`
+}
+
+// Page returns the page numbered page, counting from 1, of the album's
+// contents when divided into pages of size items. It returns false if
+// there is no such page. An empty album has a single empty page.
+func (a *Album) Page(page, size int) (*AlbumPage, bool) {
+	albums, photos := a.Albums(), a.Photos()
+	count := len(albums) + len(photos)
+	pages := (count + size - 1) / size
+	if pages == 0 {
+		pages = 1
+	}
+	if page < 1 || page > pages {
+		return nil, false
+	}
+	start := (page - 1) * size
+	end := start + size
+	if end > count {
+		end = count
+	}
+	return &AlbumPage{
+		Albums: albums[clamp(start, len(albums)):clamp(end, len(albums))],
+		Photos: photos[clamp(start-len(albums), len(photos)):clamp(end-len(albums), len(photos))],
+		Page:   page,
+		Pages:  pages,
+	}, true
+}
+
+func clamp(i, max int) int {
+	if i < 0 {
+		return 0
+	}
+	if i > max {
+		return max
+	}
+	return i
+}
+
+// PrevPage returns the number of the previous page, or 0 if there is none.
+func (p *AlbumPage) PrevPage() int {
+	if p.Page <= 1 {
+		return 0
+	}
+	return p.Page - 1
+}
+
+// NextPage returns the number of the next page, or 0 if there is none.
+func (p *AlbumPage) NextPage() int {
+	if p.Page >= p.Pages {
+		return 0
+	}
+	return p.Page + 1
+}
@@ -1,6 +1,7 @@
 package galldir_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -76,3 +77,80 @@ func TestPhotos(t *testing.T) {
 func TestAlbums(t *testing.T) {
 	testImages(t, testAlbum.Albums(), []string{"qux", "foo"})
 }
+
+func TestParent(t *testing.T) {
+	if parent := testAlbum.Parent(); parent != nil {
+		t.Errorf("unexpected parent: %v", parent)
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		page   int
+		size   int
+		albums []string
+		photos []string
+		pages  int
+		ok     bool
+	}{
+		{1, 10, []string{"qux", "foo"}, []string{"bar", "baz"}, 1, true},
+		{1, 3, []string{"qux", "foo"}, []string{"bar"}, 2, true},
+		{2, 3, []string{}, []string{"baz"}, 2, true},
+		{2, 2, []string{}, []string{"bar", "baz"}, 2, true},
+		{3, 2, nil, nil, 0, false},
+		{0, 2, nil, nil, 0, false},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d/%d", tc.page, tc.size), func(t *testing.T) {
+			page, ok := testAlbum.Page(tc.page, tc.size)
+			if ok != tc.ok {
+				t.Fatalf("unexpected ok: %v", ok)
+			}
+			if !ok {
+				return
+			}
+			testImages(t, page.Albums, tc.albums)
+			testImages(t, page.Photos, tc.photos)
+			if page.Pages != tc.pages {
+				t.Errorf("unexpected number of pages: %d", page.Pages)
+			}
+		})
+	}
+}
+
+func TestEmptyPage(t *testing.T) {
+	page, ok := (&galldir.Album{}).Page(1, 10)
+	if !ok {
+		t.Fatal("expected a page")
+	}
+	if page.Pages != 1 || page.PrevPage() != 0 || page.NextPage() != 0 {
+		t.Errorf("unexpected page: %v", page)
+	}
+}
+
+func TestSortOrder(t *testing.T) {
+	images := []galldir.Image{
+		{Name: "IMG_10", Time: time.Unix(3, 0)},
+		{Name: "img_9", Time: time.Unix(1, 0), Exif: &galldir.Exif{Taken: time.Unix(4, 0)}},
+		{Name: "IMG_009a", Time: time.Unix(2, 0)},
+	}
+	tests := []struct {
+		order    string
+		expected []string
+	}{
+		{"", []string{"IMG_009a", "IMG_10", "img_9"}},
+		{"name", []string{"IMG_009a", "IMG_10", "img_9"}},
+		{"natural", []string{"img_9", "IMG_009a", "IMG_10"}},
+		{"time", []string{"IMG_10", "IMG_009a", "img_9"}},
+		{"time-reversed", []string{"img_9", "IMG_009a", "IMG_10"}},
+		{"exif", []string{"IMG_009a", "IMG_10", "img_9"}},
+		{"bogus", []string{"IMG_009a", "IMG_10", "img_9"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.order, func(t *testing.T) {
+			order, _ := galldir.ParseSortOrder(tc.order)
+			album := &galldir.Album{Images: images, Sort: order}
+			testImages(t, album.Photos(), tc.expected)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package galldir
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// DownloadSizes are the sizes, by name, to which photos may be resized
+// when downloading an album with ?download=zip&size=name.
+var DownloadSizes = map[string]int{
+	"medium": 1024,
+	"large":  2048,
+}
+
+func isDownload(r *http.Request) bool {
+	return r.URL.Query().Get("download") == "zip"
+}
+
+// OpenImage opens an image stored in the backend for reading, without
+// caching it.
+func (p *Provider) OpenImage(path string) (io.ReadCloser, error) {
+	if !IsImage(path) {
+		return nil, errors.New("not an image")
+	}
+	return p.open(path)
+}
+
+// ScaledImage decodes an image stored in the backend, scaled to the size,
+// without caching either the image or the result.
+func (p *Provider) ScaledImage(path string, size int) (image.Image, error) {
+	src, err := p.OpenImage(path)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	defer p.Metrics.decoding()()
+	return scale(src, size)
+}
+
+// downloadEntry is a photo to be added to a ZIP archive.
+type downloadEntry struct {
+	name  string
+	album *Album
+	photo Image
+}
+
+// downloadEntries lists the photos of the album, and of its sub-albums if
+// recursive is set, named by their paths relative to root.
+func (s *Server) downloadEntries(root, album *Album, recursive bool) ([]downloadEntry, error) {
+	var entries []downloadEntry
+	prefix := strings.TrimSuffix(root.Path, "/") + "/"
+	for _, photo := range album.Photos() {
+		entries = append(entries, downloadEntry{
+			name:  strings.TrimPrefix(photo.Path, prefix),
+			album: album,
+			photo: photo,
+		})
+	}
+	if !recursive {
+		return entries, nil
+	}
+	for _, im := range album.Albums() {
+		sub, err := s.lookupAlbum(im.Path)
+		if err != nil {
+			return nil, err
+		}
+		if sub.Virtual && !root.Virtual {
+			// such as recent photos, which are already in their own albums
+			continue
+		}
+		subEntries, err := s.downloadEntries(root, sub, true)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, subEntries...)
+	}
+	return entries, nil
+}
+
+// download streams a ZIP archive of the album's photos. Adding
+// &recursive=1 includes its sub-albums and &size= resizes the photos to
+// one of the DownloadSizes.
+func (s *Server) download(w http.ResponseWriter, r *http.Request, album *Album) {
+	if s.DownloadLimit <= 0 {
+		s.error(w, r, http.StatusNotFound, fmt.Errorf("downloads disabled: %s", r.URL))
+		return
+	}
+	size := 0
+	if name := r.URL.Query().Get("size"); name != "" {
+		var ok bool
+		if size, ok = DownloadSizes[name]; !ok {
+			s.error(w, r, http.StatusBadRequest, fmt.Errorf("unknown download size %q", name))
+			return
+		}
+	}
+	_, recursive := requestParamInt(r, "recursive")
+	entries, err := s.downloadEntries(album, album, recursive)
+	if err != nil {
+		s.error(w, r, http.StatusInternalServerError, fmt.Errorf("failed to list %s for download: %v", album.Path, err))
+		return
+	}
+	var total int64
+	for _, entry := range entries {
+		total += entry.photo.Size
+	}
+	if total > s.DownloadLimit {
+		s.explainError(w, r, http.StatusForbidden,
+			fmt.Errorf("download of %s is too large: %d bytes, over the limit of %d", album.Path, total, s.DownloadLimit),
+			fmt.Sprintf("These photos total %d MB, more than the %d MB that may be downloaded at once.", megabytes(total), megabytes(s.DownloadLimit)))
+		return
+	}
+
+	// the first photo is read before the response begins, so that a
+	// download which fails from the start is reported as an error
+	var first *zipContent
+	if len(entries) > 0 {
+		if first, err = s.openZipEntry(entries[0], size); err != nil {
+			s.error(w, r, http.StatusInternalServerError, fmt.Errorf("failed to download %s: %v", entries[0].photo.Path, err))
+			return
+		}
+	}
+	name := path.Base(album.Path)
+	if name == "/" || name == "." {
+		name = "gallery"
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name + ".zip"}))
+	zw := zip.NewWriter(w)
+	var missing []string
+	for i, entry := range entries {
+		content := first
+		if i > 0 {
+			content, err = s.openZipEntry(entry, size)
+		}
+		if err == nil {
+			err = content.write(zw, name)
+		}
+		if err != nil {
+			// the response has begun, so the photo can only be left out
+			// and listed in the archive's comment
+			requestLogger(r).Error("failed to add photo to download", "photo", entry.photo.Path, "err", err)
+			missing = append(missing, entry.name)
+		}
+	}
+	if len(missing) > 0 {
+		zw.SetComment("These photos could not be downloaded:\n" + strings.Join(missing, "\n"))
+	}
+	if err := zw.Close(); err != nil {
+		requestLogger(r).Error("failed to finish download", "err", err)
+	}
+}
+
+// megabytes rounds size up to whole megabytes.
+func megabytes(size int64) int64 {
+	return (size + 1<<20 - 1) >> 20
+}
+
+// zipContent is a photo opened to be added to a ZIP archive, either as
+// it is stored or resized.
+type zipContent struct {
+	entry  downloadEntry
+	src    io.ReadCloser
+	scaled image.Image
+}
+
+func (s *Server) openZipEntry(entry downloadEntry, size int) (*zipContent, error) {
+	realPath := entry.album.RealPath(entry.photo.Path)
+	if size > 0 {
+		// resized photos are not cached, as few are downloaded twice
+		scaled, err := s.Provider.ScaledImage(realPath, size)
+		if err != nil {
+			return nil, err
+		}
+		entry.name = strings.TrimSuffix(entry.name, path.Ext(entry.name)) + ".jpg"
+		return &zipContent{entry: entry, scaled: scaled}, nil
+	}
+	src, err := s.Provider.OpenImage(realPath)
+	if err != nil {
+		return nil, err
+	}
+	return &zipContent{entry: entry, src: src}, nil
+}
+
+// write adds the photo to the archive in dir, closing it.
+func (c *zipContent) write(zw *zip.Writer, dir string) error {
+	if c.src != nil {
+		defer c.src.Close()
+	}
+	dst, err := createZipEntry(zw, path.Join(dir, c.entry.name), c.entry.photo)
+	if err != nil {
+		return err
+	}
+	if c.scaled != nil {
+		return jpeg.Encode(dst, c.scaled, nil)
+	}
+	_, err = io.Copy(dst, c.src)
+	return err
+}
+
+func createZipEntry(zw *zip.Writer, name string, photo Image) (io.Writer, error) {
+	return zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store, // photos are already compressed
+		Modified: photo.Time,
+	})
+}
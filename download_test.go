@@ -0,0 +1,217 @@
+package galldir_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func zipNames(t *testing.T, body []byte) []string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestServeDownload(t *testing.T) {
+	provider := galldir.NewProvider(http.Dir("testdata"))
+	server := &galldir.Server{
+		Provider:      provider,
+		Index:         galldir.NewIndex(provider),
+		DownloadLimit: 1 << 20,
+		RecentPhotos:  10,
+	}
+	server.Index.Crawl()
+	tests := []struct {
+		url   string
+		names []string
+	}{
+		{"/manifest/?download=zip", []string{"manifest/a.png", "manifest/b.png"}},
+		{"/manifest/?download=zip&recursive=1", []string{"manifest/a.png", "manifest/b.png"}},
+		{"/sorted/?download=zip&size=medium", []string{"sorted/IMG_10.jpg", "sorted/IMG_9.jpg", "sorted/img_2.jpg"}},
+		{"/album/?download=zip&recursive=1", []string{"album/subalbum/icon.png"}},
+		{"/tag/beach/?download=zip", []string{"beach/manifest/a.png", "beach/tagged/iptc.jpg", "beach/tagged/xmp.jpg"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "application/zip" {
+				t.Errorf("unexpected content type: %s", ct)
+			}
+			if names := zipNames(t, w.Body.Bytes()); strings.Join(names, " ") != strings.Join(tc.names, " ") {
+				t.Errorf("unexpected contents: %v", names)
+			}
+		})
+	}
+
+	if _, cached := provider.Cache.Get(galldir.ThumbName("thumb", 1024, "/sorted/IMG_9.jpg")); cached {
+		t.Error("resized photo cached by download")
+	}
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/?download=zip&recursive=1", nil))
+	for _, name := range zipNames(t, w.Body.Bytes()) {
+		if strings.HasPrefix(name, "gallery/recent/") {
+			t.Errorf("recent photos downloaded twice: %s", name)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/tagged/?download=zip", nil))
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	original, err := os.ReadFile("testdata/tagged/xmp.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range zr.File {
+		if f.Name != "tagged/xmp.jpg" {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content := new(bytes.Buffer)
+		content.ReadFrom(r)
+		if !bytes.Equal(content.Bytes(), original) {
+			t.Error("downloaded photo differs from the original")
+		}
+	}
+}
+
+func TestServeDownloadRefused(t *testing.T) {
+	tests := []struct {
+		url    string
+		limit  int64
+		status int
+	}{
+		{"/subalbum/?download=zip", 0, http.StatusNotFound},
+		{"/subalbum/?download=zip", 10, http.StatusForbidden},
+		{"/subalbum/?download=zip&size=huge", 1 << 20, http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			server := testServer(t)
+			server.DownloadLimit = tc.limit
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.status {
+				t.Errorf("unexpected status: %d", w.Code)
+			}
+			if tc.status == http.StatusForbidden && !strings.Contains(w.Body.String(), "more than the 1 MB that may be downloaded") {
+				t.Errorf("limit not explained: %s", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestServeDownloadFailure(t *testing.T) {
+	broken, err := os.ReadFile("testdata/broken/broken.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	photo, err := os.ReadFile("testdata/sorted/IMG_9.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		first, second []byte
+		status        int
+		names         string
+		comment       string
+	}{
+		{broken, photo, http.StatusInternalServerError, "", ""},
+		{photo, broken, http.StatusOK, "album/a.jpg", "These photos could not be downloaded:\nb.jpg"},
+	}
+	for _, tc := range tests {
+		dir := t.TempDir()
+		if err := os.Mkdir(filepath.Join(dir, "album"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "album", "a.jpg"), tc.first, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "album", "b.jpg"), tc.second, 0644); err != nil {
+			t.Fatal(err)
+		}
+		server := testServer(t)
+		server.Provider = galldir.NewProvider(http.Dir(dir))
+		server.DownloadLimit = 1 << 20
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest("GET", "/album/?download=zip&size=medium", nil))
+		if w.Code != tc.status {
+			t.Fatalf("unexpected status: %d", w.Code)
+		}
+		if tc.status != http.StatusOK {
+			if ct := w.Header().Get("Content-Type"); strings.Contains(ct, "zip") {
+				t.Errorf("failed download sent as %s", ct)
+			}
+			continue
+		}
+		body := w.Body.Bytes()
+		if names := strings.Join(zipNames(t, body), " "); names != tc.names {
+			t.Errorf("unexpected photos downloaded: %s", names)
+		}
+		zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if zr.Comment != tc.comment {
+			t.Errorf("unexpected comment: %q", zr.Comment)
+		}
+	}
+}
+
+func TestServeSearchDownload(t *testing.T) {
+	dir := t.TempDir()
+	photo, err := os.ReadFile("testdata/sorted/IMG_9.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "searching"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "searching", "pic.jpg"), photo, 0644); err != nil {
+		t.Fatal(err)
+	}
+	provider := galldir.NewProvider(http.Dir(dir))
+	server := &galldir.Server{
+		Provider:      provider,
+		Index:         galldir.NewIndex(provider),
+		DownloadLimit: 1 << 20,
+	}
+	server.Index.Crawl()
+
+	// search results keep the real paths of their photos
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/search?q=pic&download=zip", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+	if names := zipNames(t, w.Body.Bytes()); strings.Join(names, " ") != "search/searching/pic.jpg" {
+		t.Errorf("unexpected contents: %v", names)
+	}
+}
@@ -0,0 +1,240 @@
+package galldir
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rwcarlsen/goexif/exif"
+)
+
+const (
+	// EXIF data is near the start of a JPEG, so there is no need to read
+	// the whole file to find it.
+	exifReadLimit = 256 * 1024
+	exifWorkers   = 8
+)
+
+// Exif holds the information read from a photo's EXIF data.
+type Exif struct {
+	Taken        time.Time `json:"taken"`
+	Camera       string    `json:"camera,omitempty"`
+	Lens         string    `json:"lens,omitempty"`
+	ExposureTime string    `json:"exposureTime,omitempty"`
+	FNumber      string    `json:"fNumber,omitempty"`
+	ISO          string    `json:"iso,omitempty"`
+	FocalLength  string    `json:"focalLength,omitempty"`
+	Location     *Location `json:"location,omitempty"`
+}
+
+// Location is the place a photo was taken, in decimal degrees.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ReadExif reads the EXIF data from the start of a JPEG image.
+func ReadExif(r io.Reader) (*Exif, error) {
+	x, err := exif.Decode(io.LimitReader(r, exifReadLimit))
+	if err != nil {
+		return nil, err
+	}
+	e := &Exif{
+		Camera: exifCamera(x),
+		Lens:   exifString(x, exif.LensModel),
+		ISO:    exifString(x, exif.ISOSpeedRatings),
+	}
+	if taken, err := x.DateTime(); err == nil {
+		e.Taken = taken
+	}
+	if lat, long, err := x.LatLong(); err == nil {
+		e.Location = &Location{Latitude: lat, Longitude: long}
+	}
+	if num, denom, ok := exifRat(x, exif.ExposureTime); ok {
+		if num < denom {
+			e.ExposureTime = fmt.Sprintf("%d/%d s", num, denom)
+		} else {
+			e.ExposureTime = fmt.Sprintf("%g s", float64(num)/float64(denom))
+		}
+	}
+	if num, denom, ok := exifRat(x, exif.FNumber); ok {
+		e.FNumber = fmt.Sprintf("f/%g", float64(num)/float64(denom))
+	}
+	if num, denom, ok := exifRat(x, exif.FocalLength); ok {
+		e.FocalLength = fmt.Sprintf("%g mm", float64(num)/float64(denom))
+	}
+	return e, nil
+}
+
+func exifString(x *exif.Exif, name exif.FieldName) string {
+	tag, err := x.Get(name)
+	if err != nil {
+		return ""
+	}
+	if s, err := tag.StringVal(); err == nil {
+		return strings.TrimSpace(s)
+	}
+	return strings.Trim(tag.String(), `"`)
+}
+
+func exifRat(x *exif.Exif, name exif.FieldName) (int64, int64, bool) {
+	tag, err := x.Get(name)
+	if err != nil {
+		return 0, 0, false
+	}
+	num, denom, err := tag.Rat2(0)
+	if err != nil || denom == 0 {
+		return 0, 0, false
+	}
+	return num, denom, true
+}
+
+// exifCamera combines the make and model of the camera, avoiding
+// repetition as many manufacturers include the make in the model.
+func exifCamera(x *exif.Exif) string {
+	cameraMake := exifString(x, exif.Make)
+	model := exifString(x, exif.Model)
+	if strings.HasPrefix(strings.ToLower(model), strings.ToLower(cameraMake)) {
+		return model
+	}
+	return strings.TrimSpace(cameraMake + " " + model)
+}
+
+func hasExif(path string) bool {
+	ext := filepath.Ext(path)
+	return strings.EqualFold(ext, ".jpg") || strings.EqualFold(ext, ".jpeg")
+}
+
+// embedded holds the metadata embedded in a photo.
+type embedded struct {
+	exif     *Exif
+	keywords []string
+}
+
+// embedded returns the (possibly cached) metadata embedded in the image at
+// path. Both are read together so that the image is only opened once.
+func (p *Provider) embedded(path string) *embedded {
+	cacheName := CacheName("embedded", path)
+	cacheVal, cached := p.cacheGet(cacheName)
+	if cached {
+		return cacheVal.(*embedded)
+	}
+	e := &embedded{}
+	f, err := p.open(path)
+	if err == nil {
+		head, _ := ioutil.ReadAll(io.LimitReader(f, exifReadLimit))
+		f.Close()
+		// photos without usable EXIF data are simply left without it
+		e.exif, _ = ReadExif(bytes.NewReader(head))
+		e.keywords = ReadKeywords(bytes.NewReader(head))
+	}
+	p.Cache.Set(cacheName, e, fileTimeout)
+	return e
+}
+
+// Exif returns the (possibly cached) EXIF data of the image at path, or
+// nil if it has none.
+func (p *Provider) Exif(path string) *Exif {
+	if !hasExif(path) {
+		return nil
+	}
+	return p.embedded(path).exif
+}
+
+// Location returns the (possibly cached) location at which the image at
+// path was taken, or nil if it is not known.
+func (p *Provider) Location(path string) *Location {
+	if e := p.Exif(path); e != nil {
+		return e.Location
+	}
+	return nil
+}
+
+// Keywords returns the (possibly cached) keywords embedded in the image at
+// path.
+func (p *Provider) Keywords(path string) []string {
+	if !hasExif(path) {
+		return nil
+	}
+	return p.embedded(path).keywords
+}
+
+// loadEmbedded fills in the EXIF data of the photos amongst images, and
+// adds their embedded keywords to their tags. If cachedOnly is set only
+// metadata that is already cached is used, and the backend is not read.
+func (p *Provider) loadEmbedded(images []Image, cachedOnly bool) {
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, exifWorkers)
+	for i := range images {
+		im := &images[i]
+		if im.IsAlbum || !hasExif(im.Path) {
+			continue
+		}
+		if cachedOnly {
+			if cacheVal, cached := p.Cache.Get(CacheName("embedded", im.Path)); cached {
+				e := cacheVal.(*embedded)
+				im.Exif = e.exif
+				im.Tags = mergeTags(im.Tags, e.keywords)
+			}
+			continue
+		}
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(im *Image) {
+			defer wg.Done()
+			im.Exif = p.Exif(im.Path)
+			im.Tags = mergeTags(im.Tags, p.Keywords(im.Path))
+			<-workers
+		}(im)
+	}
+	wg.Wait()
+}
+
+// embeddedAlbum is a copy of an album with the embedded metadata of its
+// photos, cached for as long as the album it was made from is.
+type embeddedAlbum struct {
+	source *Album
+	album  *Album
+}
+
+// Embedded returns a copy of the album with the EXIF data of its photos
+// filled in and their embedded keywords added to their tags, reading them
+// from the backend if they aren't cached. Loading an album leaves them out,
+// as reading them means opening every photo.
+func (p *Provider) Embedded(album *Album) *Album {
+	return p.withEmbedded(album, true)
+}
+
+// withEmbedded returns a copy of the album with the embedded metadata of
+// its photos, reading those not cached from the backend only if read is
+// set. Virtual albums are made from photos that already have it.
+func (p *Provider) withEmbedded(album *Album, read bool) *Album {
+	if album.Virtual {
+		return album
+	}
+	cacheName := CacheName("embeddedalbum", album.Path)
+	if cacheVal, cached := p.cacheGet(cacheName); cached {
+		if e := cacheVal.(*embeddedAlbum); e.source == album {
+			return e.album
+		}
+	}
+	result := *album
+	result.Images = make([]Image, len(album.Images))
+	copy(result.Images, album.Images)
+	for i := range result.Images {
+		// the album's own tags must not be appended to
+		result.Images[i].Tags = append([]string(nil), album.Images[i].Tags...)
+	}
+	p.loadEmbedded(result.Images, !read)
+	// only the album the Provider has cached is kept, so that copies of
+	// it cannot replace it
+	if loaded, ok := p.Cache.Get(CacheName("album", album.Path)); read && ok && loaded == album {
+		p.Cache.SetDefault(cacheName, &embeddedAlbum{source: album, album: &result})
+	}
+	return &result
+}
@@ -0,0 +1,79 @@
+package galldir_test
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestReadExif(t *testing.T) {
+	f, err := os.Open("testdata/sorted/IMG_9.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	e, err := galldir.ReadExif(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if taken := e.Taken.Format("2006-01-02 15:04:05"); taken != "2019-06-02 10:00:00" {
+		t.Errorf("unexpected time taken: %s", taken)
+	}
+	expected := galldir.Exif{
+		Taken:        e.Taken,
+		Camera:       "Canon EOS 5D",
+		ExposureTime: "1/250 s",
+		FNumber:      "f/2.8",
+		ISO:          "200",
+		FocalLength:  "35 mm",
+		Location:     e.Location,
+	}
+	if *e != expected {
+		t.Errorf("unexpected EXIF: %v", e)
+	}
+	if l := e.Location; l == nil || math.Abs(l.Latitude-51.5007) > 1e-4 || math.Abs(l.Longitude+0.1246) > 1e-4 {
+		t.Errorf("unexpected location: %v", l)
+	}
+}
+
+func TestProviderExif(t *testing.T) {
+	tests := []struct {
+		path   string
+		camera string
+	}{
+		{"/IMG_10.jpg", "FUJIFILM X-T3"},
+		{"/img_2.png", ""},
+		{"/not_there.jpg", ""},
+	}
+	provider := galldir.NewProvider(http.Dir("testdata/sorted"))
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			var camera string
+			if e := provider.Exif(tc.path); e != nil {
+				camera = e.Camera
+			}
+			if camera != tc.camera {
+				t.Errorf("unexpected camera: %s", camera)
+			}
+		})
+	}
+}
+
+func TestEmbeddedCached(t *testing.T) {
+	server := testServer(t)
+	album, err := server.Provider.Album("/subalbum/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	embedded := server.Provider.Embedded(album)
+	for _, url := range []string{"/subalbum/?sort=exif", "/subalbum/?sort=name", "/subalbum/"} {
+		server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", url, nil))
+		if server.Provider.Embedded(album) != embedded {
+			t.Errorf("%s: replaced the cached album", url)
+		}
+	}
+}
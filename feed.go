@@ -0,0 +1,161 @@
+package galldir
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	feedName    = "feed.atom"
+	feedEntries = 50
+	feedThumb   = 500
+	atomNS      = "http://www.w3.org/2005/Atom"
+)
+
+// AtomFeed is an Atom feed of the newest contents of an album.
+type AtomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	NS      string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  AtomPerson  `xml:"author"`
+	Links   []AtomLink  `xml:"link"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+// AtomPerson names the author of a feed.
+type AtomPerson struct {
+	Name string `xml:"name"`
+}
+
+// AtomLink is a link from a feed or entry. Enclosures link to a thumbnail
+// of the entry's photo or album.
+type AtomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+// AtomContent is the HTML content of an entry.
+type AtomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// AtomEntry is a sub-album or photo in an AtomFeed.
+type AtomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []AtomLink  `xml:"link"`
+	Content AtomContent `xml:"content"`
+}
+
+// absoluteURL returns the URL of path, with any query, on the gallery at
+// baseURL.
+func absoluteURL(baseURL, path, query string) string {
+	u := strings.TrimSuffix(baseURL, "/") + (&url.URL{Path: path}).EscapedPath()
+	if query != "" {
+		u += "?" + query
+	}
+	return u
+}
+
+func atomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// NewFeed returns a feed of the newest sub-albums and photos of the album,
+// at most limit of them, with URLs on the gallery at baseURL.
+func NewFeed(album *Album, baseURL string, limit int) *AtomFeed {
+	albumURL := absoluteURL(baseURL, album.Path, "")
+	feed := &AtomFeed{
+		NS:     atomNS,
+		ID:     albumURL,
+		Title:  album.Name,
+		Author: AtomPerson{Name: "galldir"},
+		Links: []AtomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: absoluteURL(baseURL, path.Join(album.Path, feedName), "")},
+			{Rel: "alternate", Type: "text/html", Href: albumURL},
+		},
+	}
+	images := append(album.Albums(), album.Photos()...)
+	sort.Stable(ImagesByTime(images))
+	if len(images) > limit {
+		images = images[:limit]
+	}
+	updated := album.Time
+	for _, im := range images {
+		if im.Time.After(updated) {
+			updated = im.Time
+		}
+		feed.Entries = append(feed.Entries, newFeedEntry(im, baseURL))
+	}
+	feed.Updated = atomTime(updated)
+	return feed
+}
+
+func newFeedEntry(im Image, baseURL string) AtomEntry {
+	id := absoluteURL(baseURL, im.Path, "")
+	link := id
+	if !im.IsAlbum {
+		link = absoluteURL(baseURL, im.Path, "view=page")
+	}
+	thumb := absoluteURL(baseURL, im.Path, fmt.Sprintf("thumb=%d", feedThumb))
+	content := `<p><a href="` + template.HTMLEscapeString(link) + `"><img src="` +
+		template.HTMLEscapeString(thumb) + `" alt="" /></a></p>`
+	if im.Description != "" {
+		content += "<p>" + template.HTMLEscapeString(im.Description) + "</p>"
+	}
+	return AtomEntry{
+		ID:      id,
+		Title:   im.Name,
+		Updated: atomTime(im.Time),
+		Links: []AtomLink{
+			{Rel: "alternate", Type: "text/html", Href: link},
+			{Rel: "enclosure", Type: "image/jpeg", Href: thumb},
+		},
+		Content: AtomContent{Type: "html", Body: content},
+	}
+}
+
+// baseURL returns the public URL of the gallery, taken from the request
+// and the path the gallery is mounted at if the Server has not been given
+// one.
+func (s *Server) baseURL(r *http.Request) string {
+	if s.BaseURL != "" {
+		return s.BaseURL
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + s.pathPrefix(r)
+}
+
+func (s *Server) feed(w http.ResponseWriter, r *http.Request) {
+	albumPath := strings.TrimSuffix(r.URL.Path, feedName)
+	album, err := s.exactAlbum(albumPath)
+	if err != nil {
+		s.error(w, r, http.StatusNotFound, err)
+		return
+	}
+	album = s.withRecent(album)
+	buf := bytes.NewBufferString(xml.Header)
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(NewFeed(album, s.baseURL(r), feedEntries)); err != nil {
+		s.error(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	serveBody(w, r, "application/atom+xml; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,94 @@
+package galldir_test
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestNewFeed(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, time.June, d, 12, 0, 0, 0, time.UTC)
+	}
+	album := &galldir.Album{
+		Path: "/holiday/",
+		Name: "Holiday",
+		Time: day(1),
+		Images: []galldir.Image{
+			{Path: "/holiday/day one", Name: "Day One", Time: day(2), IsAlbum: true},
+			{Path: "/holiday/a.jpg", Name: "a.jpg", Time: day(3), Description: "Fish & chips"},
+			{Path: "/holiday/b.jpg", Name: "b.jpg", Time: day(5)},
+			{Path: "/holiday/c.jpg", Name: "c.jpg", Time: day(4)},
+		},
+	}
+	feed := galldir.NewFeed(album, "https://photos.example.com/", 3)
+	if feed.ID != "https://photos.example.com/holiday/" || feed.Title != "Holiday" {
+		t.Errorf("unexpected feed: %s %s", feed.ID, feed.Title)
+	}
+	if feed.Links[0].Href != "https://photos.example.com/holiday/feed.atom" {
+		t.Errorf("unexpected self link: %s", feed.Links[0].Href)
+	}
+	if feed.Updated != "2023-06-05T12:00:00Z" {
+		t.Errorf("unexpected update time: %s", feed.Updated)
+	}
+	var titles []string
+	for _, entry := range feed.Entries {
+		titles = append(titles, entry.Title)
+	}
+	if strings.Join(titles, ",") != "b.jpg,c.jpg,a.jpg" {
+		t.Errorf("unexpected entries: %v", titles)
+	}
+
+	feed = galldir.NewFeed(album, "https://photos.example.com", 10)
+	entry := feed.Entries[3]
+	if entry.ID != "https://photos.example.com/holiday/day%20one" || entry.Links[0].Href != entry.ID {
+		t.Errorf("unexpected album entry: %v", entry)
+	}
+	entry = feed.Entries[2]
+	if entry.Links[0].Href != "https://photos.example.com/holiday/a.jpg?view=page" ||
+		entry.Links[1].Rel != "enclosure" ||
+		entry.Links[1].Href != "https://photos.example.com/holiday/a.jpg?thumb=500" ||
+		!strings.Contains(entry.Content.Body, "<p>Fish &amp; chips</p>") {
+		t.Errorf("unexpected photo entry: %v", entry)
+	}
+}
+
+func TestServeFeed(t *testing.T) {
+	tests := []struct {
+		url      string
+		baseURL  string
+		status   int
+		contains string
+	}{
+		{"/feed.atom", "", http.StatusOK, `<id>http://example.com/subalbum</id>`},
+		{"/subalbum/feed.atom", "https://photos.example.com", http.StatusOK, `<link rel="enclosure" type="image/jpeg" href="https://photos.example.com/subalbum/icon.png?thumb=500">`},
+		{"/not_there/feed.atom", "", http.StatusNotFound, "Not Found"},
+		{"/subalbum/", "", http.StatusOK, `<link rel="alternate" type="application/atom+xml" title="Subalbum" href="/subalbum/feed.atom" />`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			server := testServer(t)
+			server.BaseURL = tc.baseURL
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.status {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			body := w.Body.String()
+			if !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+			if strings.HasSuffix(tc.url, ".atom") && w.Code == http.StatusOK {
+				var feed galldir.AtomFeed
+				if err := xml.Unmarshal([]byte(body), &feed); err != nil {
+					t.Errorf("invalid feed: %v", err)
+				}
+			}
+		})
+	}
+}
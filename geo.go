@@ -0,0 +1,87 @@
+package galldir
+
+import (
+	"math"
+)
+
+// The tile server used by maps, and the attribution it requires, unless
+// the Server is given another.
+const (
+	defaultTileURL         = "https://tile.openstreetmap.org/{z}/{x}/{y}.png"
+	defaultTileAttribution = `&copy; <a href="https://www.openstreetmap.org/copyright">OpenStreetMap</a> contributors`
+)
+
+// GeoJSON is a GeoJSON FeatureCollection of the places photos were taken.
+type GeoJSON struct {
+	Type     string       `json:"type"`
+	Features []GeoFeature `json:"features"`
+}
+
+// GeoFeature is a GeoJSON Feature placing a single photo.
+type GeoFeature struct {
+	Type       string        `json:"type"`
+	Geometry   GeoPoint      `json:"geometry"`
+	Properties GeoProperties `json:"properties"`
+}
+
+// GeoPoint is a GeoJSON Point. Its Coordinates are the longitude followed
+// by the latitude.
+type GeoPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// GeoProperties describes the photo placed by a GeoFeature.
+type GeoProperties struct {
+	Path        string `json:"path"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// location returns the place a photo was taken, if it is known and valid.
+func location(im Image) *Location {
+	if im.Exif == nil || im.Exif.Location == nil {
+		return nil
+	}
+	l := im.Exif.Location
+	if math.IsNaN(l.Latitude) || math.IsNaN(l.Longitude) ||
+		math.Abs(l.Latitude) > 90 || math.Abs(l.Longitude) > 180 {
+		return nil
+	}
+	return l
+}
+
+// HasLocation returns true if any of the photos has a known location.
+func HasLocation(photos []Image) bool {
+	for _, photo := range photos {
+		if location(photo) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// NewGeoJSON returns a FeatureCollection of those photos with a known
+// location.
+func NewGeoJSON(photos []Image) *GeoJSON {
+	g := &GeoJSON{Type: "FeatureCollection", Features: []GeoFeature{}}
+	for _, photo := range photos {
+		l := location(photo)
+		if l == nil {
+			continue
+		}
+		g.Features = append(g.Features, GeoFeature{
+			Type: "Feature",
+			Geometry: GeoPoint{
+				Type:        "Point",
+				Coordinates: [2]float64{l.Longitude, l.Latitude},
+			},
+			Properties: GeoProperties{
+				Path:        photo.Path,
+				Name:        photo.Name,
+				Description: photo.Description,
+			},
+		})
+	}
+	return g
+}
@@ -0,0 +1,77 @@
+package galldir_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestNewGeoJSON(t *testing.T) {
+	photos := []galldir.Image{
+		{Path: "/a.jpg", Name: "a.jpg", Exif: &galldir.Exif{Location: &galldir.Location{Latitude: 51.5, Longitude: -0.1}}},
+		{Path: "/b.jpg", Name: "b.jpg", Exif: &galldir.Exif{}},
+		{Path: "/c.png", Name: "c.png"},
+		{Path: "/d.jpg", Name: "d.jpg", Exif: &galldir.Exif{Location: &galldir.Location{Latitude: 91, Longitude: 0}}},
+	}
+	if !galldir.HasLocation(photos) || galldir.HasLocation(photos[1:]) {
+		t.Error("unexpected HasLocation")
+	}
+	g := galldir.NewGeoJSON(photos)
+	if len(g.Features) != 1 {
+		t.Fatalf("unexpected features: %v", g.Features)
+	}
+	f := g.Features[0]
+	if f.Properties.Path != "/a.jpg" || f.Geometry.Coordinates != [2]float64{-0.1, 51.5} {
+		t.Errorf("unexpected feature: %v", f)
+	}
+	if empty, _ := json.Marshal(galldir.NewGeoJSON(nil)); string(empty) != `{"type":"FeatureCollection","features":[]}` {
+		t.Errorf("unexpected empty GeoJSON: %s", empty)
+	}
+}
+
+func TestServeMap(t *testing.T) {
+	server := testServer(t)
+	server.Provider = galldir.NewProvider(http.Dir("testdata/sorted"))
+	server.Index = galldir.NewIndex(server.Provider)
+	server.Index.Crawl()
+	tests := []struct {
+		url         string
+		contentType string
+		contains    string
+	}{
+		{"/", "", `<a href="?view=map">`},
+		{"/?view=map", "", `data: "?format=geojson"`},
+		{"/?view=map", "", `tiles: "https://tile.openstreetmap.org/{z}/{x}/{y}.png"`},
+		{"/?format=geojson", "application/geo+json", `"coordinates":[-0.1246`},
+		{"/map", "", `<h1>Map</h1>`},
+		{"/map?format=geojson", "application/geo+json", `"path":"/IMG_9.jpg"`},
+		{"/search?q=canon&view=map", "", `data: "?format=geojson\u0026q=canon"`},
+		{"/timeline/2019/06/?format=geojson", "application/geo+json", `"path":"/timeline/2019/06/IMG_9.jpg"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if tc.contentType != "" && w.Header().Get("Content-Type") != tc.contentType {
+				t.Errorf("unexpected content type: %s", w.Header().Get("Content-Type"))
+			}
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+
+	server.TileURL = "https://tiles.example.com/{z}/{x}/{y}.png"
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/?view=map", nil))
+	if body := w.Body.String(); !strings.Contains(body, server.TileURL) || strings.Contains(body, "openstreetmap") {
+		t.Errorf("tile server not configured: %s", body)
+	}
+}
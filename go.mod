@@ -1,11 +1,20 @@
 module github.com/jamesfcarter/galldir
 
-go 1.16
+go 1.21
 
 require (
+	github.com/andybalholm/brotli v1.0.5
 	github.com/disintegration/imaging v1.6.0
-	github.com/golang/mock v1.6.0 // indirect
 	github.com/jamesfcarter/s3httpfilesystem v0.0.0-20230103202810-eb62dfdc7db7
 	github.com/patrickmn/go-cache v2.1.0+incompatible
+	github.com/rwcarlsen/goexif v0.0.0-20190401172101-9e8deecbddbd
+	github.com/yuin/goldmark v1.4.13
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/aws/aws-sdk-go v1.44.172 // indirect
+	github.com/golang/mock v1.6.0 // indirect
+	github.com/jmespath/go-jmespath v0.4.0 // indirect
 	golang.org/x/image v0.6.0 // indirect
 )
@@ -0,0 +1,169 @@
+package galldir
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// The states reported by a Health.
+const (
+	healthOK          = "ok"
+	healthDegraded    = "degraded"
+	healthUnavailable = "unavailable"
+)
+
+// Health checks whether the gallery is able to serve, by listing the root
+// of the backend and watching for files in it that fail to open. The
+// outcome of a check is reused for CacheFor, and a check taking longer than
+// Timeout fails. The gallery is degraded, though still ready, if more than
+// ErrorThreshold files have failed to open within Window.
+type Health struct {
+	Timeout        time.Duration
+	CacheFor       time.Duration
+	ErrorThreshold int
+	Window         time.Duration
+	provider       *Provider
+	backend        http.FileSystem
+	checkMutex     sync.Mutex
+	checked        time.Time
+	err            error
+	errorsMutex    sync.Mutex
+	errors         []time.Time
+}
+
+// NewHealth returns a Health for the provider's backend, which it
+// instruments to count the files that fail to open.
+func NewHealth(p *Provider) *Health {
+	h := &Health{
+		Timeout:        5 * time.Second,
+		CacheFor:       10 * time.Second,
+		ErrorThreshold: 10,
+		Window:         time.Minute,
+		provider:       p,
+		backend:        p.FS,
+	}
+	p.FS = &watchedFS{FS: p.FS, health: h}
+	return h
+}
+
+// backendError records a file that failed to open. Files that don't exist
+// are expected, as the sidecars of albums are optional.
+func (h *Health) backendError(err error) {
+	if err == nil || errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	h.errorsMutex.Lock()
+	defer h.errorsMutex.Unlock()
+	h.errors = append(h.errors, time.Now())
+	// only enough errors to exceed the threshold need be kept
+	if len(h.errors) > h.ErrorThreshold+1 {
+		h.errors = h.errors[len(h.errors)-h.ErrorThreshold-1:]
+	}
+}
+
+// recentErrors returns the number of files that failed to open within the
+// Window, up to one more than the ErrorThreshold.
+func (h *Health) recentErrors() int {
+	h.errorsMutex.Lock()
+	defer h.errorsMutex.Unlock()
+	since := time.Now().Add(-h.Window)
+	for len(h.errors) > 0 && h.errors[0].Before(since) {
+		h.errors = h.errors[1:]
+	}
+	return len(h.errors)
+}
+
+// listRoot opens and lists the root of the backend.
+func (h *Health) listRoot() error {
+	root, err := h.backend.Open("/")
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+	_, err = root.Readdir(1)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// check returns the outcome of the latest check of the backend, checking
+// it again if that is out of date.
+func (h *Health) check() error {
+	if time.Since(h.checked) < h.CacheFor {
+		return h.err
+	}
+	done := make(chan error, 1)
+	go func() { done <- h.listRoot() }()
+	select {
+	case h.err = <-done:
+	case <-time.After(h.Timeout):
+		h.err = errors.New("timed out listing the backend")
+	}
+	h.checked = time.Now()
+	if h.err != nil {
+		h.provider.logger().Error("backend is unavailable", "err", h.err)
+	}
+	return h.err
+}
+
+// HealthStatus is the state of the gallery reported by /readyz.
+type HealthStatus struct {
+	Status        string    `json:"status"`
+	Checked       time.Time `json:"checked"`
+	BackendErrors int       `json:"backendErrors"`
+}
+
+// Status checks the gallery's health.
+func (h *Health) Status() HealthStatus {
+	h.checkMutex.Lock()
+	status := HealthStatus{Status: healthOK}
+	if err := h.check(); err != nil {
+		status.Status = healthUnavailable
+	}
+	status.Checked = h.checked
+	h.checkMutex.Unlock()
+	status.BackendErrors = h.recentErrors()
+	if status.Status == healthOK && status.BackendErrors > h.ErrorThreshold {
+		status.Status = healthDegraded
+	}
+	return status
+}
+
+// ServeHTTP reports whether the gallery is ready to serve, with a status of
+// 503 Service Unavailable if it is not. The reasons for any failure are
+// logged rather than reported, as they may reveal details of the backend.
+func (h *Health) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := h.Status()
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if status.Status == healthUnavailable {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+}
+
+// Alive reports that the process is running, for use as a liveness probe.
+func Alive(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write([]byte("ok\n"))
+}
+
+// watchedFS is an http.FileSystem that reports files in the one it wraps
+// that fail to open to a Health.
+type watchedFS struct {
+	FS     http.FileSystem
+	health *Health
+}
+
+func (w *watchedFS) Open(name string) (http.File, error) {
+	f, err := w.FS.Open(name)
+	w.health.backendError(err)
+	return f, err
+}
@@ -0,0 +1,109 @@
+package galldir_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+// brokenFS is an http.FileSystem whose files fail to open, after a delay,
+// while err is set.
+type brokenFS struct {
+	http.FileSystem
+	mutex sync.Mutex
+	err   error
+	delay time.Duration
+}
+
+func (b *brokenFS) set(err error, delay time.Duration) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.err, b.delay = err, delay
+}
+
+func (b *brokenFS) Open(name string) (http.File, error) {
+	b.mutex.Lock()
+	err, delay := b.err, b.delay
+	b.mutex.Unlock()
+	time.Sleep(delay)
+	if err != nil {
+		return nil, err
+	}
+	return b.FileSystem.Open(name)
+}
+
+func readiness(t *testing.T, health *galldir.Health) (int, galldir.HealthStatus) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	health.ServeHTTP(w, httptest.NewRequest("GET", "/readyz", nil))
+	var status galldir.HealthStatus
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	}
+	return w.Code, status
+}
+
+func TestHealth(t *testing.T) {
+	backend := &brokenFS{FileSystem: http.Dir("testdata/album")}
+	provider := galldir.NewProvider(backend)
+	health := galldir.NewHealth(provider)
+	health.ErrorThreshold = 1
+	health.Timeout = 50 * time.Millisecond
+
+	code, status := readiness(t, health)
+	if code != http.StatusOK || status.Status != "ok" || status.Checked.IsZero() {
+		t.Fatalf("unexpected readiness: %d %+v", code, status)
+	}
+
+	// an earlier check is reused
+	backend.set(errors.New("access denied"), 0)
+	if code, status = readiness(t, health); status.Status != "ok" {
+		t.Errorf("check not reused: %d %+v", code, status)
+	}
+
+	health.CacheFor = 0
+	if code, status = readiness(t, health); code != http.StatusServiceUnavailable || status.Status != "unavailable" {
+		t.Errorf("unexpected readiness of broken backend: %d %+v", code, status)
+	}
+
+	backend.set(nil, time.Second)
+	if code, status = readiness(t, health); code != http.StatusServiceUnavailable {
+		t.Errorf("unexpected readiness of slow backend: %d %+v", code, status)
+	}
+
+	// files that don't exist are expected
+	backend.set(nil, 0)
+	provider.FS.Open("/not_there")
+	if code, status = readiness(t, health); code != http.StatusOK || status.Status != "ok" || status.BackendErrors != 0 {
+		t.Errorf("unexpected readiness: %d %+v", code, status)
+	}
+
+	backend.set(os.ErrPermission, 0)
+	provider.FS.Open("/subalbum/icon.png")
+	provider.FS.Open("/subalbum/icon.png")
+	backend.set(nil, 0)
+	if code, status = readiness(t, health); code != http.StatusOK || status.Status != "degraded" || status.BackendErrors != 2 {
+		t.Errorf("unexpected readiness after errors: %d %+v", code, status)
+	}
+
+	health.Window = 0
+	if code, status = readiness(t, health); status.Status != "ok" || status.BackendErrors != 0 {
+		t.Errorf("errors outlived window: %d %+v", code, status)
+	}
+}
+
+func TestAlive(t *testing.T) {
+	w := httptest.NewRecorder()
+	galldir.Alive(w, httptest.NewRequest("GET", "/healthz", nil))
+	if w.Code != http.StatusOK || strings.TrimSpace(w.Body.String()) != "ok" {
+		t.Errorf("unexpected response: %d %s", w.Code, w.Body.String())
+	}
+}
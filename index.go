@@ -0,0 +1,175 @@
+package galldir
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Index holds every album in the gallery so that photos may be found
+// other than by browsing directories. It is filled by crawling the
+// Provider and kept up to date as the Provider reloads albums.
+type Index struct {
+	Provider *Provider
+	mutex    sync.RWMutex
+	albums   map[string]*Album
+	// views holds the indexed photos arranged for the virtual albums. It
+	// is nil from each change to the index until it is next needed.
+	views *indexViews
+}
+
+// indexViews holds the indexed photos arranged for the virtual albums, so
+// that they need not be gathered from the whole gallery on each request.
+type indexViews struct {
+	// tagged holds the photos by lower-cased tag.
+	tagged map[string][]Image
+	// months holds the photos by the month they were taken, and
+	// monthOrder lists those months from the earliest.
+	months     map[timelinePeriod][]Image
+	monthOrder []timelinePeriod
+	// added holds the photos from the most recently added, and taken
+	// from the most recently taken.
+	added []Image
+	taken []Image
+}
+
+func newIndexViews(photos []Image) *indexViews {
+	v := &indexViews{
+		tagged: make(map[string][]Image),
+		months: make(map[timelinePeriod][]Image),
+	}
+	for _, photo := range photos {
+		for _, tag := range photo.Tags {
+			key := strings.ToLower(tag)
+			v.tagged[key] = append(v.tagged[key], photo)
+		}
+		taken := photo.Taken()
+		month := timelinePeriod{year: taken.Year(), month: taken.Month()}
+		if _, ok := v.months[month]; !ok {
+			v.monthOrder = append(v.monthOrder, month)
+		}
+		v.months[month] = append(v.months[month], photo)
+	}
+	sort.Slice(v.monthOrder, func(i, j int) bool {
+		return v.monthOrder[i].start().Before(v.monthOrder[j].start())
+	})
+	v.added = append([]Image(nil), photos...)
+	SortByTime.sort(v.added)
+	v.taken = append([]Image(nil), photos...)
+	SortByTakenReversed.sort(v.taken)
+	return v
+}
+
+// currentViews returns the indexed photos arranged for the virtual albums,
+// arranging them afresh if the index has changed since they were last
+// needed.
+func (x *Index) currentViews() *indexViews {
+	x.mutex.RLock()
+	views := x.views
+	x.mutex.RUnlock()
+	if views != nil {
+		return views
+	}
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	if x.views == nil {
+		x.views = newIndexViews(x.photos())
+	}
+	return x.views
+}
+
+// NewIndex returns an empty Index of the albums supplied by the Provider.
+func NewIndex(p *Provider) *Index {
+	x := &Index{
+		Provider: p,
+		albums:   make(map[string]*Album),
+	}
+	p.OnAlbumLoad(func(album *Album) {
+		// the crawl reads the metadata embedded in photos, so albums
+		// loaded in between use whatever of it is cached
+		x.Update(p.withEmbedded(album, false))
+	})
+	return x
+}
+
+// Update adds an album to the index, replacing any earlier version of it.
+// Sub-albums that the album no longer contains are removed.
+func (x *Index) Update(album *Album) {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	if old, ok := x.albums[album.Path]; ok {
+		for _, im := range old.Albums() {
+			if album.Image(im.Path) == nil {
+				x.remove(im.Path + "/")
+			}
+		}
+	}
+	x.albums[album.Path] = album
+	x.views = nil
+}
+
+// remove drops the album at path and everything beneath it from the
+// index. The caller must hold the write lock.
+func (x *Index) remove(path string) {
+	for albumPath := range x.albums {
+		if strings.HasPrefix(albumPath, path) {
+			delete(x.albums, albumPath)
+		}
+	}
+}
+
+// Crawl walks every album in the gallery, adding each to the index along
+// with the metadata embedded in its photos. Albums that fail to load are
+// logged and skipped.
+func (x *Index) Crawl() {
+	x.Provider.Walk("/", func(album *Album) {
+		x.Update(x.Provider.Embedded(album))
+	})
+}
+
+// Run crawls the gallery and then crawls it again each interval, never
+// returning.
+func (x *Index) Run(interval time.Duration) {
+	for {
+		x.Crawl()
+		time.Sleep(interval)
+	}
+}
+
+// Albums returns every indexed album, sorted by path.
+func (x *Index) Albums() []*Album {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.sortedAlbums()
+}
+
+// sortedAlbums returns every indexed album, sorted by path. The caller
+// must hold the lock.
+func (x *Index) sortedAlbums() []*Album {
+	albums := make([]*Album, 0, len(x.albums))
+	for _, album := range x.albums {
+		albums = append(albums, album)
+	}
+	sort.Slice(albums, func(i, j int) bool {
+		return albums[i].Path < albums[j].Path
+	})
+	return albums
+}
+
+// Photos returns every indexed photo, album by album.
+func (x *Index) Photos() []Image {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.photos()
+}
+
+// photos returns every indexed photo, album by album. The caller must
+// hold the lock.
+func (x *Index) photos() []Image {
+	var photos []Image
+	for _, album := range x.sortedAlbums() {
+		photos = append(photos, album.Photos()...)
+	}
+	return photos
+}
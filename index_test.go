@@ -0,0 +1,119 @@
+package galldir_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func testIndex(t *testing.T) *galldir.Index {
+	t.Helper()
+
+	x := galldir.NewIndex(galldir.NewProvider(http.Dir("testdata")))
+	x.Crawl()
+	return x
+}
+
+func TestIndexCrawl(t *testing.T) {
+	x := testIndex(t)
+	var paths []string
+	for _, album := range x.Albums() {
+		paths = append(paths, album.Path)
+	}
+	for _, path := range []string{"/", "/manifest/", "/manifest/json/", "/sorted/", "/album/subalbum/"} {
+		i := sort.SearchStrings(paths, path)
+		if i == len(paths) || paths[i] != path {
+			t.Errorf("%s not indexed in %v", path, paths)
+		}
+	}
+}
+
+func TestIndexUpdate(t *testing.T) {
+	p := galldir.NewProvider(http.Dir("testdata"))
+	x := galldir.NewIndex(p)
+	album, err := p.Album("/manifest/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Album("/manifest/json/", false); err != nil {
+		t.Fatal(err)
+	}
+	if albums := x.Albums(); len(albums) != 2 {
+		t.Fatalf("loaded albums not indexed: %d", len(albums))
+	}
+	emptied := *album
+	emptied.Images = nil
+	x.Update(&emptied)
+	if albums := x.Albums(); len(albums) != 1 {
+		t.Errorf("removed sub-album still indexed: %d", len(albums))
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		paths []string
+	}{
+		{"", nil},
+		{"sandcastle", []string{"/manifest/a.png"}},
+		{"SEASIDE beach", []string{"/manifest", "/manifest/a.png", "/manifest/b.png"}},
+		{"seaside sandcastle", []string{"/manifest/a.png"}},
+		{"from json", []string{"/manifest/json"}},
+		{"canon", []string{"/sorted/IMG_9.jpg", "/tagged/xmp.jpg"}},
+		{"harbour", []string{"/tagged/iptc.jpg"}},
+		{"2019-06-01 fujifilm", []string{"/sorted/IMG_10.jpg"}},
+		{"secret", nil},
+		{"nothing matches this", nil},
+	}
+	x := testIndex(t)
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			result := x.Search(tc.query)
+			var paths []string
+			for _, im := range result.Images {
+				paths = append(paths, im.Path)
+			}
+			sort.Strings(paths)
+			if strings.Join(paths, " ") != strings.Join(tc.paths, " ") {
+				t.Errorf("unexpected result: %v", paths)
+			}
+			for _, path := range paths {
+				if real := result.RealPath(path); real != path {
+					t.Errorf("unexpected real path of %s: %s", path, real)
+				}
+			}
+		})
+	}
+}
+
+func TestServeSearch(t *testing.T) {
+	server := testServer(t)
+	server.Index = galldir.NewIndex(server.Provider)
+	server.Index.Crawl()
+	tests := []struct {
+		url      string
+		contains string
+	}{
+		{"/search?q=icon", `<a href="/subalbum/icon.png" data-sub-html=`},
+		{"/search?q=icon", `<h1>Search: icon</h1>`},
+		{"/search?q=icon", `value="icon"`},
+		{"/search?q=subalbum", `<figcaption>Subalbum`},
+		{"/", `<form class="galldir-search" action="/search">`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+}
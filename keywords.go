@@ -0,0 +1,205 @@
+package galldir
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	xmpPrefix = "http://ns.adobe.com/xap/1.0/\x00"
+	irbPrefix = "Photoshop 3.0\x00"
+	dcNS      = "http://purl.org/dc/elements/1.1/"
+	rdfNS     = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	// iptcResource is the ID of the Photoshop image resource holding IPTC
+	// data, in which dataset 2:25 holds a keyword.
+	iptcResource = 0x0404
+)
+
+// ParseXMPKeywords returns the keywords (the dc:subject list) from an XMP
+// packet, such as one embedded in a JPEG or an .xmp sidecar file.
+func ParseXMPKeywords(r io.Reader) ([]string, error) {
+	var keywords []string
+	var inSubject, inItem bool
+	var item strings.Builder
+	d := xml.NewDecoder(r)
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return keywords, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space == dcNS && t.Name.Local == "subject" {
+				inSubject = true
+			} else if inSubject && t.Name.Space == rdfNS && t.Name.Local == "li" {
+				inItem = true
+				item.Reset()
+			}
+		case xml.EndElement:
+			if t.Name.Space == dcNS && t.Name.Local == "subject" {
+				inSubject = false
+			} else if inItem && t.Name.Space == rdfNS && t.Name.Local == "li" {
+				inItem = false
+				if keyword := strings.TrimSpace(item.String()); keyword != "" {
+					keywords = append(keywords, keyword)
+				}
+			}
+		case xml.CharData:
+			if inItem {
+				item.Write(t)
+			}
+		}
+	}
+}
+
+// ReadKeywords returns the keywords embedded in a JPEG image, either in
+// XMP or in IPTC data. Images without keywords, or that cannot be read,
+// have none.
+func ReadKeywords(r io.Reader) []string {
+	br := bufio.NewReader(io.LimitReader(r, exifReadLimit))
+	var soi [2]byte
+	if _, err := io.ReadFull(br, soi[:]); err != nil || soi != [2]byte{0xff, 0xd8} {
+		return nil
+	}
+	var keywords []string
+	for {
+		marker, payload, ok := readSegment(br)
+		if !ok {
+			return keywords
+		}
+		switch {
+		case marker == 0xe1 && bytes.HasPrefix(payload, []byte(xmpPrefix)):
+			xmp, err := ParseXMPKeywords(bytes.NewReader(payload[len(xmpPrefix):]))
+			if err == nil {
+				keywords = mergeTags(keywords, xmp)
+			}
+		case marker == 0xed && bytes.HasPrefix(payload, []byte(irbPrefix)):
+			keywords = mergeTags(keywords, iptcKeywords(payload[len(irbPrefix):]))
+		}
+	}
+}
+
+// readSegment reads the next segment of a JPEG, returning false once the
+// image data (which holds no metadata) is reached.
+func readSegment(br *bufio.Reader) (byte, []byte, bool) {
+	var marker byte
+	for marker == 0 || marker == 0xff {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, nil, false
+		}
+		if marker == 0 && b != 0xff {
+			return 0, nil, false
+		}
+		marker = b
+	}
+	if marker == 0xda || marker == 0xd9 {
+		return 0, nil, false
+	}
+	var length uint16
+	if err := binary.Read(br, binary.BigEndian, &length); err != nil || length < 2 {
+		return 0, nil, false
+	}
+	payload := make([]byte, length-2)
+	if _, err := io.ReadFull(br, payload); err != nil {
+		return 0, nil, false
+	}
+	return marker, payload, true
+}
+
+// iptcKeywords returns the keywords from the IPTC resource amongst a
+// block of Photoshop image resources.
+func iptcKeywords(irb []byte) []string {
+	for len(irb) >= 12 && string(irb[:4]) == "8BIM" {
+		id := binary.BigEndian.Uint16(irb[4:6])
+		// the resource name is a Pascal string padded to an even length
+		nameLen := (int(irb[6]) + 2) &^ 1
+		if len(irb) < 6+nameLen+4 {
+			return nil
+		}
+		size := int(binary.BigEndian.Uint32(irb[6+nameLen:]))
+		data := irb[6+nameLen+4:]
+		if size > len(data) {
+			return nil
+		}
+		if id == iptcResource {
+			return iptcDatasets(data[:size])
+		}
+		irb = data[(size+1)&^1:]
+	}
+	return nil
+}
+
+func iptcDatasets(data []byte) []string {
+	var keywords []string
+	for len(data) >= 5 && data[0] == 0x1c {
+		record, dataset := data[1], data[2]
+		size := int(binary.BigEndian.Uint16(data[3:5]))
+		// extended datasets are not used for keywords
+		if size&0x8000 != 0 || len(data) < 5+size {
+			break
+		}
+		if record == 2 && dataset == 25 {
+			keywords = mergeTags(keywords, []string{string(data[5 : 5+size])})
+		}
+		data = data[5+size:]
+	}
+	return keywords
+}
+
+// mergeTags adds to tags those of extra it doesn't already hold, ignoring
+// differences in case.
+func mergeTags(tags, extra []string) []string {
+	for _, tag := range extra {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || hasTag(tags, tag) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadTags adds the keywords from any XMP sidecar file, either photo.jpg.xmp
+// or photo.xmp, to the tags of each photo in the album. files holds the
+// names of every file in the album's directory.
+func (p *Provider) loadTags(a *Album, files map[string]bool) {
+	for i := range a.Images {
+		im := &a.Images[i]
+		if im.IsAlbum {
+			continue
+		}
+		name := filepath.Base(im.Path)
+		for _, sidecar := range []string{
+			name + ".xmp",
+			strings.TrimSuffix(name, filepath.Ext(name)) + ".xmp",
+		} {
+			if !files[sidecar] {
+				continue
+			}
+			keywords, err := ParseXMPKeywords(strings.NewReader(p.loadFile(filepath.Join(a.Path, sidecar))))
+			if err != nil {
+				p.logger().Warn("failed to parse sidecar", "source", filepath.Join(a.Path, sidecar), "err", err)
+			}
+			im.Tags = mergeTags(im.Tags, keywords)
+			break
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package galldir_test
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestReadKeywords(t *testing.T) {
+	tests := []struct {
+		path     string
+		keywords string
+	}{
+		{"testdata/tagged/xmp.jpg", "beach,Sunset"},
+		{"testdata/tagged/iptc.jpg", "harbour,BEACH"},
+		{"testdata/sorted/IMG_9.jpg", ""},
+		{"testdata/tagged/sidecar.png", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			f, err := os.Open(tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			if keywords := strings.Join(galldir.ReadKeywords(f), ","); keywords != tc.keywords {
+				t.Errorf("unexpected keywords: %s", keywords)
+			}
+		})
+	}
+}
+
+func TestParseXMPKeywords(t *testing.T) {
+	f, err := os.Open("testdata/tagged/sidecar.xmp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	keywords, err := galldir.ParseXMPKeywords(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(keywords, ",") != "sunset,pier" {
+		t.Errorf("unexpected keywords: %v", keywords)
+	}
+	if _, err := galldir.ParseXMPKeywords(strings.NewReader("<x:xmpmeta>")); err == nil {
+		t.Error("expected an error from truncated XMP")
+	}
+}
+
+func TestProviderTags(t *testing.T) {
+	tests := []struct {
+		path string
+		tags string
+	}{
+		{"/xmp.jpg", "beach,Sunset"},
+		{"/iptc.jpg", "harbour,BEACH"},
+		{"/sidecar.png", "family,sunset,pier"},
+	}
+	provider := galldir.NewProvider(http.Dir("testdata/tagged"))
+	album, err := provider.Album("/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	album = provider.Embedded(album)
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			im := album.Image(tc.path)
+			if im == nil {
+				t.Fatal("image not found")
+			}
+			if tags := strings.Join(im.Tags, ","); tags != tc.tags {
+				t.Errorf("unexpected tags: %s", tags)
+			}
+		})
+	}
+}
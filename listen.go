@@ -0,0 +1,175 @@
+package galldir
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// unixPrefix marks an address as the path of a Unix socket.
+const unixPrefix = "unix:"
+
+// Listen returns a listener on addr, which is either a TCP address or the
+// path of a Unix socket prefixed with "unix:". A socket left behind by an
+// earlier run is replaced. If maxConns is positive, no more than that many
+// connections are open at once; further connections wait to be accepted.
+func Listen(addr string, maxConns int) (net.Listener, error) {
+	var l net.Listener
+	var err error
+	if strings.HasPrefix(addr, unixPrefix) {
+		path := strings.TrimPrefix(addr, unixPrefix)
+		if fi, err := os.Stat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+			os.Remove(path)
+		}
+		l, err = net.Listen("unix", path)
+	} else {
+		l, err = net.Listen("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if maxConns > 0 {
+		l = &limitListener{Listener: l, slots: make(chan struct{}, maxConns)}
+	}
+	return l, nil
+}
+
+// limitListener is a net.Listener that holds a slot for each open
+// connection.
+type limitListener struct {
+	net.Listener
+	slots chan struct{}
+}
+
+func (l *limitListener) Accept() (net.Conn, error) {
+	l.slots <- struct{}{}
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		<-l.slots
+		return nil, err
+	}
+	return &limitConn{Conn: conn, release: func() { <-l.slots }}, nil
+}
+
+type limitConn struct {
+	net.Conn
+	once    sync.Once
+	release func()
+}
+
+func (c *limitConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.release)
+	return err
+}
+
+// CertReloader holds a TLS certificate and key read from files, reading
+// them again when they change so that renewed certificates are picked up
+// without a restart. The files are checked at most once every Interval.
+type CertReloader struct {
+	CertFile string
+	KeyFile  string
+	Interval time.Duration
+	mutex    sync.Mutex
+	cert     *tls.Certificate
+	modTime  time.Time
+	checked  time.Time
+}
+
+// NewCertReloader returns a CertReloader for the certificate and key in
+// the files, or an error if they cannot be loaded.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	c := &CertReloader{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		Interval: time.Minute,
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// modified returns the time the certificate or key was last modified.
+func (c *CertReloader) modified() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{c.CertFile, c.KeyFile} {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (c *CertReloader) load() error {
+	modTime, err := c.modified()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %v", err)
+	}
+	c.cert = &cert
+	c.modTime = modTime
+	return nil
+}
+
+// GetCertificate returns the current certificate, for use as the
+// GetCertificate of a tls.Config. If the new files cannot be loaded the
+// previous certificate is kept.
+func (c *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if time.Since(c.checked) >= c.Interval {
+		c.checked = time.Now()
+		if modTime, err := c.modified(); err != nil {
+			slog.Error("failed to check certificate", "err", err)
+		} else if !modTime.Equal(c.modTime) {
+			if err := c.load(); err != nil {
+				slog.Error("failed to reload certificate", "err", err)
+			} else {
+				slog.Info("reloaded certificate", "file", c.CertFile)
+			}
+		}
+	}
+	return c.cert, nil
+}
+
+// RedirectToHTTPS returns a handler that redirects every request to the
+// same URL over HTTPS, on the port of httpsAddr.
+func RedirectToHTTPS(httpsAddr string) http.Handler {
+	port := ""
+	if !strings.HasPrefix(httpsAddr, unixPrefix) {
+		if _, name, err := net.SplitHostPort(httpsAddr); err == nil {
+			if n, err := net.LookupPort("tcp", name); err == nil && n != 443 {
+				port = strconv.Itoa(n)
+			}
+		}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		host = strings.Trim(host, "[]")
+		if port != "" {
+			host = net.JoinHostPort(host, port)
+		} else if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+		target := "https://" + host + r.URL.RequestURI()
+		http.Redirect(w, r, target, http.StatusMovedPermanently)
+	})
+}
@@ -0,0 +1,163 @@
+package galldir_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestRedirectToHTTPS(t *testing.T) {
+	tests := []struct {
+		httpsAddr string
+		host      string
+		url       string
+		expected  string
+	}{
+		{":443", "example.com", "/a/b.jpg?thumb=50", "https://example.com/a/b.jpg?thumb=50"},
+		{":https", "example.com:80", "/", "https://example.com/"},
+		{":8443", "example.com:8080", "/a/", "https://example.com:8443/a/"},
+		{"unix:/run/galldir.sock", "example.com", "/", "https://example.com/"},
+		{":443", "[::1]:80", "/", "https://[::1]/"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.httpsAddr+" "+tc.host, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.url, nil)
+			r.Host = tc.host
+			w := httptest.NewRecorder()
+			galldir.RedirectToHTTPS(tc.httpsAddr).ServeHTTP(w, r)
+			if w.Code != http.StatusMovedPermanently {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if location := w.Header().Get("Location"); location != tc.expected {
+				t.Errorf("unexpected Location: %s", location)
+			}
+		})
+	}
+}
+
+func writeCert(t *testing.T, dir, name string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := ioutil.WriteFile(filepath.Join(dir, "cert.pem"), certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "key.pem"), keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func certName(t *testing.T, certs *galldir.CertReloader) string {
+	t.Helper()
+	cert, err := certs.GetCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if _, err := galldir.NewCertReloader(certFile, keyFile); err == nil {
+		t.Fatal("expected an error")
+	}
+	writeCert(t, dir, "first")
+	certs, err := galldir.NewCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certs.Interval = 0
+	if name := certName(t, certs); name != "first" {
+		t.Fatalf("unexpected certificate: %s", name)
+	}
+
+	writeCert(t, dir, "second")
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, later, later)
+	if name := certName(t, certs); name != "second" {
+		t.Errorf("certificate not reloaded: %s", name)
+	}
+
+	if err := ioutil.WriteFile(keyFile, []byte("broken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	later = later.Add(time.Minute)
+	os.Chtimes(keyFile, later, later)
+	if name := certName(t, certs); name != "second" {
+		t.Errorf("broken certificate replaced the old one: %s", name)
+	}
+}
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "galldir.sock")
+	// leave a stale socket behind, as a server that crashed would
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.SetUnlinkOnClose(false)
+	stale.Close()
+
+	l, err := galldir.Listen("unix:"+path, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	}))
+	client := &http.Client{Transport: &http.Transport{
+		Dial: func(network, addr string) (net.Conn, error) {
+			return net.Dial("unix", path)
+		},
+	}}
+	defer client.CloseIdleConnections()
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get("http://galldir/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != "hello" {
+			t.Errorf("unexpected body: %s", body)
+		}
+	}
+}
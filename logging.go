@@ -0,0 +1,188 @@
+package galldir
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// requestInfo is what is known about a request for the purposes of
+// logging it. It is held in the request's context.
+type requestInfo struct {
+	id     string
+	logger *slog.Logger
+	album  string
+	// cache is "hit" or "miss" for requests answered from the Provider's
+	// cache, if it holds what was asked for.
+	cache string
+}
+
+type requestInfoKey struct{}
+
+// maxRequestID is the longest X-Request-ID accepted from a client.
+const maxRequestID = 64
+
+// requestID returns the ID of the request, taken from its X-Request-ID
+// header if it has a reasonable one and otherwise made up.
+func requestID(r *http.Request) string {
+	if id := r.Header.Get("X-Request-ID"); id != "" && len(id) <= maxRequestID {
+		printable := true
+		for _, c := range id {
+			if c <= ' ' || c > '~' {
+				printable = false
+			}
+		}
+		if printable {
+			return id
+		}
+	}
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// withRequestInfo returns the request with requestInfo added to its
+// context.
+func (s *Server) withRequestInfo(r *http.Request) (*http.Request, *requestInfo) {
+	info := &requestInfo{id: requestID(r)}
+	info.logger = s.logger().With("request_id", info.id, "path", r.URL.Path)
+	return r.WithContext(context.WithValue(r.Context(), requestInfoKey{}, info)), info
+}
+
+func getRequestInfo(r *http.Request) *requestInfo {
+	if info, ok := r.Context().Value(requestInfoKey{}).(*requestInfo); ok {
+		return info
+	}
+	return &requestInfo{logger: slog.Default()}
+}
+
+// requestLogger returns a logger whose records carry the ID and path of
+// the request and the album it is for.
+func requestLogger(r *http.Request) *slog.Logger {
+	info := getRequestInfo(r)
+	if info.album != "" {
+		return info.logger.With("album", info.album)
+	}
+	return info.logger
+}
+
+// noteAlbum records the album the request is for.
+func noteAlbum(r *http.Request, album *Album) {
+	getRequestInfo(r).album = album.Path
+}
+
+// noteCache records whether the named entry of the Provider's cache was
+// present when the request came to use it.
+func (s *Server) noteCache(r *http.Request, cacheName string) {
+	if _, ok := s.Provider.Cache.Get(cacheName); ok {
+		getRequestInfo(r).cache = "hit"
+	} else {
+		getRequestInfo(r).cache = "miss"
+	}
+}
+
+func (s *Server) logger() *slog.Logger {
+	if s.Logger == nil {
+		return slog.Default()
+	}
+	return s.Logger
+}
+
+func (p *Provider) logger() *slog.Logger {
+	if p.Logger == nil {
+		return slog.Default()
+	}
+	return p.Logger
+}
+
+// logRequest logs the outcome of a request once it has been handled.
+func logRequest(r *http.Request, status int, size int64, duration time.Duration) {
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.Int("status", status),
+		slog.Int64("bytes", size),
+		slog.Duration("duration", duration),
+	}
+	if cache := getRequestInfo(r).cache; cache != "" {
+		attrs = append(attrs, slog.String("cache", cache))
+	}
+	requestLogger(r).LogAttrs(r.Context(), slog.LevelInfo, "request", attrs...)
+}
+
+// ParseLogLevel returns the level named s: debug, info, warn or error.
+func ParseLogLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	err := level.UnmarshalText([]byte(s))
+	return level, err
+}
+
+// The formats of access logs.
+const (
+	AccessLogCommon   = "common"
+	AccessLogCombined = "combined"
+)
+
+// AccessLog returns a handler that logs each request h handles to w in the
+// Common Log Format, or the Combined Log Format if format is
+// AccessLogCombined.
+func AccessLog(w io.Writer, format string, h http.Handler) http.Handler {
+	var mutex sync.Mutex
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: rw, status: http.StatusOK}
+		h.ServeHTTP(sw, r)
+		host := r.RemoteAddr
+		if ip, _, err := net.SplitHostPort(host); err == nil {
+			host = ip
+		}
+		user := "-"
+		if name, _, ok := r.BasicAuth(); ok && name != "" {
+			user = name
+		}
+		size := "-"
+		if sw.bytes > 0 {
+			size = fmt.Sprint(sw.bytes)
+		}
+		line := fmt.Sprintf("%s - %s [%s] %s %d %s", host, user,
+			start.Format("02/Jan/2006:15:04:05 -0700"),
+			quoteLog(r.Method+" "+r.RequestURI+" "+r.Proto), sw.status, size)
+		if format == AccessLogCombined {
+			line += " " + quoteLog(r.Referer()) + " " + quoteLog(r.UserAgent())
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		io.WriteString(w, line+"\n")
+	})
+}
+
+// quoteLog quotes a field of an access log line, escaping quotes,
+// backslashes and control characters so that a client cannot forge lines.
+func quoteLog(s string) string {
+	if s == "" {
+		return `"-"`
+	}
+	b := strings.Builder{}
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < ' ' || c == 0x7f:
+			fmt.Fprintf(&b, "\\x%02x", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
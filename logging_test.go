@@ -0,0 +1,110 @@
+package galldir_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var records []map[string]interface{}
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		record := make(map[string]interface{})
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestRequestLogging(t *testing.T) {
+	tests := []struct {
+		url       string
+		requestID string
+		expected  map[string]interface{}
+	}{
+		{"/subalbum/icon.png?thumb=50", "", map[string]interface{}{
+			"level": "INFO", "msg": "request", "path": "/subalbum/icon.png",
+			"album": "/subalbum/", "status": 200.0, "cache": "miss",
+		}},
+		{"/subalbum/icon.png?thumb=50", "abc-123", map[string]interface{}{
+			"request_id": "abc-123", "cache": "hit",
+		}},
+		{"/subalbum/", "bad id", map[string]interface{}{
+			"msg": "request", "album": "/subalbum/", "status": 200.0,
+		}},
+		{"/not_there/", "", map[string]interface{}{
+			"level": "WARN", "msg": "Not Found", "path": "/not_there/",
+		}},
+	}
+	buf := bytes.NewBuffer(nil)
+	server := testServer(t)
+	server.Logger = slog.New(slog.NewJSONHandler(buf, nil))
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			buf.Reset()
+			r := httptest.NewRequest("GET", tc.url, nil)
+			if tc.requestID != "" {
+				r.Header.Set("X-Request-ID", tc.requestID)
+			}
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, r)
+			id := w.Header().Get("X-Request-ID")
+			if id == "" || tc.requestID == "abc-123" && id != tc.requestID || tc.requestID == "bad id" && id == tc.requestID {
+				t.Errorf("unexpected request ID: %q", id)
+			}
+			records := logRecords(t, buf)
+			if len(records) == 0 {
+				t.Fatal("nothing logged")
+			}
+			record := records[0]
+			if record["request_id"] != id {
+				t.Errorf("unexpected request_id: %v", record["request_id"])
+			}
+			for key, value := range tc.expected {
+				if record[key] != value {
+					t.Errorf("unexpected %s: %v", key, record[key])
+				}
+			}
+			if last := records[len(records)-1]; last["msg"] != "request" || last["duration"] == nil {
+				t.Errorf("unexpected last record: %v", last)
+			}
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{galldir.AccessLogCommon, `^192\.0\.2\.1 - - \[\d\d/\w{3}/\d{4}:\d\d:\d\d:\d\d [+-]\d{4}\] "GET /a/\?x=1 HTTP/1\.1" 201 5` + "\n$"},
+		{galldir.AccessLogCombined, `^192\.0\.2\.1 - - \[.*\] "GET /a/\?x=1 HTTP/1\.1" 201 5 "http://example\.com/" "Agent \\"Smith\\"\\x0a"` + "\n$"},
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("hello"))
+	})
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			r := httptest.NewRequest("GET", "/a/?x=1", nil)
+			r.Header.Set("Referer", "http://example.com/")
+			r.Header.Set("User-Agent", "Agent \"Smith\"\n")
+			galldir.AccessLog(buf, tc.format, handler).ServeHTTP(httptest.NewRecorder(), r)
+			if !regexp.MustCompile(tc.expected).MatchString(buf.String()) {
+				t.Errorf("unexpected log: %q", buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package galldir
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// The names of the files that may hold an album's Manifest, in order of
+// preference.
+const (
+	manifestYAML = "album.yaml"
+	manifestJSON = ".galldir.json"
+)
+
+// Manifest holds the metadata of an album, read from an album.yaml or
+// .galldir.json file in its directory. Hidden lists the names of photos
+// and sub-albums that are left out of the album, while Captions and Tags
+// are keyed by photo file name. An album with a manifest takes all of its
+// metadata from it, ignoring its .title, .date, .cover, .description, .sort
+// and .captions files.
+type Manifest struct {
+	Title       string              `yaml:"title" json:"title"`
+	Date        string              `yaml:"date" json:"date"`
+	Cover       string              `yaml:"cover" json:"cover"`
+	Description string              `yaml:"description" json:"description"`
+	Sort        string              `yaml:"sort" json:"sort"`
+	Hidden      []string            `yaml:"hidden" json:"hidden"`
+	Captions    map[string]string   `yaml:"captions" json:"captions"`
+	Tags        map[string][]string `yaml:"tags" json:"tags"`
+	// source is the path of the file the manifest was read from.
+	source string
+}
+
+// ParseManifest parses a manifest from YAML or, if isJSON is set, JSON.
+func ParseManifest(content string, isJSON bool) (*Manifest, error) {
+	m := &Manifest{}
+	var err error
+	if isJSON {
+		err = json.Unmarshal([]byte(content), m)
+	} else {
+		err = yaml.Unmarshal([]byte(content), m)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IsHidden returns true if the named photo or sub-album is hidden.
+func (m *Manifest) IsHidden(name string) bool {
+	for _, hidden := range m.Hidden {
+		if hidden == name {
+			return true
+		}
+	}
+	return false
+}
+
+// manifest returns the (possibly cached) Manifest of the album at path.
+// An album without a manifest, or with one that cannot be parsed, has an
+// empty Manifest.
+func (p *Provider) manifest(path string) *Manifest {
+	cacheName := CacheName("manifest", path)
+	cacheVal, cached := p.cacheGet(cacheName)
+	if cached {
+		return cacheVal.(*Manifest)
+	}
+	m := &Manifest{}
+	for _, name := range []string{manifestYAML, manifestJSON} {
+		manifestPath := filepath.Join(path, name)
+		content := p.loadFile(manifestPath)
+		if content == "" {
+			continue
+		}
+		parsed, err := ParseManifest(content, name == manifestJSON)
+		if err != nil {
+			p.logger().Warn("failed to parse manifest", "source", manifestPath, "err", err)
+			continue
+		}
+		m = parsed
+		m.source = manifestPath
+		break
+	}
+	p.Cache.Set(cacheName, m, fileTimeout)
+	return m
+}
@@ -0,0 +1,148 @@
+package galldir_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		isJSON    bool
+		title     string
+		expectErr bool
+	}{
+		{"yaml", "title: Foo\nhidden: [a.jpg]\n", false, "Foo", false},
+		{"json", `{"title": "Foo", "hidden": ["a.jpg"]}`, true, "Foo", false},
+		{"bad yaml", "title: [Foo\n", false, "", true},
+		{"bad json", `{"title": `, true, "", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := galldir.ParseManifest(tc.content, tc.isJSON)
+			if err == nil && tc.expectErr {
+				t.Fatal("expected an error")
+			}
+			if err != nil && !tc.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if m.Title != tc.title {
+				t.Errorf("unexpected title: %s", m.Title)
+			}
+			if !m.IsHidden("a.jpg") || m.IsHidden("b.jpg") {
+				t.Errorf("unexpected hidden: %v", m.Hidden)
+			}
+		})
+	}
+}
+
+func TestAlbumManifest(t *testing.T) {
+	provider := galldir.NewProvider(http.Dir("testdata/manifest"))
+	album, err := provider.Album("/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if album.Name != "Seaside" {
+		t.Errorf("unexpected name: %s", album.Name)
+	}
+	if date := album.Time.Format("2006-01-02 15:04:05"); date != "2019-06-01 12:00:00" {
+		t.Errorf("unexpected date: %s", date)
+	}
+	if album.Sort != galldir.SortByNaturalName {
+		t.Errorf("unexpected sort order: %s", album.Sort)
+	}
+	if !strings.Contains(string(album.Description), "<em>beach</em>") {
+		t.Errorf("unexpected description: %s", album.Description)
+	}
+	testImages(t, album.Albums(), []string{"From JSON"})
+	photos := album.Photos()
+	testImages(t, photos, []string{"a.png", "b.png"})
+	if photos[0].Description != "Building sandcastles" || photos[1].Description != "Paddling" {
+		t.Errorf("unexpected captions: %v", photos)
+	}
+	if len(photos[0].Tags) != 2 || photos[0].Tags[1] != "sandcastle" {
+		t.Errorf("unexpected tags: %v", photos[0].Tags)
+	}
+	if album.Image("/secret.png") != nil {
+		t.Error("hidden photo in album")
+	}
+
+	sub, err := provider.Album("/json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.Name != "From JSON" || len(sub.Images) != 0 {
+		t.Errorf("unexpected album: %v", sub)
+	}
+}
+
+func TestManifestCover(t *testing.T) {
+	provider := galldir.NewProvider(http.Dir("testdata/manifest"))
+	album, err := provider.Album("/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = provider.CoverThumb(album, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// b.png is the cover, so its thumbnail is now cached
+	if _, cached := provider.Cache.Get(galldir.ThumbName("thumb", 10, "/b.png")); !cached {
+		t.Error("cover not taken from manifest")
+	}
+}
+
+// recordingFS notes the name of every file opened in it.
+type recordingFS struct {
+	http.FileSystem
+	opened []string
+}
+
+func (fs *recordingFS) Open(name string) (http.File, error) {
+	fs.opened = append(fs.opened, name)
+	return fs.FileSystem.Open(name)
+}
+
+func TestManifestFilesOpened(t *testing.T) {
+	backend := &recordingFS{FileSystem: http.Dir("testdata/manifest")}
+	provider := galldir.NewProvider(backend)
+	if _, err := provider.Album("/", false); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range backend.opened {
+		if name == "/.title" || name == "/.captions" || name == "/.galldir.json" {
+			t.Errorf("opened %s despite the manifest", name)
+		}
+	}
+}
+
+func TestSubAlbumFilesOpened(t *testing.T) {
+	backend := &recordingFS{FileSystem: http.Dir("testdata")}
+	provider := galldir.NewProvider(backend)
+	if _, err := provider.Album("/", false); err != nil {
+		t.Fatal(err)
+	}
+	// each sub-album is listed once, and only its files that exist are read
+	opened := make(map[string]bool)
+	for _, name := range backend.opened {
+		if opened[name] {
+			t.Errorf("opened %s twice", name)
+		}
+		opened[name] = true
+		if _, err := os.Stat(filepath.Join("testdata", name)); err != nil {
+			t.Errorf("looked for missing file %s", name)
+		}
+	}
+	if !opened["/manifest"] || !opened["/manifest/album.yaml"] {
+		t.Errorf("sub-album not read: %v", backend.opened)
+	}
+}
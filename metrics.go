@@ -0,0 +1,314 @@
+package galldir
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics records how the gallery is used and how it performs, and serves
+// the figures in the Prometheus text format. A nil *Metrics records
+// nothing, so instrumented code need not check for one.
+type Metrics struct {
+	provider       *Provider
+	mutex          sync.Mutex
+	requests       map[[2]string]uint64
+	latencies      map[string]*histogram
+	cacheHits      map[string]uint64
+	cacheMisses    map[string]uint64
+	thumbnails     *histogram
+	thumbnailBytes uint64
+	backendCalls   map[string]uint64
+	backendErrors  map[string]uint64
+	decodes        int64
+}
+
+// NewMetrics returns Metrics that record the requests to any handler they
+// instrument, as well as the use of the provider's cache and backend,
+// which it instruments.
+func NewMetrics(p *Provider) *Metrics {
+	m := &Metrics{
+		provider:      p,
+		requests:      make(map[[2]string]uint64),
+		latencies:     make(map[string]*histogram),
+		cacheHits:     make(map[string]uint64),
+		cacheMisses:   make(map[string]uint64),
+		thumbnails:    newHistogram(),
+		backendCalls:  make(map[string]uint64),
+		backendErrors: make(map[string]uint64),
+	}
+	p.FS = &meteredFS{FS: p.FS, metrics: m}
+	p.Metrics = m
+	return m
+}
+
+// latencyBuckets are the upper bounds in seconds of the buckets into which
+// latencies are counted.
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// histogram counts observations into latencyBuckets.
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogram() *histogram {
+	return &histogram{counts: make([]uint64, len(latencyBuckets))}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, bound := range latencyBuckets {
+		if v <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// Instrument returns a handler that records the number and latency of the
+// requests h handles, counted under route.
+func (m *Metrics) Instrument(route string, h http.Handler) http.Handler {
+	if m == nil {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(sw, r)
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		m.requests[[2]string{route, strconv.Itoa(sw.status)}]++
+		latency, ok := m.latencies[route]
+		if !ok {
+			latency = newHistogram()
+			m.latencies[route] = latency
+		}
+		latency.observe(time.Since(start).Seconds())
+	})
+}
+
+// statusWriter is an http.ResponseWriter that notes the status and size
+// of the response.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (m *Metrics) cacheLookup(cacheName string, hit bool) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if hit {
+		m.cacheHits[cacheClass(cacheName)]++
+	} else {
+		m.cacheMisses[cacheClass(cacheName)]++
+	}
+}
+
+// decoding records that an image is being decoded, returning a function to
+// call once it is done.
+func (m *Metrics) decoding() func() {
+	if m == nil {
+		return func() {}
+	}
+	atomic.AddInt64(&m.decodes, 1)
+	return func() { atomic.AddInt64(&m.decodes, -1) }
+}
+
+func (m *Metrics) thumbnail(d time.Duration, size int) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.thumbnails.observe(d.Seconds())
+	m.thumbnailBytes += uint64(size)
+}
+
+// backendCall counts a call to the backend and, if it failed, the error.
+// Files that don't exist are expected, as the sidecars of albums are
+// optional, and the end of a directory is not an error.
+func (m *Metrics) backendCall(op string, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.backendCalls[op]++
+	if err != nil && err != io.EOF && !errors.Is(err, fs.ErrNotExist) {
+		m.backendErrors[op]++
+	}
+}
+
+// meteredFS is an http.FileSystem that counts the calls made to the one it
+// wraps, and their failures.
+type meteredFS struct {
+	FS      http.FileSystem
+	metrics *Metrics
+}
+
+func (fs *meteredFS) Open(name string) (http.File, error) {
+	f, err := fs.FS.Open(name)
+	fs.metrics.backendCall("open", err)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredFile{File: f, metrics: fs.metrics}, nil
+}
+
+type meteredFile struct {
+	http.File
+	metrics *Metrics
+}
+
+func (f *meteredFile) Readdir(count int) ([]os.FileInfo, error) {
+	files, err := f.File.Readdir(count)
+	f.metrics.backendCall("readdir", err)
+	return files, err
+}
+
+func (f *meteredFile) Stat() (os.FileInfo, error) {
+	fi, err := f.File.Stat()
+	f.metrics.backendCall("stat", err)
+	return fi, err
+}
+
+// cacheSizes returns the number of entries in the provider's cache and the
+// bytes held by them, by class. Only images and thumbnails are counted
+// towards the bytes.
+func (m *Metrics) cacheSizes() (map[string]uint64, map[string]uint64) {
+	items, bytes := make(map[string]uint64), make(map[string]uint64)
+	for _, stats := range m.provider.CacheStats() {
+		items[stats.Class] = uint64(stats.Entries)
+		if stats.Bytes > 0 {
+			bytes[stats.Class] = uint64(stats.Bytes)
+		}
+	}
+	return items, bytes
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	items, bytes := m.cacheSizes()
+	buf := bufio.NewWriter(w)
+	defer buf.Flush()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	requests := make(map[string]uint64, len(m.requests))
+	for key, n := range m.requests {
+		requests[labels("route", key[0], "code", key[1])] = n
+	}
+	writeMetric(buf, "galldir_requests_total", "counter", "Requests handled, by route and status.", requests)
+	writeHeader(buf, "galldir_request_duration_seconds", "histogram", "Time taken to handle requests, by route.")
+	for _, route := range sortedKeys(m.latencies) {
+		writeHistogram(buf, "galldir_request_duration_seconds", []string{"route", route}, m.latencies[route])
+	}
+	writeMetric(buf, "galldir_cache_hits_total", "counter", "Cache lookups that found an entry, by class.", classLabels(m.cacheHits))
+	writeMetric(buf, "galldir_cache_misses_total", "counter", "Cache lookups that found no entry, by class.", classLabels(m.cacheMisses))
+	writeMetric(buf, "galldir_cache_entries", "gauge", "Entries in the cache, by class.", classLabels(items))
+	writeMetric(buf, "galldir_cache_bytes", "gauge", "Bytes of images held in the cache, by class.", classLabels(bytes))
+	writeHeader(buf, "galldir_thumbnail_duration_seconds", "histogram", "Time taken to generate thumbnails.")
+	writeHistogram(buf, "galldir_thumbnail_duration_seconds", nil, m.thumbnails)
+	writeMetric(buf, "galldir_thumbnail_bytes_total", "counter", "Bytes of thumbnails generated.", map[string]uint64{"": m.thumbnailBytes})
+	writeMetric(buf, "galldir_backend_calls_total", "counter", "Calls to the backend, by operation.", opLabels(m.backendCalls))
+	writeMetric(buf, "galldir_backend_errors_total", "counter", "Failed calls to the backend, by operation.", opLabels(m.backendErrors))
+	decodes := uint64(atomic.LoadInt64(&m.decodes))
+	writeMetric(buf, "galldir_decodes_in_flight", "gauge", "Images being decoded to make thumbnails.", map[string]uint64{"": decodes})
+}
+
+// labels formats pairs of label names and values.
+func labels(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		parts = append(parts, pairs[i]+"="+strconv.Quote(pairs[i+1]))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func classLabels(values map[string]uint64) map[string]uint64 {
+	return labelled("class", values)
+}
+
+func opLabels(values map[string]uint64) map[string]uint64 {
+	return labelled("op", values)
+}
+
+func labelled(name string, values map[string]uint64) map[string]uint64 {
+	result := make(map[string]uint64, len(values))
+	for value, n := range values {
+		result[labels(name, value)] = n
+	}
+	return result
+}
+
+func sortedKeys(m map[string]*histogram) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// writeMetric writes the values of a metric, keyed by their labels.
+func writeMetric(w io.Writer, name, kind, help string, values map[string]uint64) {
+	writeHeader(w, name, kind, help)
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s%s %d\n", name, key, values[key])
+	}
+}
+
+func writeHistogram(w io.Writer, name string, pairs []string, h *histogram) {
+	for i, bound := range latencyBuckets {
+		le := strconv.FormatFloat(bound, 'g', -1, 64)
+		fmt.Fprintf(w, "%s_bucket%s %d\n", name, labels(append(pairs, "le", le)...), h.counts[i])
+	}
+	fmt.Fprintf(w, "%s_bucket%s %d\n", name, labels(append(pairs, "le", "+Inf")...), h.count)
+	suffix := ""
+	if len(pairs) > 0 {
+		suffix = labels(pairs...)
+	}
+	fmt.Fprintf(w, "%s_sum%s %g\n", name, suffix, h.sum)
+	fmt.Fprintf(w, "%s_count%s %d\n", name, suffix, h.count)
+}
@@ -0,0 +1,59 @@
+package galldir_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestMetrics(t *testing.T) {
+	server := testServer(t)
+	metrics := galldir.NewMetrics(server.Provider)
+	server.Metrics = metrics
+	assets := metrics.Instrument("asset", server.Theme.Assets)
+	for _, url := range []string{
+		"/subalbum/",
+		"/subalbum/icon.png?thumb=50",
+		"/subalbum/icon.png?thumb=50",
+		"/subalbum/icon.png",
+		"/not_there/",
+	} {
+		server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", url, nil))
+	}
+	assets.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/css/galldir.css", nil))
+
+	w := httptest.NewRecorder()
+	metrics.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+	body := w.Body.String()
+	for _, expected := range []string{
+		"# TYPE galldir_requests_total counter\n",
+		`galldir_requests_total{route="album",code="200"} 1` + "\n",
+		`galldir_requests_total{route="album",code="404"} 1` + "\n",
+		`galldir_requests_total{route="thumb",code="200"} 2` + "\n",
+		`galldir_requests_total{route="original",code="200"} 1` + "\n",
+		`galldir_requests_total{route="asset",code="200"} 1` + "\n",
+		`galldir_request_duration_seconds_bucket{route="thumb",le="+Inf"} 2` + "\n",
+		`galldir_request_duration_seconds_count{route="thumb"} 2` + "\n",
+		`galldir_cache_hits_total{class="thumb"} 1` + "\n",
+		`galldir_cache_misses_total{class="thumb"} 1` + "\n",
+		`galldir_cache_entries{class="thumb"} 1` + "\n",
+		`galldir_cache_bytes{class="thumb"} `,
+		"galldir_thumbnail_duration_seconds_count 1\n",
+		`galldir_backend_calls_total{op="open"} `,
+		"galldir_decodes_in_flight 0\n",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("missing %q", expected)
+		}
+	}
+	// /not_there/ and the albums' missing sidecars are not errors
+	if strings.Contains(body, `galldir_backend_errors_total{op="open"}`) {
+		t.Error("missing files counted as backend errors")
+	}
+}
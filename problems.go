@@ -0,0 +1,75 @@
+package galldir
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// The kinds of Problem.
+const (
+	// ProblemBackend is a file in the backend that failed to open or read.
+	ProblemBackend = "backend"
+	// ProblemDecode is a photo that could not be decoded to make a
+	// thumbnail of it.
+	ProblemDecode = "decode"
+)
+
+// maxProblems is the number of recent problems a Provider keeps.
+const maxProblems = 100
+
+// Problem is something that went wrong reading the gallery.
+type Problem struct {
+	Time time.Time
+	Kind string
+	Path string
+	Err  string
+}
+
+// problemLog holds the most recent problems, oldest first.
+type problemLog struct {
+	mutex    sync.Mutex
+	problems []Problem
+}
+
+// problem records a problem with the file at path.
+func (p *Provider) problem(kind, path string, err error) {
+	l := &p.problems
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.problems = append(l.problems, Problem{
+		Time: time.Now(),
+		Kind: kind,
+		Path: path,
+		Err:  err.Error(),
+	})
+	if len(l.problems) > maxProblems {
+		l.problems = l.problems[len(l.problems)-maxProblems:]
+	}
+}
+
+// Problems returns the most recent problems found reading the gallery,
+// newest first.
+func (p *Provider) Problems() []Problem {
+	l := &p.problems
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	problems := make([]Problem, len(l.problems))
+	for i, problem := range l.problems {
+		problems[len(problems)-1-i] = problem
+	}
+	return problems
+}
+
+// open opens the file at path in the backend, recording a problem if it
+// fails for any reason other than the file not existing, as the sidecars of
+// albums are optional.
+func (p *Provider) open(path string) (http.File, error) {
+	f, err := p.FS.Open(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		p.problem(ProblemBackend, path, err)
+	}
+	return f, err
+}
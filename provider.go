@@ -9,8 +9,11 @@ import (
 	_ "image/png" // loaded for image.Decode support
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -28,6 +31,16 @@ const (
 type Provider struct {
 	FS    http.FileSystem
 	Cache *cache.Cache
+	// DateFallback selects where the date of an album without a .date
+	// file (or a date in its manifest) comes from.
+	DateFallback DateFallback
+	loadHooks    []func(*Album)
+	problems     problemLog
+	// Metrics, if set, records the use of the cache and thumbnailing.
+	Metrics *Metrics
+	// Logger receives any problems found with the gallery. If it is nil
+	// the default logger is used.
+	Logger *slog.Logger
 	// ImageCacheEntries limits the number of cached full size images.
 	// This is done to limit the amount of memory consumed, but also puts
 	// an effective limit on the number of images that may be transferred
@@ -63,14 +76,22 @@ func (p *Provider) claimCacheEntry(cacheName string, f func() error) error {
 	return f()
 }
 
+// cacheGet returns the named entry of the cache, noting whether it was
+// found.
+func (p *Provider) cacheGet(cacheName string) (interface{}, bool) {
+	val, ok := p.Cache.Get(cacheName)
+	p.Metrics.cacheLookup(cacheName, ok)
+	return val, ok
+}
+
 func (p *Provider) loadFile(path string) string {
 	cacheName := CacheName("file", path)
-	cacheVal, cached := p.Cache.Get(cacheName)
+	cacheVal, cached := p.cacheGet(cacheName)
 	if cached {
 		return cacheVal.(string)
 	}
 	var content string
-	f, err := p.FS.Open(path)
+	f, err := p.open(path)
 	if err == nil {
 		contentBytes, _ := ioutil.ReadAll(f)
 		content = string(contentBytes)
@@ -80,23 +101,128 @@ func (p *Provider) loadFile(path string) string {
 	return content
 }
 
-func (p *Provider) getDate(path string, modTime time.Time) time.Time {
-	if date := p.loadFile(filepath.Join(path, ".date")); date != "" {
-		t, err := time.Parse("2006-01-02 15:04:05", date)
+// sidecar returns the value of a piece of album metadata, taken from the
+// album's manifest if it has one or otherwise from the named dotfile.
+func (p *Provider) sidecar(path, manifestValue, dotfile string) string {
+	if manifestValue != "" || p.manifest(path).source != "" {
+		return manifestValue
+	}
+	return p.loadFile(filepath.Join(path, dotfile))
+}
+
+// albumFiles are the files in an album's directory that may hold its
+// metadata.
+var albumFiles = []string{
+	manifestYAML, manifestJSON,
+	".title", ".date", ".sort", ".description", ".cover", ".captions",
+}
+
+// listing returns the (possibly cached) files in the directory of the
+// album at path.
+func (p *Provider) listing(path string) ([]os.FileInfo, error) {
+	cacheName := CacheName("listing", strings.TrimSuffix(path, "/")+"/")
+	if cacheVal, cached := p.cacheGet(cacheName); cached {
+		return cacheVal.([]os.FileInfo), nil
+	}
+	dir, err := p.open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+	files, err := dir.Readdir(0)
+	if err != nil {
+		return nil, err
+	}
+	p.setListing(path, files)
+	return files, nil
+}
+
+// setListing caches the files in the directory of the album at path, and
+// notes the album files missing from it so that they are not looked for in
+// the backend. It returns the names of the files.
+func (p *Provider) setListing(path string, files []os.FileInfo) map[string]bool {
+	p.Cache.Set(CacheName("listing", strings.TrimSuffix(path, "/")+"/"), files, fileTimeout)
+	names := make(map[string]bool, len(files))
+	for _, file := range files {
+		names[listedName(path, file)] = true
+	}
+	for _, name := range albumFiles {
+		if !names[name] {
+			p.Cache.Set(CacheName("file", filepath.Join(path, name)), "", fileTimeout)
+		}
+	}
+	return names
+}
+
+// listedName returns the name of a file listed in the directory at path.
+func listedName(path string, file os.FileInfo) string {
+	return strings.TrimPrefix(file.Name(), strings.TrimPrefix(path, "/"))
+}
+
+// getDate returns the date of the album at path, and the end date if it
+// covers a range of dates.
+func (p *Provider) getDate(path string, modTime time.Time) (time.Time, time.Time) {
+	manifest := p.manifest(path)
+	source := filepath.Join(path, ".date")
+	if manifest.Date != "" {
+		source = manifest.source
+	}
+	if date := p.sidecar(path, manifest.Date, ".date"); date != "" {
+		start, end, err := ParseDateRange(date)
 		if err == nil {
-			return t
+			return start, end
 		}
+		p.logger().Warn("ignoring date", "source", source, "err", err)
 	}
-	return modTime
+	if date, ok := p.photoDate(path); ok {
+		return date, time.Time{}
+	}
+	return modTime, time.Time{}
+}
+
+func (p *Provider) getSort(path string) SortOrder {
+	name := p.sidecar(path, p.manifest(path).Sort, ".sort")
+	if name == "" {
+		return ""
+	}
+	order, ok := ParseSortOrder(name)
+	if !ok {
+		p.logger().Warn("unknown sort order", "sort", name, "album", path)
+	}
+	return order
+}
+
+func (p *Provider) getTitle(path string) string {
+	return p.sidecar(path, p.manifest(path).Title, ".title")
 }
 
 func (p *Provider) getName(path string) string {
-	if name := p.loadFile(filepath.Join(path, ".title")); name != "" {
+	if name := p.getTitle(path); name != "" {
 		return name
 	}
 	return NameFromPath(path)
 }
 
+// Breadcrumbs returns a Breadcrumb for each album from the root of the
+// gallery down to and including path, named by their titles where
+// present.
+func (p *Provider) Breadcrumbs(path string) []Breadcrumb {
+	crumbs := Breadcrumbs(path)
+	for i := range crumbs {
+		if name := p.getTitle(crumbs[i].Path); name != "" {
+			crumbs[i].Name = name
+		}
+	}
+	return crumbs
+}
+
+// OnAlbumLoad arranges for f to be called whenever an album is loaded from
+// the backend rather than the cache. It must be called before the Provider
+// is used.
+func (p *Provider) OnAlbumLoad(f func(*Album)) {
+	p.loadHooks = append(p.loadHooks, f)
+}
+
 // Album retrieves a (possibly cached) Album from the backend, or returns an
 // error if it is unable to.
 func (p *Provider) Album(path string, refreshCache bool) (*Album, error) {
@@ -105,7 +231,7 @@ func (p *Provider) Album(path string, refreshCache bool) (*Album, error) {
 	}
 	cacheName := CacheName("album", path)
 	if !refreshCache {
-		cacheVal, cached := p.Cache.Get(cacheName)
+		cacheVal, cached := p.cacheGet(cacheName)
 		if cached {
 			return cacheVal.(*Album), nil
 		}
@@ -115,11 +241,14 @@ func (p *Provider) Album(path string, refreshCache bool) (*Album, error) {
 		return nil, err
 	}
 	p.Cache.SetDefault(cacheName, album)
+	for _, hook := range p.loadHooks {
+		hook(album)
+	}
 	return album, nil
 }
 
 func (p *Provider) loadAlbum(path string) (*Album, error) {
-	albumFile, err := p.FS.Open(path)
+	albumFile, err := p.open(path)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to open %s: %v", path, err)
 	}
@@ -127,39 +256,46 @@ func (p *Provider) loadAlbum(path string) (*Album, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Failed to stat %s: %v", path, err)
 	}
-	a := &Album{
-		Path: path,
-		Name: p.getName(path),
-		Time: p.getDate(path, fi.ModTime()),
-	}
 	files, err := albumFile.Readdir(0)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to readdir %s: %v", path, err)
 	}
+	fileNames := p.setListing(path, files)
+	a := &Album{
+		Path:        path,
+		Name:        p.getName(path),
+		Breadcrumbs: p.Breadcrumbs(path),
+		Sort:        p.getSort(path),
+		Description: p.getDescription(path),
+	}
+	a.Time, a.EndTime = p.getDate(path, fi.ModTime())
+	manifest := p.manifest(path)
 	a.Images = make([]Image, 0, len(files))
 	for _, file := range files {
-		fileName := strings.TrimPrefix(file.Name(), strings.TrimPrefix(path, "/"))
-		if !file.IsDir() && !IsImage(fileName) {
+		fileName := listedName(path, file)
+		if !file.IsDir() && !IsImage(fileName) || manifest.IsHidden(fileName) {
 			continue
 		}
 		path := filepath.Join(path, fileName)
-		a.Images = append(a.Images, Image{
-			Path: path,
-			Name: func() string {
-				if file.IsDir() {
-					return p.getName(path)
-				}
-				return fileName
-			}(),
-			Time: func() time.Time {
-				if file.IsDir() {
-					return p.getDate(path, fi.ModTime())
-				}
-				return file.ModTime()
-			}(),
+		im := Image{
+			Path:    path,
+			Name:    fileName,
+			Time:    file.ModTime(),
 			IsAlbum: file.IsDir(),
-		})
+			Tags:    mergeTags(nil, manifest.Tags[fileName]),
+		}
+		if file.IsDir() {
+			// listing the sub-album shows which of its files to read
+			p.listing(path)
+			im.Name = p.getName(path)
+			im.Time, im.EndTime = p.getDate(path, file.ModTime())
+		} else {
+			im.Size = file.Size()
+		}
+		a.Images = append(a.Images, im)
 	}
+	p.loadCaptions(a, fileNames)
+	p.loadTags(a, fileNames)
 	return a, nil
 }
 
@@ -171,18 +307,20 @@ func (p *Provider) ImageContent(path string) (io.ReadSeeker, error) {
 		return nil, errors.New("not an image")
 	}
 	cacheName := CacheName("image", path)
-	cachedImage, cached := p.Cache.Get(cacheName)
+	cachedImage, cached := p.cacheGet(cacheName)
 	if cached {
 		return bytes.NewReader(cachedImage.([]byte)), nil
 	}
 	var image []byte
 	err := p.claimCacheEntry(cacheName, func() error {
-		src, err := p.FS.Open(path)
+		src, err := p.open(path)
 		if err != nil {
 			return err
 		}
 		image, err = ioutil.ReadAll(src)
+		src.Close()
 		if err != nil {
+			p.problem(ProblemBackend, path, err)
 			return err
 		}
 		p.Cache.SetDefault(cacheName, image)
@@ -195,24 +333,42 @@ func (p *Provider) ImageContent(path string) (io.ReadSeeker, error) {
 }
 
 func (p *Provider) resizedImage(src io.ReadSeeker, size int, cacheName string) (io.ReadSeeker, error) {
+	defer p.Metrics.decoding()()
+	start := time.Now()
+	buf := bytes.NewBuffer(nil)
+	if err := resize(buf, src, size); err != nil {
+		return nil, err
+	}
+	jpgBytes := buf.Bytes()
+	p.Metrics.thumbnail(time.Since(start), len(jpgBytes))
+	p.Cache.SetDefault(cacheName, jpgBytes)
+	return bytes.NewReader(jpgBytes), nil
+}
+
+// resize decodes the image read from src and writes it to dst as a JPEG,
+// scaled so that its longer side is size.
+func resize(dst io.Writer, src io.Reader, size int) error {
+	im, err := scale(src, size)
+	if err != nil {
+		return err
+	}
+	if err := jpeg.Encode(dst, im, nil); err != nil {
+		return fmt.Errorf("failed to encode image: %v", err)
+	}
+	return nil
+}
+
+// scale decodes the image read from src, scaled so that its longer side is
+// size.
+func scale(src io.Reader, size int) (image.Image, error) {
 	im, _, err := image.Decode(src)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode image: %v", err)
 	}
-	var thumb image.Image
 	if p := im.Bounds().Size(); p.X > p.Y {
-		thumb = imaging.Resize(im, size, 0, imaging.Lanczos)
-	} else {
-		thumb = imaging.Resize(im, 0, size, imaging.Lanczos)
+		return imaging.Resize(im, size, 0, imaging.Lanczos), nil
 	}
-	buf := bytes.NewBuffer(nil)
-	err = jpeg.Encode(buf, thumb, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode image: %v", err)
-	}
-	jpgBytes := buf.Bytes()
-	p.Cache.SetDefault(cacheName, jpgBytes)
-	return bytes.NewReader(jpgBytes), nil
+	return imaging.Resize(im, 0, size, imaging.Lanczos), nil
 }
 
 // CacheName generates a unique key for the cache
@@ -228,7 +384,7 @@ func ThumbName(class string, size int, path string) string {
 // CachedThumb returns a (potentially cached) thumbnail of the supplied
 // source image
 func (p *Provider) CachedThumb(cacheName string, size int, src io.ReadSeeker) (io.ReadSeeker, error) {
-	cachedImage, cached := p.Cache.Get(cacheName)
+	cachedImage, cached := p.cacheGet(cacheName)
 	if cached {
 		return bytes.NewReader(cachedImage.([]byte)), nil
 	}
@@ -239,7 +395,7 @@ func (p *Provider) CachedThumb(cacheName string, size int, src io.ReadSeeker) (i
 // at the given path, scaled to the size.
 func (p *Provider) ImageThumb(path string, size int) (io.ReadSeeker, error) {
 	cacheName := ThumbName("thumb", size, path)
-	cachedImage, cached := p.Cache.Get(cacheName)
+	cachedImage, cached := p.cacheGet(cacheName)
 	if cached {
 		return bytes.NewReader(cachedImage.([]byte)), nil
 	}
@@ -247,18 +403,145 @@ func (p *Provider) ImageThumb(path string, size int) (io.ReadSeeker, error) {
 	if err != nil {
 		return nil, err
 	}
-	return p.resizedImage(src, size, cacheName)
+	thumb, err := p.resizedImage(src, size, cacheName)
+	if err != nil {
+		p.problem(ProblemDecode, path, err)
+	}
+	return thumb, err
+}
+
+// Cover returns the photo used as the album's cover: the one named by its
+// manifest or .cover file or else its first photo. Its path is the real
+// path of the photo in the backend.
+func (p *Provider) Cover(album *Album) (Image, error) {
+	cover := ""
+	if !album.Virtual {
+		cover = p.sidecar(album.Path, p.manifest(album.Path).Cover, ".cover")
+	}
+	if cover != "" {
+		coverPath := filepath.Join(album.Path, cover)
+		if im := album.Image(coverPath); im != nil {
+			return *im, nil
+		}
+		return Image{Path: coverPath, Name: cover}, nil
+	}
+	photos := album.Photos()
+	if len(photos) == 0 {
+		return Image{}, errors.New("no photo for cover " + album.Path)
+	}
+	photo := photos[0]
+	photo.Path = album.RealPath(photo.Path)
+	return photo, nil
 }
 
 // CoverThumb returns a (potentially cached) thumbnail of the album cover,
 // scaled to the size
 func (p *Provider) CoverThumb(album *Album, size int) (io.ReadSeeker, error) {
-	if cover := p.loadFile(filepath.Join(album.Path, ".cover")); cover != "" {
-		return p.ImageThumb(filepath.Join(album.Path, cover), size)
+	cover, err := p.Cover(album)
+	if err != nil {
+		return nil, err
 	}
-	photos := album.Photos()
-	if len(photos) == 0 {
-		return nil, errors.New("no photo for cover " + album.Path)
+	return p.ImageThumb(cover.Path, size)
+}
+
+// cacheClass returns the class of a cache entry, given its name, with any
+// thumbnail size removed.
+func cacheClass(cacheName string) string {
+	class := strings.SplitN(cacheName, "-", 2)[0]
+	return strings.TrimRight(class, "0123456789")
+}
+
+// CacheStats is the number of entries of a class, such as "album" or
+// "thumb", in a Provider's cache. Bytes counts the size of the images and
+// thumbnails held by them.
+type CacheStats struct {
+	Class   string
+	Entries int
+	Bytes   int64
+}
+
+// CacheStats returns the number of entries in the cache and their size, by
+// class.
+func (p *Provider) CacheStats() []CacheStats {
+	classes := make(map[string]*CacheStats)
+	for name, item := range p.Cache.Items() {
+		class := cacheClass(name)
+		stats, ok := classes[class]
+		if !ok {
+			stats = &CacheStats{Class: class}
+			classes[class] = stats
+		}
+		stats.Entries++
+		if content, ok := item.Object.([]byte); ok {
+			stats.Bytes += int64(len(content))
+		}
+	}
+	result := make([]CacheStats, 0, len(classes))
+	for _, stats := range classes {
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Class < result[j].Class
+	})
+	return result
+}
+
+// Invalidate removes everything cached about the album or image at path,
+// and everything beneath it, so that it is read afresh from the backend.
+// The listing of the album containing it is removed too. It returns the
+// number of entries removed.
+func (p *Provider) Invalidate(path string) int {
+	prefix := strings.TrimSuffix(path, "/") + "/"
+	parent := ""
+	if prefix != "/" {
+		parent = strings.TrimSuffix(filepath.Dir(strings.TrimSuffix(prefix, "/")), "/") + "/"
+	}
+	return p.invalidate(func(class, entryPath string) bool {
+		if entryPath == parent && (class == "album" || class == "embeddedalbum" || class == "listing") {
+			return true
+		}
+		return strings.HasPrefix(entryPath+"/", prefix)
+	})
+}
+
+// InvalidateThumbs removes every thumbnail from the cache, returning the
+// number removed.
+func (p *Provider) InvalidateThumbs() int {
+	return p.invalidate(func(class, entryPath string) bool {
+		return class == "thumb" || class == "assetthumb"
+	})
+}
+
+// invalidate removes the cache entries for which match returns true.
+func (p *Provider) invalidate(match func(class, path string) bool) int {
+	removed := 0
+	for name := range p.Cache.Items() {
+		parts := strings.SplitN(name, "-", 2)
+		// entries without a path, such as imageIndex, are bookkeeping
+		if len(parts) < 2 || !match(cacheClass(name), parts[1]) {
+			continue
+		}
+		p.Cache.Delete(name)
+		removed++
+	}
+	return removed
+}
+
+// Walk loads the album at root and every album beneath it, breadth first,
+// calling f with each. Albums that fail to load are logged and skipped.
+func (p *Provider) Walk(root string, f func(*Album)) {
+	queue := []string{root}
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+		album, err := p.Album(path, false)
+		if err != nil {
+			p.logger().Error("failed to load album", "album", path, "err", err)
+			continue
+		}
+		f(album)
+		for _, im := range album.Albums() {
+			queue = append(queue, im.Path)
+		}
 	}
-	return p.ImageThumb(photos[0].Path, size)
 }
@@ -198,3 +198,49 @@ func TestCoverThumb(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderBreadcrumbs(t *testing.T) {
+	provider := galldir.NewProvider(http.Dir("testdata"))
+	album, err := provider.Album("/album/subalbum", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []galldir.Breadcrumb{
+		{Name: "Home", Path: "/"},
+		{Name: "Test Album", Path: "/album/"},
+		{Name: "Subalbum", Path: "/album/subalbum/"},
+	}
+	if len(album.Breadcrumbs) != len(expected) {
+		t.Fatalf("unexpected number of breadcrumbs: %d", len(album.Breadcrumbs))
+	}
+	for i := range expected {
+		if album.Breadcrumbs[i] != expected[i] {
+			t.Errorf("unexpected breadcrumb: %v", album.Breadcrumbs[i])
+		}
+	}
+	if parent := album.Parent(); parent == nil || parent.Path != "/album/" {
+		t.Errorf("unexpected parent: %v", parent)
+	}
+}
+
+func TestAlbumSort(t *testing.T) {
+	provider := galldir.NewProvider(http.Dir("testdata/sorted"))
+	album, err := provider.Album("/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if album.Sort != galldir.SortByNaturalName {
+		t.Errorf("unexpected sort order: %s", album.Sort)
+	}
+	testImages(t, album.Photos(), []string{"img_2.png", "IMG_9.jpg", "IMG_10.jpg"})
+	if im := album.Image("/IMG_9.jpg"); im == nil || im.Exif != nil {
+		t.Error("EXIF data read when loading the album")
+	}
+	album = provider.Embedded(album)
+	album.Sort = galldir.SortByTaken
+	// img_2.png has no EXIF data so is sorted by its modification time
+	photos := album.Photos()
+	if photos[0].Name != "IMG_10.jpg" || photos[1].Name != "IMG_9.jpg" {
+		t.Errorf("unexpected order: %v", photos)
+	}
+}
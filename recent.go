@@ -0,0 +1,74 @@
+package galldir
+
+import (
+	"strings"
+)
+
+const recentPath = "/recent/"
+
+// Recent returns a virtual album of the n photos most recently added to
+// the gallery or, if byTaken is set, most recently taken. Each photo's
+// path in the album is its real path prefixed by the album's.
+func (x *Index) Recent(n int, byTaken bool) *Album {
+	views := x.currentViews()
+	photos, order := views.added, SortByTime
+	if byTaken {
+		photos, order = views.taken, SortByTakenReversed
+	}
+	if len(photos) > n {
+		photos = photos[:n]
+	}
+	result := &Album{
+		Path:        recentPath,
+		Name:        "Recent",
+		Breadcrumbs: []Breadcrumb{{Name: "Home", Path: "/"}, {Name: "Recent", Path: recentPath}},
+		Sort:        order,
+		Virtual:     true,
+	}
+	result.Images = make([]Image, 0, len(photos))
+	for _, photo := range photos {
+		photo.Path = strings.TrimSuffix(recentPath, "/") + photo.Path
+		result.Images = append(result.Images, photo)
+	}
+	return result
+}
+
+// recentAlbum returns the album of recent photos, or nil if it is disabled
+// or empty.
+func (s *Server) recentAlbum() *Album {
+	if s.Index == nil || s.RecentPhotos <= 0 {
+		return nil
+	}
+	recent := s.Index.Recent(s.RecentPhotos, s.RecentByTaken)
+	if len(recent.Images) == 0 {
+		return nil
+	}
+	return recent
+}
+
+// withRecent returns a copy of the root album that also holds the album
+// of recent photos, dated by the newest of them. Any other album is
+// returned as it is.
+func (s *Server) withRecent(root *Album) *Album {
+	if root.Path != "/" || root.Virtual {
+		return root
+	}
+	recent := s.recentAlbum()
+	if recent == nil {
+		return root
+	}
+	newest := recent.Images[0]
+	entry := Image{
+		Path:    strings.TrimSuffix(recentPath, "/"),
+		Name:    recent.Name,
+		Time:    newest.Time,
+		IsAlbum: true,
+		Count:   len(recent.Images),
+	}
+	if s.RecentByTaken {
+		entry.Time = newest.Taken()
+	}
+	withRecent := *root
+	withRecent.Images = append(append(make([]Image, 0, len(root.Images)+1), root.Images...), entry)
+	return &withRecent
+}
@@ -0,0 +1,112 @@
+package galldir_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestIndexRecent(t *testing.T) {
+	p := galldir.NewProvider(http.Dir("testdata/sorted"))
+	x := galldir.NewIndex(p)
+	x.Crawl()
+
+	album := x.Recent(2, true)
+	var paths []string
+	for _, im := range album.Photos() {
+		paths = append(paths, im.Path)
+	}
+	if strings.Join(paths, " ") != "/recent/img_2.png /recent/IMG_9.jpg" {
+		t.Errorf("unexpected recently taken photos: %v", paths)
+	}
+	if real := album.RealPath(paths[1]); real != "/IMG_9.jpg" {
+		t.Errorf("unexpected real path: %s", real)
+	}
+
+	photos := x.Recent(10, false).Photos()
+	if len(photos) != 3 {
+		t.Fatalf("unexpected recently added photos: %v", photos)
+	}
+	for i := 1; i < len(photos); i++ {
+		if photos[i].Time.After(photos[i-1].Time) {
+			t.Errorf("recently added photos out of order: %v", photos)
+		}
+	}
+
+	// the recent photos are gathered afresh once the index changes
+	x.Update(&galldir.Album{Path: "/new/", Images: []galldir.Image{{Path: "/new/a.jpg", Time: time.Now()}}})
+	if photos := x.Recent(1, false).Photos(); len(photos) != 1 || photos[0].Path != "/recent/new/a.jpg" {
+		t.Errorf("index update not reflected in recent photos: %v", photos)
+	}
+}
+
+func TestServeRecent(t *testing.T) {
+	server := testServer(t)
+	server.Provider = galldir.NewProvider(http.Dir("testdata/sorted"))
+	server.Index = galldir.NewIndex(server.Provider)
+	server.Index.Crawl()
+	server.RecentPhotos = 2
+	tests := []struct {
+		url      string
+		status   int
+		contains string
+	}{
+		{"/", http.StatusOK, `<figcaption>Recent<br />2 photos</figcaption>`},
+		{"/?format=json", http.StatusOK, `"path":"/recent","name":"Recent"`},
+		{"/feed.atom", http.StatusOK, `<id>http://example.com/recent</id>`},
+		{"/recent/", http.StatusOK, `<h1>Recent</h1>`},
+		{"/recent/?format=json", http.StatusOK, `"path":"/recent/`},
+		{"/recent/feed.atom", http.StatusOK, `<title>Recent</title>`},
+		{"/recent/img_2.png", http.StatusOK, "PNG"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.status {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+
+	server.RecentPhotos = 0
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/recent/", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unexpected status with no recent album: %d", w.Code)
+	}
+}
+
+func TestServeRecentDirectory(t *testing.T) {
+	server := shadowedServer(t, "recent")
+	tests := []struct {
+		recent   int
+		url      string
+		contains string
+	}{
+		{0, "/recent/", `href="/recent/img_2.png"`},
+		{0, "/recent/img_2.png", "PNG"},
+		{10, "/recent/", `href="/recent/recent/img_2.png"`},
+		{10, "/recent/img_2.png", "PNG"},
+		{10, "/recent/recent/img_2.png", "PNG"},
+	}
+	for _, tc := range tests {
+		server.RecentPhotos = tc.recent
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+		if w.Code != http.StatusOK {
+			t.Errorf("%s with %d recent photos: unexpected status: %d", tc.url, tc.recent, w.Code)
+			continue
+		}
+		if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+			t.Errorf("%s with %d recent photos: unexpected body: %s", tc.url, tc.recent, body)
+		}
+	}
+}
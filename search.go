@@ -0,0 +1,72 @@
+package galldir
+
+import (
+	"strings"
+)
+
+// searchText returns the lower case text that an image may be found by:
+// its name, caption, tags, and the camera and date from its EXIF data.
+func searchText(im Image) string {
+	text := []string{im.Name, im.Description}
+	text = append(text, im.Tags...)
+	if im.Exif != nil {
+		text = append(text, im.Exif.Camera, im.Exif.Lens)
+		if !im.Exif.Taken.IsZero() {
+			text = append(text,
+				im.Exif.Taken.Format(dateFormat),
+				im.Exif.Taken.Format("2006-01-02"))
+		}
+	}
+	return strings.ToLower(strings.Join(text, "\n"))
+}
+
+// matches returns true if text contains every one of the terms.
+func matches(text string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// Search returns a virtual album of the albums and photos matching every
+// word of the query. Albums match by their title and description, photos
+// by their file name, caption, tags, EXIF camera and date, and the title
+// of the album holding them.
+func (x *Index) Search(query string) *Album {
+	result := &Album{
+		Path:        searchPath,
+		Name:        "Search",
+		Breadcrumbs: []Breadcrumb{{Name: "Home", Path: "/"}},
+		Sort:        SortByTaken,
+		Virtual:     true,
+		realPaths:   true,
+	}
+	if query != "" {
+		result.Name = "Search: " + query
+	}
+	result.Breadcrumbs = append(result.Breadcrumbs, Breadcrumb{Name: result.Name, Path: searchPath})
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return result
+	}
+	for _, album := range x.Albums() {
+		albumText := strings.ToLower(album.Name + "\n" + string(album.Description))
+		if album.Path != "/" && matches(albumText, terms) {
+			result.Images = append(result.Images, Image{
+				Path:    strings.TrimSuffix(album.Path, "/"),
+				Name:    album.Name,
+				Time:    album.Time,
+				EndTime: album.EndTime,
+				IsAlbum: true,
+			})
+		}
+		for _, photo := range album.Photos() {
+			if matches(albumText+"\n"+searchText(photo), terms) {
+				result.Images = append(result.Images, photo)
+			}
+		}
+	}
+	return result
+}
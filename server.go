@@ -1,66 +1,388 @@
 package galldir
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"html/template"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 )
 
-// Server implements to http.Handler interface to serve a photo gallery
+// Server implements to http.Handler interface to serve a photo gallery.
+// Albums are split into pages of PageSize items, and if InfiniteScroll is
+// set further pages are fetched as the user scrolls.
 type Server struct {
-	Provider *Provider
-	Assets   http.FileSystem
+	Provider       *Provider
+	Assets         http.FileSystem
+	Theme          *Theme
+	PageSize       int
+	InfiniteScroll bool
+	// Index, if set, enables searching the gallery.
+	Index *Index
+	// TileURL is the URL template, such as
+	// https://tile.openstreetmap.org/{z}/{x}/{y}.png, of the map tiles
+	// shown behind geotagged photos. TileAttribution is the HTML crediting
+	// the tiles' source.
+	TileURL         string
+	TileAttribution template.HTML
+	// BaseURL is the public URL of the gallery, such as
+	// https://photos.example.com, used where absolute URLs are needed. If
+	// it is empty the URL is taken from each request.
+	BaseURL string
+	// RecentPhotos is the number of photos in the album of those most
+	// recently added to the gallery, shown on the front page, or 0 to
+	// leave it out. If RecentByTaken is set it holds the photos most
+	// recently taken instead. It needs an Index.
+	RecentPhotos  int
+	RecentByTaken bool
+	// DownloadLimit is the largest total size in bytes of the photos that
+	// may be downloaded together as a ZIP archive, or 0 to disable
+	// downloads.
+	DownloadLimit int64
+	// PathPrefix is the path, such as /photos, at which the gallery is
+	// mounted on its site. It must already have been removed from the
+	// requests the Server handles, as http.StripPrefix does. If
+	// TrustForwardedPrefix is set, the prefix is taken from the
+	// X-Forwarded-Prefix header of requests that have one, for a reverse
+	// proxy that removes the prefix itself.
+	PathPrefix           string
+	TrustForwardedPrefix bool
+	// Metrics, if set, records the requests the Server handles.
+	Metrics *Metrics
+	// Logger receives a record of each request and of any errors. If it is
+	// nil the default logger is used.
+	Logger *slog.Logger
+	// Admin, if set, enables the administration page at /admin.
+	Admin *Admin
 }
 
 const (
-	albumPath = "/img/album.png"
+	albumPath       = "/img/album.png"
+	searchPath      = "/search"
+	mapPath         = "/map"
+	defaultPageSize = 100
+	// socialThumb is the size of the album cover shown in previews of
+	// links to the album.
+	socialThumb = 1200
 )
 
 func (s *Server) albumThumb(w http.ResponseWriter, r *http.Request, album *Album, thumbSize int) {
-	content, err := s.Provider.CoverThumb(album, thumbSize)
+	var content io.ReadSeeker
+	var tag string
+	cover, err := s.Provider.Cover(album)
+	if err == nil {
+		tag = photoETag(cover.Path, cover.Size, cover.Time, thumbSize)
+		if notModified(w, r, tag, cacheCover) {
+			return
+		}
+		s.noteCache(r, ThumbName("thumb", thumbSize, cover.Path))
+		content, err = s.Provider.ImageThumb(cover.Path, thumbSize)
+	}
 	if err != nil {
-		log.Println(err)
+		requestLogger(r).Warn("no album cover", "err", err)
+		cover = Image{}
+		tag = etag(s.theme().Assets.URL(albumPath), thumbSize)
+		if notModified(w, r, tag, cacheCover) {
+			return
+		}
 		content, err = s.assetThumb(albumPath, thumbSize)
 	}
 	if err != nil {
-		log.Println(err)
+		s.error(w, r, http.StatusInternalServerError, fmt.Errorf("failed to make album thumbnail: %v", err))
+		return
+	}
+	cacheable(w, tag, cacheCover)
+	http.ServeContent(w, r, "", cover.Time, content)
+}
+
+var defaultTheme = func() *Theme {
+	theme, err := NewTheme("", nil)
+	if err != nil {
+		panic(err)
+	}
+	return theme
+}()
+
+func (s *Server) theme() *Theme {
+	if s.Theme == nil {
+		return defaultTheme
+	}
+	return s.Theme
+}
+
+// pathPrefix returns the path at which the gallery is mounted for the
+// request, without a trailing slash.
+func (s *Server) pathPrefix(r *http.Request) string {
+	prefix := s.PathPrefix
+	if forwarded := r.Header.Get("X-Forwarded-Prefix"); s.TrustForwardedPrefix && forwarded != "" {
+		prefix = forwarded
+	}
+	return CleanPathPrefix(prefix)
+}
+
+// CleanPathPrefix returns the canonical form of the path at which a
+// gallery is mounted: empty for the root of the site, or else starting
+// with a slash and without a trailing one.
+func CleanPathPrefix(prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return ""
+	}
+	return path.Clean("/" + prefix)
+}
+
+func (s *Server) template(r *http.Request, name string) *template.Template {
+	return s.theme().MountedTemplate(name, s.pathPrefix(r))
+}
+
+func (s *Server) render(w http.ResponseWriter, r *http.Request, name string, data interface{}) {
+	err := s.template(r, name).Execute(w, data)
+	if err != nil {
+		requestLogger(r).Error("failed to render template", "template", name, "err", err)
+	}
+}
+
+// renderPage renders a template as the whole of a successful response.
+func (s *Server) renderPage(w http.ResponseWriter, r *http.Request, name string, data interface{}) {
+	buf := bytes.NewBuffer(nil)
+	err := s.template(r, name).Execute(buf, data)
+	if err != nil {
+		s.error(w, r, http.StatusInternalServerError, fmt.Errorf("failed to render %s: %v", name, err))
 		return
 	}
-	http.ServeContent(w, r, "", time.Now(), content)
+	serveBody(w, r, "text/html; charset=utf-8", buf.Bytes())
+}
+
+// error logs err and renders the error page. The error itself is not
+// shown to the user as it may reveal details of the backend.
+func (s *Server) error(w http.ResponseWriter, r *http.Request, status int, err error) {
+	s.explainError(w, r, status, err, "")
+}
+
+// explainError is like error, but also tells the visitor why with message.
+func (s *Server) explainError(w http.ResponseWriter, r *http.Request, status int, err error, message string) {
+	level := slog.LevelWarn
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	requestLogger(r).Log(r.Context(), level, http.StatusText(status), "err", err)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	s.render(w, r, "error.html", struct {
+		Status     int
+		StatusText string
+		Path       string
+		Message    string
+	}{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Path:       r.URL.Path,
+		Message:    message,
+	})
+}
+
+// lookupAlbum returns the album at path, which may be a virtual album of
+// tagged photos, the timeline or recent photos, or otherwise the real
+// album there.
+func (s *Server) lookupAlbum(albumPath string) (*Album, error) {
+	if s.Index == nil {
+		return s.Provider.Album(albumPath, false)
+	}
+	switch {
+	case strings.HasPrefix(albumPath+"/", tagPath):
+		segment := strings.SplitN(strings.TrimPrefix(albumPath+"/", tagPath), "/", 2)[0]
+		if segment == "" {
+			return s.Index.Tags(), nil
+		}
+		// a real tag directory is browsed below any name no photo is
+		// tagged with
+		if name, ok := tagName(segment); ok {
+			if album, ok := s.Index.Tag(name); ok {
+				return album, nil
+			}
+		}
+	case strings.HasPrefix(albumPath+"/", timelinePath):
+		// a real timeline directory is browsed below any path that isn't a
+		// period with photos
+		if album, ok := s.Index.Timeline(albumPath); ok {
+			return album, nil
+		}
+	case strings.HasPrefix(albumPath+"/", recentPath):
+		// a real recent directory is browsed while there is no album of
+		// recent photos to show instead
+		if album := s.recentAlbum(); album != nil {
+			return album, nil
+		}
+	}
+	return s.Provider.Album(albumPath, false)
+}
+
+// exactAlbum returns the album at albumPath like lookupAlbum, but fails
+// if the path continues beyond a virtual album, as the paths of its photos
+// do.
+func (s *Server) exactAlbum(albumPath string) (*Album, error) {
+	album, err := s.lookupAlbum(albumPath)
+	if err != nil {
+		return nil, err
+	}
+	if album.Path != strings.TrimSuffix(albumPath, "/")+"/" {
+		return nil, fmt.Errorf("no album at %s", albumPath)
+	}
+	return album, nil
 }
 
 func (s *Server) album(w http.ResponseWriter, r *http.Request) {
-	refresh := cacheRefresh(r)
-	album, err := s.Provider.Album(r.URL.Path, refresh)
+	album, err := s.exactAlbum(r.URL.Path)
 	if err != nil {
-		log.Println(err)
+		s.error(w, r, http.StatusNotFound, err)
 		return
 	}
+	noteAlbum(r, album)
 	thumbSize, needThumb := isThumb(r)
 	if needThumb {
 		s.albumThumb(w, r, album, thumbSize)
 		return
 	}
+	s.renderAlbum(w, r, album, "")
+}
+
+// renderAlbum renders a page of the album. query holds any URL parameters
+// beyond those understood by renderAlbum that are needed to reach the album,
+// and must begin with "&".
+func (s *Server) renderAlbum(w http.ResponseWriter, r *http.Request, album *Album, query template.URL) {
+	if isMapView(r) || isGeoJSON(r) {
+		s.renderMap(w, r, s.Provider.Embedded(album), query)
+		return
+	}
+	if isDownload(r) {
+		s.download(w, r, album)
+		return
+	}
+	sortOrder, sorted := ParseSortOrder(r.URL.Query().Get("sort"))
+	order := album.Sort
+	if sorted {
+		order = sortOrder
+	}
+	// EXIF data is only read from the backend when the album is sorted by
+	// it, and is otherwise shown if the index's crawl has already read it
+	album = s.Provider.withEmbedded(album, order.byTaken())
+	if sorted {
+		sortedAlbum := *album
+		sortedAlbum.Sort = sortOrder
+		album = &sortedAlbum
+	}
+	album = s.withRecent(album)
+	pageNumber, ok := requestParamInt(r, "page")
+	if !ok {
+		pageNumber = 1
+	}
+	albumPage, ok := album.Page(pageNumber, s.pageSize())
+	if !ok {
+		s.error(w, r, http.StatusNotFound, fmt.Errorf("no page %d of album %s", pageNumber, album.Path))
+		return
+	}
+	feed := path.Join(album.Path, feedName)
+	imageURL := absoluteURL(s.baseURL(r), album.Path, fmt.Sprintf("thumb=%d", socialThumb))
+	if query != "" {
+		// results of a search have no feed or cover
+		feed, imageURL = "", ""
+	}
 	page := struct {
-		Refresh template.URL
-		Album   *Album
+		Query          template.URL `json:"-"`
+		InfiniteScroll bool         `json:"-"`
+		Sort           SortOrder    `json:"-"`
+		SortOrders     []SortOrder  `json:"-"`
+		CanSearch      bool         `json:"-"`
+		Search         string       `json:"-"`
+		HasMap         bool         `json:"-"`
+		CanDownload    bool         `json:"-"`
+		Feed           string       `json:"-"`
+		URL            string       `json:"-"`
+		ImageURL       string       `json:"-"`
+		Summary        string       `json:"-"`
+		Album          *Album       `json:"album"`
+		*AlbumPage
 	}{
-		Refresh: func() template.URL {
-			if refresh {
-				return template.URL("&refresh=1")
-			}
-			return template.URL("")
-		}(),
-		Album: album,
+		Query:          query,
+		InfiniteScroll: s.InfiniteScroll,
+		Sort:           sortOrder,
+		SortOrders:     SortOrders,
+		CanSearch:      s.Index != nil,
+		Search:         r.URL.Query().Get("q"),
+		HasMap:         HasLocation(album.Photos()),
+		CanDownload:    s.DownloadLimit > 0 && len(album.Images) > 0,
+		Feed:           feed,
+		URL:            absoluteURL(s.baseURL(r), album.Path, strings.TrimPrefix(string(query), "&")),
+		ImageURL:       imageURL,
+		Summary:        albumSummary(album),
+		Album:          album,
+		AlbumPage:      albumPage,
+	}
+	if isJSON(r) {
+		s.renderJSON(w, r, "application/json", page)
+		return
+	}
+	s.renderPage(w, r, "index.html", page)
+}
+
+// renderMap renders a map of where the album's photos were taken or, if
+// requested, their locations as GeoJSON.
+func (s *Server) renderMap(w http.ResponseWriter, r *http.Request, album *Album, query template.URL) {
+	if isGeoJSON(r) {
+		s.renderJSON(w, r, "application/geo+json", NewGeoJSON(album.Photos()))
+		return
 	}
-	err = indexTemplate.Execute(w, page)
+	tileURL, attribution := s.TileURL, s.TileAttribution
+	if tileURL == "" {
+		tileURL, attribution = defaultTileURL, defaultTileAttribution
+	}
+	s.renderPage(w, r, "map.html", struct {
+		Album           *Album
+		GeoJSON         template.URL
+		TileURL         string
+		TileAttribution template.HTML
+	}{
+		Album:           album,
+		GeoJSON:         "?format=geojson" + query,
+		TileURL:         tileURL,
+		TileAttribution: attribution,
+	})
+}
+
+// albumSummary describes the album in a line of plain text, for previews
+// of links to it.
+func albumSummary(album *Album) string {
+	if summary := PlainText(album.Description); summary != "" {
+		return summary
+	}
+	photos := len(album.Photos())
+	if photos == 1 {
+		return "1 photo"
+	}
+	return fmt.Sprintf("%d photos", photos)
+}
+
+func (s *Server) pageSize() int {
+	if s.PageSize <= 0 {
+		return defaultPageSize
+	}
+	return s.PageSize
+}
+
+func (s *Server) renderJSON(w http.ResponseWriter, r *http.Request, contentType string, data interface{}) {
+	body, err := json.Marshal(data)
 	if err != nil {
-		log.Println(err)
+		s.error(w, r, http.StatusInternalServerError, err)
+		return
 	}
+	serveBody(w, r, contentType, append(body, '\n'))
 }
 
 func requestParamInt(r *http.Request, flag string) (int, bool) {
@@ -76,15 +398,26 @@ func requestParamInt(r *http.Request, flag string) (int, bool) {
 	return value, true
 }
 
-func cacheRefresh(r *http.Request) bool {
-	_, ok := requestParamInt(r, "refresh")
-	return ok
-}
-
 func isThumb(r *http.Request) (int, bool) {
 	return requestParamInt(r, "thumb")
 }
 
+func isJSON(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "json"
+}
+
+func isGeoJSON(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "geojson"
+}
+
+func isPageView(r *http.Request) bool {
+	return r.URL.Query().Get("view") == "page"
+}
+
+func isMapView(r *http.Request) bool {
+	return r.URL.Query().Get("view") == "map"
+}
+
 func (s *Server) assetThumb(path string, thumbSize int) (io.ReadSeeker, error) {
 	cacheName := ThumbName("assetthumb", thumbSize, path)
 	image, err := s.Assets.Open(path)
@@ -96,72 +429,148 @@ func (s *Server) assetThumb(path string, thumbSize int) (io.ReadSeeker, error) {
 
 func (s *Server) image(w http.ResponseWriter, r *http.Request) {
 	albumPath := path.Dir(r.URL.Path)
-	album, err := s.Provider.Album(albumPath, false)
+	album, err := s.lookupAlbum(albumPath)
 	if err != nil {
-		log.Printf("Failed to fetch album %s: %v\n", albumPath, err)
+		s.error(w, r, http.StatusNotFound, fmt.Errorf("Failed to fetch album %s: %v", albumPath, err))
 		return
 	}
 	image := album.Image(r.URL.Path)
+	if image == nil && album.Virtual {
+		// the photo may be in a real directory named like the virtual album
+		if real, err := s.Provider.Album(albumPath, false); err == nil {
+			album, image = real, real.Image(r.URL.Path)
+		}
+	}
+	noteAlbum(r, album)
 	if image == nil {
-		log.Printf("image %s not found\n", r.URL.Path)
+		s.error(w, r, http.StatusNotFound, fmt.Errorf("image %s not found", r.URL.Path))
+		return
+	}
+	if isPageView(r) {
+		album = s.Provider.withEmbedded(album, album.Sort.byTaken())
+		photo := *image
+		realPath := album.RealPath(photo.Path)
+		photo.Exif = s.Provider.Exif(realPath)
+		photo.Tags = mergeTags(append([]string(nil), image.Tags...), s.Provider.Keywords(realPath))
+		s.renderImage(w, r, album, &photo)
 		return
 	}
 	var content io.ReadSeeker
+	realPath := album.RealPath(r.URL.Path)
 	thumbSize, needThumb := isThumb(r)
+	tag := photoETag(realPath, image.Size, image.Time, thumbSize)
+	if notModified(w, r, tag, cachePhoto) {
+		return
+	}
 	if needThumb {
-		content, err = s.Provider.ImageThumb(r.URL.Path, thumbSize)
+		s.noteCache(r, ThumbName("thumb", thumbSize, realPath))
+		content, err = s.Provider.ImageThumb(realPath, thumbSize)
 		if err != nil {
 			content, err = s.assetThumb(albumPath, thumbSize)
 		}
 	} else {
-		content, err = s.Provider.ImageContent(r.URL.Path)
+		s.noteCache(r, CacheName("image", realPath))
+		content, err = s.Provider.ImageContent(realPath)
 	}
 	if err != nil {
-		log.Printf("failed to serve image %s: %v\n", r.URL.Path, err)
+		s.error(w, r, http.StatusInternalServerError, fmt.Errorf("failed to serve image %s: %v", r.URL.Path, err))
 		return
 	}
+	cacheable(w, tag, cachePhoto)
 	http.ServeContent(w, r, image.Name, image.Time, content)
 }
 
+// renderImage renders the page of a photo, which links to those either
+// side of it in its album.
+func (s *Server) renderImage(w http.ResponseWriter, r *http.Request, album *Album, image *Image) {
+	page := struct {
+		Album         *Album
+		Image         *Image
+		Prev          *Image
+		Next          *Image
+		URL           string
+		ImageURL      string
+		CanBrowseTags bool
+	}{
+		Album:         album,
+		Image:         image,
+		URL:           absoluteURL(s.baseURL(r), image.Path, "view=page"),
+		ImageURL:      absoluteURL(s.baseURL(r), image.Path, "thumb=1024"),
+		CanBrowseTags: s.Index != nil,
+	}
+	photos := album.Photos()
+	for i := range photos {
+		if photos[i].Path != image.Path {
+			continue
+		}
+		if i > 0 {
+			page.Prev = &photos[i-1]
+		}
+		if i < len(photos)-1 {
+			page.Next = &photos[i+1]
+		}
+		break
+	}
+	s.renderPage(w, r, "image.html", page)
+}
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	album := s.Index.Search(query)
+	s.renderAlbum(w, r, album, template.URL("&q="+url.QueryEscape(query)))
+}
+
+// worldMap renders a map of every photo in the gallery.
+func (s *Server) worldMap(w http.ResponseWriter, r *http.Request) {
+	s.renderMap(w, r, &Album{
+		Path:        mapPath,
+		Name:        "Map",
+		Breadcrumbs: []Breadcrumb{{Name: "Home", Path: "/"}, {Name: "Map", Path: mapPath}},
+		Images:      s.Index.Photos(),
+		Virtual:     true,
+		realPaths:   true,
+	}, "")
+}
+
+// route returns the kind of response the request is for, by which requests
+// are counted in the Server's Metrics.
+func route(r *http.Request) string {
+	if r.URL.Path == adminPath {
+		return "admin"
+	}
+	if _, ok := isThumb(r); ok {
+		return "thumb"
+	}
+	if IsImage(r.URL.Path) && !isPageView(r) {
+		return "original"
+	}
+	if isDownload(r) {
+		return "download"
+	}
+	return "album"
+}
+
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if IsImage(r.URL.Path) {
+	start := time.Now()
+	r, info := s.withRequestInfo(r)
+	w.Header().Set("X-Request-ID", info.id)
+	sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+	s.Metrics.Instrument(route(r), http.HandlerFunc(s.serve)).ServeHTTP(sw, r)
+	logRequest(r, sw.status, sw.bytes, time.Since(start))
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == adminPath && s.Admin != nil {
+		s.admin(w, r)
+	} else if r.URL.Path == searchPath && s.Index != nil {
+		s.search(w, r)
+	} else if r.URL.Path == mapPath && s.Index != nil {
+		s.worldMap(w, r)
+	} else if path.Base(r.URL.Path) == feedName {
+		s.feed(w, r)
+	} else if IsImage(r.URL.Path) {
 		s.image(w, r)
 	} else {
 		s.album(w, r)
 	}
 }
-
-var indexTemplate = template.Must(template.New("index.html").Parse(`
-<html>
-    <head>
-	<title>{{ .Album.Name }}</title>
-	<link type="text/css" rel="stylesheet" href="/css/lightgallery.css" />
-	<link type="text/css" rel="stylesheet" href="/css/galldir.css" />
-    </head>
-    <body>
-	<h1>{{ .Album.Name }}</h1>
-        <script src="/js/lightgallery.min.js"></script>
-        <script src="/js/lg-thumbnail.min.js"></script>
-        <script src="/js/lg-fullscreen.min.js"></script>
-	<div class="galldir-albums">
-	    {{ range .Album.Albums }}
-		<figure><p><a href="{{ .Path }}">
-			<img src="{{ .Path }}?thumb=250{{ $.Refresh }}" />
-			<figcaption>{{ .Name }}</figcaption>
-		</a></p></figure>
-	    {{ end }}
-	</div>
-	<div id="lightgallery">
-	{{ range .Album.Photos }}
-	    <a href="{{ .Path }}"><img src="{{ .Path }}?thumb=250" /></a>
-	{{ end }}
-	</div>
-    	<script>
-	    lightGallery(document.getElementById('lightgallery'), {
-		thumbnail:true,
-		animatedthumb:true
-	    });
-        </script>
-    </body>
-</html>
-`))
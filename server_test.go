@@ -0,0 +1,226 @@
+package galldir_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+	"github.com/jamesfcarter/galldir/data"
+)
+
+func testServer(t *testing.T) *galldir.Server {
+	t.Helper()
+
+	theme, err := galldir.NewTheme("", data.Assets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &galldir.Server{
+		Provider: galldir.NewProvider(http.Dir("testdata/album")),
+		Assets:   theme.Assets.FS,
+		Theme:    theme,
+	}
+}
+
+// shadowedServer returns an indexed server for a gallery holding a photo
+// in the directory at dir, such as "recent" or "timeline/holiday".
+func shadowedServer(t *testing.T, dir string) *galldir.Server {
+	t.Helper()
+
+	root := t.TempDir()
+	photo, err := os.ReadFile("testdata/sorted/img_2.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir = filepath.Join(root, filepath.FromSlash(dir))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "img_2.png"), photo, 0644); err != nil {
+		t.Fatal(err)
+	}
+	server := testServer(t)
+	server.Provider = galldir.NewProvider(http.Dir(root))
+	server.Index = galldir.NewIndex(server.Provider)
+	server.Index.Crawl()
+	return server
+}
+
+func TestServeAlbum(t *testing.T) {
+	tests := []struct {
+		url      string
+		status   int
+		contains string
+	}{
+		{"/", http.StatusOK, `<figcaption>Subalbum</figcaption>`},
+		{"/subalbum/", http.StatusOK, `<a href="/subalbum/icon.png" data-sub-html=`},
+		{"/subalbum/", http.StatusOK, `loading="lazy"`},
+		{"/subalbum/?page=2", http.StatusNotFound, "Not Found"},
+		{"/not_there/", http.StatusNotFound, "Not Found"},
+		{"/subalbum/not_there.png", http.StatusNotFound, "Not Found"},
+		{"/subalbum/icon.png?view=page", http.StatusOK, `<img src="/subalbum/icon.png?thumb=1024"`},
+	}
+	server := testServer(t)
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.status {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+}
+
+func TestServeAlbumJSON(t *testing.T) {
+	server := testServer(t)
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/subalbum/?format=json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+	var page struct {
+		Album struct {
+			Name        string
+			Breadcrumbs []galldir.Breadcrumb
+		}
+		Photos []galldir.Image
+		Page   int
+		Pages  int
+	}
+	if err := json.NewDecoder(w.Body).Decode(&page); err != nil {
+		t.Fatal(err)
+	}
+	if page.Album.Name != "Subalbum" || len(page.Album.Breadcrumbs) != 2 {
+		t.Errorf("unexpected album: %v", page.Album)
+	}
+	if len(page.Photos) != 1 || page.Photos[0].Path != "/subalbum/icon.png" {
+		t.Errorf("unexpected photos: %v", page.Photos)
+	}
+	if page.Page != 1 || page.Pages != 1 {
+		t.Errorf("unexpected page %d of %d", page.Page, page.Pages)
+	}
+}
+
+func TestServeImagePage(t *testing.T) {
+	tests := []struct {
+		url      string
+		contains string
+		excludes string
+	}{
+		{"/IMG_9.jpg?view=page", `<a rel="prev" href="/img_2.png?view=page">&larr; img_2.png</a>`, ""},
+		{"/IMG_9.jpg?view=page", `<a rel="next" href="/IMG_10.jpg?view=page">IMG_10.jpg &rarr;</a>`, ""},
+		{"/img_2.png?view=page", `rel="next"`, `rel="prev"`},
+		{"/IMG_10.jpg?view=page", `rel="prev"`, `rel="next"`},
+		{"/IMG_9.jpg?view=page", `<meta property="og:image" content="https://photos.example.com/IMG_9.jpg?thumb=1024" />`, ""},
+		{"/IMG_9.jpg?view=page", `<meta property="og:url" content="https://photos.example.com/IMG_9.jpg?view=page" />`, ""},
+		{"/IMG_9.jpg?view=page", `<a href="/IMG_9.jpg" download="IMG_9.jpg">`, ""},
+		{"/IMG_9.jpg?view=page", `<dt>Camera</dt><dd>Canon EOS 5D</dd>`, ""},
+		{"/", `data-sub-html="&lt;p&gt;&lt;a class=&#34;galldir-permalink&#34; href=&#34;/IMG_9.jpg?view=page&#34;&gt;`, ""},
+	}
+	server := testServer(t)
+	server.Provider = galldir.NewProvider(http.Dir("testdata/sorted"))
+	server.BaseURL = "https://photos.example.com"
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			body := w.Body.String()
+			if !strings.Contains(body, tc.contains) {
+				t.Errorf("missing %s: %s", tc.contains, body)
+			}
+			if tc.excludes != "" && strings.Contains(body, tc.excludes) {
+				t.Errorf("unexpected %s: %s", tc.excludes, body)
+			}
+		})
+	}
+}
+
+func TestServeAlbumPreview(t *testing.T) {
+	tests := []struct {
+		url      string
+		contains string
+	}{
+		{"/manifest/", `<meta property="og:title" content="Seaside" />`},
+		{"/manifest/", `<meta property="og:description" content="A day at the beach." />`},
+		{"/manifest/", `<meta property="og:image" content="https://photos.example.com/manifest/?thumb=1200" />`},
+		{"/manifest/", `<meta name="twitter:card" content="summary_large_image" />`},
+		{"/manifest/", `<link rel="canonical" href="https://photos.example.com/manifest/" />`},
+		{"/manifest/json/", `<meta property="og:description" content="0 photos" />`},
+	}
+	server := testServer(t)
+	server.Provider = galldir.NewProvider(http.Dir("testdata"))
+	server.BaseURL = "https://photos.example.com/"
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("missing %s: %s", tc.contains, body)
+			}
+		})
+	}
+}
+
+func TestServePathPrefix(t *testing.T) {
+	tests := []struct {
+		url       string
+		forwarded string
+		contains  string
+	}{
+		{"/subalbum/", "", `<a href="/photos/subalbum/icon.png" data-sub-html=`},
+		{"/subalbum/", "", `href=&#34;/photos/subalbum/icon.png?view=page&#34;`},
+		{"/subalbum/", "", `href="/photos/css/galldir.css?v=`},
+		{"/subalbum/", "", `<link rel="canonical" href="http://example.com/photos/subalbum/" />`},
+		{"/subalbum/", "", `false , "/photos");`},
+		{"/subalbum/", "/gallery/", `<a href="/gallery/subalbum/icon.png" data-sub-html=`},
+		{"/subalbum/icon.png?view=page", "", `<img src="/photos/subalbum/icon.png?thumb=1024"`},
+		{"/subalbum/feed.atom", "", `href="http://example.com/photos/subalbum/icon.png?view=page"`},
+		{"/not_there/", "", `<a href="/photos/">Back to the gallery</a>`},
+	}
+	server := testServer(t)
+	server.PathPrefix = "/photos"
+	server.TrustForwardedPrefix = true
+	for _, tc := range tests {
+		t.Run(tc.url+" "+tc.forwarded, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tc.url, nil)
+			if tc.forwarded != "" {
+				r.Header.Set("X-Forwarded-Prefix", tc.forwarded)
+			}
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, r)
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+}
+
+func TestCleanPathPrefix(t *testing.T) {
+	tests := []struct {
+		prefix   string
+		expected string
+	}{
+		{"", ""},
+		{"/", ""},
+		{"photos", "/photos"},
+		{"/photos/", "/photos"},
+		{"/a//b/", "/a/b"},
+	}
+	for _, tc := range tests {
+		if prefix := galldir.CleanPathPrefix(tc.prefix); prefix != tc.expected {
+			t.Errorf("%q: unexpected prefix: %q", tc.prefix, prefix)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package galldir
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const tagPath = "/tag/"
+
+// tagEscaper escapes the name of a tag for the path of its album, so that
+// a tag containing "/" stays in one segment of the path.
+var tagEscaper = strings.NewReplacer("%", "%25", "/", "%2F")
+
+// tagAlbumPath returns the path of the album of photos tagged with name.
+func tagAlbumPath(name string) string {
+	return tagPath + tagEscaper.Replace(name) + "/"
+}
+
+// tagName returns the name of the tag whose album path has the given
+// segment, or false if the segment is wrongly escaped.
+func tagName(segment string) (string, bool) {
+	name, err := url.PathUnescape(segment)
+	return name, err == nil
+}
+
+// tagBreadcrumbs leads from the root of the gallery to the list of tags.
+func tagBreadcrumbs() []Breadcrumb {
+	return []Breadcrumb{{Name: "Home", Path: "/"}, {Name: "Tags", Path: tagPath}}
+}
+
+// Tags returns a virtual album holding an album for each tag used in the
+// gallery, dated by the newest photo with the tag.
+func (x *Index) Tags() *Album {
+	tagged := x.currentViews().tagged
+	keys := make([]string, 0, len(tagged))
+	for key := range tagged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := &Album{
+		Path:        tagPath,
+		Name:        "Tags",
+		Breadcrumbs: tagBreadcrumbs(),
+		Sort:        SortByName,
+		Virtual:     true,
+	}
+	for _, key := range keys {
+		// the tag is named as it is on the first photo to have it
+		name := key
+		for _, tag := range tagged[key][0].Tags {
+			if strings.EqualFold(tag, key) {
+				name = tag
+				break
+			}
+		}
+		im := Image{Path: strings.TrimSuffix(tagAlbumPath(name), "/"), Name: name, IsAlbum: true}
+		for _, photo := range tagged[key] {
+			if photo.Taken().After(im.Time) {
+				im.Time = photo.Taken()
+			}
+		}
+		result.Images = append(result.Images, im)
+	}
+	return result
+}
+
+// Tag returns a virtual album of the photos tagged with name, ignoring
+// case, or false if there are none. Each photo's path in the album is its
+// real path prefixed by the album's.
+func (x *Index) Tag(name string) (*Album, bool) {
+	photos := x.currentViews().tagged[strings.ToLower(name)]
+	if len(photos) == 0 {
+		return nil, false
+	}
+	albumPath := tagAlbumPath(name)
+	result := &Album{
+		Path:        albumPath,
+		Name:        name,
+		Breadcrumbs: append(tagBreadcrumbs(), Breadcrumb{Name: name, Path: albumPath}),
+		Sort:        SortByTaken,
+		Virtual:     true,
+		Images:      make([]Image, 0, len(photos)),
+	}
+	for _, photo := range photos {
+		photo.Path = strings.TrimSuffix(albumPath, "/") + photo.Path
+		if result.Time.IsZero() || photo.Taken().Before(result.Time) {
+			result.Time = photo.Taken()
+		}
+		if photo.Taken().After(result.EndTime) {
+			result.EndTime = photo.Taken()
+		}
+		result.Images = append(result.Images, photo)
+	}
+	return result, true
+}
@@ -0,0 +1,181 @@
+package galldir_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestIndexTag(t *testing.T) {
+	tests := []struct {
+		tag   string
+		paths []string
+	}{
+		{"beach", []string{"/tag/beach/manifest/a.png", "/tag/beach/tagged/iptc.jpg", "/tag/beach/tagged/xmp.jpg"}},
+		{"SUNSET", []string{"/tag/SUNSET/tagged/sidecar.png", "/tag/SUNSET/tagged/xmp.jpg"}},
+		{"nothing", nil},
+	}
+	x := testIndex(t)
+	for _, tc := range tests {
+		t.Run(tc.tag, func(t *testing.T) {
+			album, ok := x.Tag(tc.tag)
+			if ok != (tc.paths != nil) {
+				t.Fatalf("unexpected tag existence: %v", ok)
+			}
+			if !ok {
+				return
+			}
+			if !album.Virtual {
+				t.Error("tag album not virtual")
+			}
+			var paths []string
+			for _, im := range album.Images {
+				paths = append(paths, im.Path)
+				if real := album.RealPath(im.Path); !strings.HasPrefix(real, "/manifest/") && !strings.HasPrefix(real, "/tagged/") {
+					t.Errorf("unexpected real path: %s", real)
+				}
+			}
+			sort.Strings(paths)
+			if strings.Join(paths, " ") != strings.Join(tc.paths, " ") {
+				t.Errorf("unexpected photos: %v", paths)
+			}
+		})
+	}
+}
+
+func TestIndexTags(t *testing.T) {
+	var names []string
+	for _, im := range testIndex(t).Tags().Albums() {
+		names = append(names, im.Name)
+	}
+	if strings.Join(names, ",") != "beach,family,harbour,pier,sandcastle,sunset" {
+		t.Errorf("unexpected tags: %v", names)
+	}
+}
+
+func TestServeTag(t *testing.T) {
+	theme, err := galldir.NewTheme("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := galldir.NewProvider(http.Dir("testdata"))
+	server := &galldir.Server{
+		Provider: provider,
+		Theme:    theme,
+		Index:    galldir.NewIndex(provider),
+	}
+	server.Index.Crawl()
+	tests := []struct {
+		url      string
+		status   int
+		contains string
+	}{
+		{"/tag/", http.StatusOK, `<a href="/tag/harbour">`},
+		{"/tag/harbour/", http.StatusOK, `<a href="/tag/harbour/tagged/iptc.jpg" data-sub-html=`},
+		{"/tag/nothing/", http.StatusNotFound, "Not Found"},
+		{"/tag/harbour/tagged/iptc.jpg?view=page", http.StatusOK, `<a href="/tag/harbour/">harbour</a>`},
+		{"/tag/harbour/tagged/iptc.jpg?view=page", http.StatusOK, `<a href="/tag/BEACH/">BEACH</a>`},
+		{"/tag/harbour/tagged/xmp.jpg", http.StatusNotFound, "Not Found"},
+		{"/tagged/iptc.jpg?view=page", http.StatusOK, `<a href="/tag/harbour/">harbour</a>`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.status {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, httptest.NewRequest("GET", "/tag/harbour/tagged/iptc.jpg", nil))
+	content, err := os.ReadFile("testdata/tagged/iptc.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusOK || w.Body.String() != string(content) {
+		t.Errorf("unexpected content of tagged photo: %d", w.Code)
+	}
+}
+
+func TestServeTagWithSlash(t *testing.T) {
+	theme, err := galldir.NewTheme("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := galldir.NewProvider(http.Dir("testdata"))
+	server := &galldir.Server{
+		Provider: provider,
+		Theme:    theme,
+		Index:    galldir.NewIndex(provider),
+	}
+	server.Index.Crawl()
+	album, err := provider.Album("/tagged/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the index sees a new tag as soon as the album is updated
+	tagged := *album
+	tagged.Images = append([]galldir.Image(nil), album.Images...)
+	for i := range tagged.Images {
+		tagged.Images[i].Tags = []string{"sun/sea"}
+	}
+	server.Index.Update(&tagged)
+
+	tests := []struct {
+		url      string
+		status   int
+		contains string
+	}{
+		{"/tag/", http.StatusOK, `<a href="/tag/sun%252Fsea">`},
+		{"/tag/sun%252Fsea/", http.StatusOK, `<a href="/tag/sun%252Fsea/tagged/iptc.jpg"`},
+		{"/tag/sun%252Fsea/tagged/iptc.jpg?view=page", http.StatusOK, `<a href="/tag/sun%252Fsea/">sun/sea</a>`},
+		{"/tag/sun/sea/", http.StatusNotFound, "Not Found"},
+		{"/tag/sun%25zz/", http.StatusNotFound, "Not Found"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.status {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+}
+
+func TestServeTagDirectory(t *testing.T) {
+	tests := []struct {
+		dir      string
+		url      string
+		contains string
+	}{
+		{"tag", "/tag/img_2.png", "PNG"},
+		{"tag/beach", "/tag/beach/", `href="/tag/beach/img_2.png"`},
+		{"tag/beach", "/tag/beach/img_2.png?view=page", `<h1>img_2.png</h1>`},
+	}
+	for _, tc := range tests {
+		server := shadowedServer(t, tc.dir)
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: unexpected status: %d", tc.url, w.Code)
+			continue
+		}
+		if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+			t.Errorf("%s: unexpected body: %s", tc.url, body)
+		}
+	}
+}
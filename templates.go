@@ -0,0 +1,271 @@
+package galldir
+
+// The default templates. Any of these may be replaced by a theme, see
+// NewTheme.
+var defaultTemplates = map[string]string{
+	"index.html": indexTemplate,
+	"image.html": imageTemplate,
+	"map.html":   mapTemplate,
+	"error.html": errorTemplate,
+	"admin.html": adminTemplate,
+}
+
+const indexTemplate = `
+<html>
+    <head>
+	<title>{{ .Album.Name }}</title>
+	<meta name="viewport" content="width=device-width, initial-scale=1" />
+	<meta property="og:type" content="website" />
+	<meta property="og:title" content="{{ .Album.Name }}" />
+	<meta property="og:description" content="{{ .Summary }}" />
+	<meta property="og:url" content="{{ .URL }}" />
+	<meta name="twitter:card" content="summary_large_image" />
+	<meta name="twitter:title" content="{{ .Album.Name }}" />
+	<meta name="twitter:description" content="{{ .Summary }}" />
+	{{ with .ImageURL }}
+	<meta property="og:image" content="{{ . }}" />
+	<meta name="twitter:image" content="{{ . }}" />
+	{{ end }}
+	<link rel="canonical" href="{{ .URL }}" />
+	<link type="text/css" rel="stylesheet" href="{{ asset "/css/lightgallery.css" }}" />
+	<link type="text/css" rel="stylesheet" href="{{ asset "/css/galldir.css" }}" />
+	{{ with .Feed }}<link rel="alternate" type="application/atom+xml" title="{{ $.Album.Name }}" href="{{ url . }}" />{{ end }}
+    </head>
+    <body>
+	{{ if .CanSearch }}
+	<form class="galldir-search" action="{{ url "/search" }}">
+	    <input type="search" name="q" value="{{ .Search }}" placeholder="Search" />
+	</form>
+	{{ end }}
+	{{ with .Album.Parent }}
+	<nav class="galldir-breadcrumbs">
+	    {{ range $i, $crumb := $.Album.Breadcrumbs }}
+		{{ if $i }} / {{ end }}<a href="{{ url .Path }}">{{ .Name }}</a>
+	    {{ end }}
+	    <a class="galldir-parent" href="{{ url .Path }}">Up to {{ .Name }}</a>
+	</nav>
+	{{ end }}
+	<h1>{{ .Album.Name }}</h1>
+	{{ if not .Album.EndTime.IsZero }}
+	<p class="galldir-date">{{ dateRange .Album.Time .Album.EndTime }}</p>
+	{{ end }}
+	{{ with .Album.Description }}
+	<div class="galldir-description">{{ . }}</div>
+	{{ end }}
+	{{ if or .Album.Prev .Album.Next }}
+	<nav class="galldir-sequence">
+	    {{ with .Album.Prev }}<a rel="prev" href="{{ url .Path }}">&larr; {{ .Name }}</a>{{ end }}
+	    {{ with .Album.Next }}<a rel="next" href="{{ url .Path }}">{{ .Name }} &rarr;</a>{{ end }}
+	</nav>
+	{{ end }}
+	{{ if .HasMap }}
+	<p class="galldir-map-link"><a href="?view=map{{ $.Query }}">Map of these photos</a></p>
+	{{ end }}
+	{{ if .CanDownload }}
+	<p class="galldir-download">
+	    Download <a href="?download=zip{{ $.Query }}">photos</a>
+	    {{ if .Album.Albums }}or <a href="?download=zip&recursive=1{{ $.Query }}">photos and albums</a>{{ end }}
+	    as ZIP
+	</p>
+	{{ end }}
+	<nav class="galldir-sort">
+	    Sort by
+	    {{ range .SortOrders }}
+		<a href="?sort={{ . }}{{ $.Query }}">{{ .Label }}</a>
+	    {{ end }}
+	</nav>
+        <script src="{{ asset "/js/lightgallery.min.js" }}"></script>
+        <script src="{{ asset "/js/lg-thumbnail.min.js" }}"></script>
+        <script src="{{ asset "/js/lg-fullscreen.min.js" }}"></script>
+        <script src="{{ asset "/js/galldir.js" }}"></script>
+	<div class="galldir-albums">
+	    {{ range .Albums }}
+		<figure><p><a href="{{ url .Path }}">
+			<img loading="lazy" src="{{ url .Path }}?thumb=250"
+			    srcset="{{ url .Path }}?thumb=250 1x, {{ url .Path }}?thumb=500 2x" />
+			<figcaption>{{ .Name }}{{ if not .EndTime.IsZero }}<br />{{ dateRange .Time .EndTime }}{{ end }}{{ with .Count }}<br />{{ . }} photo{{ if ne . 1 }}s{{ end }}{{ end }}</figcaption>
+		</a></p></figure>
+	    {{ end }}
+	</div>
+	<div id="lightgallery">
+	{{ range .Photos }}
+	    <a href="{{ url .Path }}" data-sub-html="{{ subHTML .Description (url .Path) }}"><img loading="lazy"
+		src="{{ url .Path }}?thumb=250" alt="{{ .Description }}"
+		srcset="{{ url .Path }}?thumb=250 1x, {{ url .Path }}?thumb=500 2x" /></a>
+	{{ end }}
+	</div>
+	{{ if gt .Pages 1 }}
+	<nav class="galldir-pages">
+	    {{ with .PrevPage }}<a rel="prev" href="?page={{ . }}{{ with $.Sort }}&sort={{ . }}{{ end }}{{ $.Query }}">Previous</a>{{ end }}
+	    Page {{ .Page }} of {{ .Pages }}
+	    {{ with .NextPage }}<a rel="next" href="?page={{ . }}{{ with $.Sort }}&sort={{ . }}{{ end }}{{ $.Query }}">Next</a>{{ end }}
+	</nav>
+	{{ end }}
+    	<script>
+	    galldir.init({
+		thumbnail:true,
+		animateThumb:true
+	    }, {{ .InfiniteScroll }}, {{ url "" }});
+        </script>
+    </body>
+</html>
+`
+
+const imageTemplate = `
+<html>
+    <head>
+	<title>{{ .Image.Name }} - {{ .Album.Name }}</title>
+	<meta name="viewport" content="width=device-width, initial-scale=1" />
+	<meta property="og:type" content="website" />
+	<meta property="og:title" content="{{ .Image.Name }}" />
+	{{ with .Image.Description }}<meta property="og:description" content="{{ . }}" />{{ end }}
+	<meta property="og:url" content="{{ .URL }}" />
+	<meta property="og:image" content="{{ .ImageURL }}" />
+	<meta name="twitter:card" content="summary_large_image" />
+	<meta name="twitter:title" content="{{ .Image.Name }}" />
+	<meta name="twitter:image" content="{{ .ImageURL }}" />
+	<link rel="canonical" href="{{ .URL }}" />
+	<link type="text/css" rel="stylesheet" href="{{ asset "/css/galldir.css" }}" />
+    </head>
+    <body>
+	<nav class="galldir-breadcrumbs">
+	    {{ range .Album.Breadcrumbs }}
+		<a href="{{ url .Path }}">{{ .Name }}</a> /
+	    {{ end }}
+	</nav>
+	<h1>{{ .Image.Name }}</h1>
+	{{ if or .Prev .Next }}
+	<nav class="galldir-sequence">
+	    {{ with .Prev }}<a rel="prev" href="{{ url .Path }}?view=page">&larr; {{ .Name }}</a>{{ end }}
+	    {{ with .Next }}<a rel="next" href="{{ url .Path }}?view=page">{{ .Name }} &rarr;</a>{{ end }}
+	</nav>
+	{{ end }}
+	<figure class="galldir-photo">
+	    <a href="{{ url .Image.Path }}"><img src="{{ url .Image.Path }}?thumb=1024" alt="{{ .Image.Description }}"
+		srcset="{{ url .Image.Path }}?thumb=1024 1x, {{ url .Image.Path }}?thumb=2048 2x" /></a>
+	    {{ with .Image.Description }}<figcaption>{{ . }}</figcaption>{{ end }}
+	</figure>
+	<p class="galldir-download"><a href="{{ url .Image.Path }}" download="{{ .Image.Name }}">Download original</a></p>
+	{{ with .Image.Tags }}
+	<ul class="galldir-tags">
+	    {{ range . }}
+		<li>{{ if $.CanBrowseTags }}<a href="{{ url (tagPath .) }}">{{ . }}</a>{{ else }}{{ . }}{{ end }}</li>
+	    {{ end }}
+	</ul>
+	{{ end }}
+	<dl class="galldir-metadata">
+	    {{ range metadata .Image }}
+		<dt>{{ .Name }}</dt><dd>{{ .Value }}</dd>
+	    {{ end }}
+	</dl>
+    </body>
+</html>
+`
+
+const mapTemplate = `
+<html>
+    <head>
+	<title>{{ .Album.Name }}</title>
+	<meta name="viewport" content="width=device-width, initial-scale=1" />
+	<link type="text/css" rel="stylesheet" href="{{ asset "/css/galldir.css" }}" />
+    </head>
+    <body>
+	<nav class="galldir-breadcrumbs">
+	    {{ range $i, $crumb := .Album.Breadcrumbs }}
+		{{ if $i }} / {{ end }}<a href="{{ url .Path }}">{{ .Name }}</a>
+	    {{ end }}
+	</nav>
+	<h1>{{ .Album.Name }}</h1>
+	<div id="galldir-map" class="galldir-map"></div>
+	<script src="{{ asset "/js/map.js" }}"></script>
+	<script>
+	    galldirMap.init(document.getElementById('galldir-map'), {
+		data: {{ .GeoJSON }},
+		tiles: {{ .TileURL }},
+		attribution: {{ .TileAttribution }},
+		base: {{ url "" }}
+	    });
+	</script>
+    </body>
+</html>
+`
+
+const errorTemplate = `
+<html>
+    <head>
+	<title>{{ .Status }} {{ .StatusText }}</title>
+	<link type="text/css" rel="stylesheet" href="{{ asset "/css/galldir.css" }}" />
+    </head>
+    <body>
+	<h1>{{ .StatusText }}</h1>
+	<p class="galldir-error">{{ .Path }}</p>
+	{{ with .Message }}<p>{{ . }}</p>{{ end }}
+	<p><a href="{{ url "/" }}">Back to the gallery</a></p>
+    </body>
+</html>
+`
+
+const adminTemplate = `
+<html>
+    <head>
+	<title>Administration</title>
+	<meta name="viewport" content="width=device-width, initial-scale=1" />
+	<link type="text/css" rel="stylesheet" href="{{ asset "/css/galldir.css" }}" />
+    </head>
+    <body class="galldir-admin">
+	<nav class="galldir-breadcrumbs"><a href="{{ url "/" }}">Back to the gallery</a></nav>
+	<h1>Administration</h1>
+	{{ with .Message }}<p class="galldir-message">{{ . }}</p>{{ end }}
+
+	<h2>Cache</h2>
+	<table>
+	    <tr><th>Class</th><th>Entries</th><th>Bytes</th></tr>
+	    {{ range .Cache }}
+	    <tr><td>{{ .Class }}</td><td>{{ .Entries }}</td><td>{{ .Bytes }}</td></tr>
+	    {{ end }}
+	</table>
+	<form method="post">
+	    <input type="hidden" name="token" value="{{ .Token }}" />
+	    <input type="hidden" name="action" value="invalidate" />
+	    <input type="text" name="path" value="/" />
+	    <button>Invalidate album and everything beneath it</button>
+	</form>
+	<form method="post">
+	    <input type="hidden" name="token" value="{{ .Token }}" />
+	    <input type="hidden" name="action" value="thumbs" />
+	    <button>Invalidate all thumbnails</button>
+	</form>
+
+	<h2>Crawl</h2>
+	{{ if .Indexed }}<p>{{ .Indexed }} albums are indexed.</p>{{ end }}
+	{{ if .Crawling }}
+	<p>A crawl of the gallery is running.</p>
+	{{ else }}
+	<form method="post">
+	    <input type="hidden" name="token" value="{{ .Token }}" />
+	    <input type="hidden" name="action" value="crawl" />
+	    <button>Crawl the gallery</button>
+	</form>
+	{{ end }}
+
+	<h2>Problems</h2>
+	{{ if .Problems }}
+	<table>
+	    <tr><th>Time</th><th>Kind</th><th>Path</th><th>Error</th></tr>
+	    {{ range .Problems }}
+	    <tr><td>{{ date "2006-01-02 15:04:05" .Time }}</td><td>{{ .Kind }}</td><td><a href="{{ url .Path }}">{{ .Path }}</a></td><td>{{ .Err }}</td></tr>
+	    {{ end }}
+	</table>
+	{{ else }}
+	<p>No problems have been found.</p>
+	{{ end }}
+
+	<h2>Configuration</h2>
+	<dl class="galldir-metadata">
+	    {{ range $name, $value := .Config }}
+		<dt>{{ $name }}</dt><dd>{{ $value }}</dd>
+	    {{ end }}
+	</dl>
+    </body>
+</html>
+`
@@ -0,0 +1,186 @@
+package galldir
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Theme holds the templates and static assets used to render the gallery.
+type Theme struct {
+	Assets *AssetServer
+	// templates are never executed themselves, only copied for each path
+	// the gallery is mounted at.
+	templates map[string]*template.Template
+	mutex     sync.Mutex
+	mounted   map[string]map[string]*template.Template
+}
+
+// maxMounts limits the number of paths for which copies of the templates
+// are kept, as the path may come from a request header.
+const maxMounts = 16
+
+// NewTheme loads a theme from dir. Templates named index.html, image.html,
+// map.html, error.html and admin.html in dir/templates replace the
+// defaults, and files in dir/assets are served in preference to those in
+// defaultAssets. An empty dir returns the default theme. It is an error for
+// a template to refer to an asset that does not exist.
+func NewTheme(dir string, defaultAssets http.FileSystem) (*Theme, error) {
+	assets := defaultAssets
+	if dir != "" {
+		assets = overlayFS{http.Dir(filepath.Join(dir, "assets")), defaultAssets}
+	}
+	t := &Theme{
+		Assets:    NewAssetServer(assets),
+		templates: make(map[string]*template.Template, len(defaultTemplates)),
+		mounted:   make(map[string]map[string]*template.Template),
+	}
+	funcs := t.funcs("")
+	for name, src := range defaultTemplates {
+		if dir != "" {
+			themeSrc, err := ioutil.ReadFile(filepath.Join(dir, "templates", name))
+			if err == nil {
+				src = string(themeSrc)
+			} else if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+		tmpl, err := template.New(name).Funcs(TemplateFuncs).Funcs(funcs).Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template %s: %v", name, err)
+		}
+		if err := t.Assets.checkAssets(tmpl.Tree); err != nil {
+			return nil, fmt.Errorf("template %s: %v", name, err)
+		}
+		t.templates[name] = tmpl
+	}
+	return t, nil
+}
+
+// Template returns the named template for a gallery at the root of its
+// site, or nil if there is no such template.
+func (t *Theme) Template(name string) *template.Template {
+	return t.MountedTemplate(name, "")
+}
+
+// MountedTemplate returns the named template for a gallery mounted at base,
+// such as "/photos", or nil if there is no such template. The template's
+// url and asset functions return paths beneath base.
+func (t *Theme) MountedTemplate(name, base string) *template.Template {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	templates, ok := t.mounted[base]
+	if !ok {
+		templates = make(map[string]*template.Template, len(t.templates))
+		for name, tmpl := range t.templates {
+			templates[name] = template.Must(tmpl.Clone()).Funcs(t.funcs(base))
+		}
+		if len(t.mounted) < maxMounts {
+			t.mounted[base] = templates
+		}
+	}
+	return templates[name]
+}
+
+// funcs returns the theme's own template functions for a gallery mounted
+// at base.
+func (t *Theme) funcs(base string) template.FuncMap {
+	return template.FuncMap{
+		"asset": func(name string) string { return base + t.Assets.URL(name) },
+		"url":   func(path string) string { return base + (&url.URL{Path: path}).EscapedPath() },
+	}
+}
+
+// overlayFS is an http.FileSystem that opens files from the first of its
+// members to contain them.
+type overlayFS []http.FileSystem
+
+func (o overlayFS) Open(name string) (http.File, error) {
+	err := error(os.ErrNotExist)
+	for _, fs := range o {
+		if fs == nil {
+			continue
+		}
+		var f http.File
+		f, err = fs.Open(name)
+		if err == nil {
+			return f, nil
+		}
+	}
+	return nil, err
+}
+
+// MetadataField is a single labelled piece of information about an Image.
+type MetadataField struct {
+	Name  string
+	Value string
+}
+
+const dateFormat = "2 January 2006"
+
+// TemplateFuncs are the helper functions available to all templates. Each
+// theme also provides an asset function that returns the fingerprinted URL
+// of one of its assets, and a url function that turns a path within the
+// gallery into an escaped URL path on the site the gallery is mounted in.
+var TemplateFuncs = template.FuncMap{
+	"date":        formatDate,
+	"dateRange":   formatDateRange,
+	"metadata":    Metadata,
+	"breadcrumbs": Breadcrumbs,
+	"subHTML":     subHTML,
+	"tagPath":     tagAlbumPath,
+}
+
+// formatDate formats t using layout, or the default date format if the
+// layout is empty. The zero time is formatted as an empty string.
+func formatDate(layout string, t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	if layout == "" {
+		layout = dateFormat
+	}
+	return t.Format(layout)
+}
+
+func formatLocation(l *Location) string {
+	if l == nil {
+		return ""
+	}
+	return fmt.Sprintf("%.5f, %.5f", l.Latitude, l.Longitude)
+}
+
+// Metadata returns the displayable information held about an Image.
+func Metadata(im Image) []MetadataField {
+	fields := []MetadataField{{"Name", im.Name}}
+	if im.Description != "" {
+		fields = append(fields, MetadataField{"Description", im.Description})
+	}
+	if !im.Time.IsZero() {
+		fields = append(fields, MetadataField{"Date", formatDate("", im.Time)})
+	}
+	if im.Exif == nil {
+		return fields
+	}
+	for _, field := range []MetadataField{
+		{"Taken", formatDate("2 January 2006 15:04", im.Exif.Taken)},
+		{"Camera", im.Exif.Camera},
+		{"Lens", im.Exif.Lens},
+		{"Exposure", im.Exif.ExposureTime},
+		{"Aperture", im.Exif.FNumber},
+		{"ISO", im.Exif.ISO},
+		{"Focal length", im.Exif.FocalLength},
+		{"Location", formatLocation(location(im))},
+	} {
+		if field.Value != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,98 @@
+package galldir_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestThemeTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"index.html", "<h1>Themed Foo</h1>"},
+		{"map.html", "<h1>Map of Foo</h1>"},
+		{"error.html", "<title>404 Not Found</title>"},
+	}
+	theme, err := galldir.NewTheme("testdata/theme", http.Dir("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := map[string]interface{}{
+		"Album":      &galldir.Album{Name: "Foo"},
+		"Status":     404,
+		"StatusText": "Not Found",
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			err := theme.Template(tc.name).Execute(buf, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(buf.String(), tc.expected) {
+				t.Errorf("unexpected output: %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestThemeAssets(t *testing.T) {
+	tests := []struct {
+		path      string
+		content   string
+		expectErr bool
+	}{
+		{"/css/galldir.css", "h1 { color: red; }\n", false},
+		{"/hello", "hello", false},
+		{"/not_there", "", true},
+	}
+	theme, err := galldir.NewTheme("testdata/theme", http.Dir("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			f, err := theme.Assets.FS.Open(tc.path)
+			if err == nil && tc.expectErr {
+				t.Fatal("expected an error")
+			}
+			if err != nil && !tc.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			defer f.Close()
+			content, _ := ioutil.ReadAll(f)
+			if string(content) != tc.content {
+				t.Errorf("unexpected content: %s", content)
+			}
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	fields := galldir.Metadata(galldir.Image{
+		Name: "foo.jpg",
+		Time: time.Date(2019, 3, 26, 11, 30, 0, 0, time.UTC),
+	})
+	expected := []galldir.MetadataField{
+		{Name: "Name", Value: "foo.jpg"},
+		{Name: "Date", Value: "26 March 2019"},
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("unexpected number of fields: %d", len(fields))
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("unexpected field: %v", fields[i])
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package galldir
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const timelinePath = "/timeline/"
+
+// timelinePeriod is a year, or a month of a year, of the timeline.
+type timelinePeriod struct {
+	year  int
+	month time.Month
+}
+
+func (tp timelinePeriod) path() string {
+	if tp.month == 0 {
+		return fmt.Sprintf("%s%04d/", timelinePath, tp.year)
+	}
+	return fmt.Sprintf("%s%04d/%02d/", timelinePath, tp.year, tp.month)
+}
+
+func (tp timelinePeriod) name() string {
+	if tp.month == 0 {
+		return strconv.Itoa(tp.year)
+	}
+	return fmt.Sprintf("%s %d", tp.month, tp.year)
+}
+
+func (tp timelinePeriod) start() time.Time {
+	month := tp.month
+	if month == 0 {
+		month = time.January
+	}
+	return time.Date(tp.year, month, 1, 0, 0, 0, 0, time.Local)
+}
+
+func (tp timelinePeriod) breadcrumb() Breadcrumb {
+	return Breadcrumb{Name: tp.name(), Path: tp.path()}
+}
+
+// parseTimelinePath returns the period of the timeline at path, which may
+// continue beyond the period to the real path of a photo. The root of the
+// timeline has a zero year.
+func parseTimelinePath(path string) (timelinePeriod, bool) {
+	var tp timelinePeriod
+	parts := strings.SplitN(strings.TrimPrefix(path+"/", timelinePath), "/", 3)
+	if parts[0] == "" {
+		return tp, true
+	}
+	year, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts[0]) != 4 {
+		return tp, false
+	}
+	tp.year = year
+	if len(parts) < 2 || parts[1] == "" {
+		return tp, true
+	}
+	month, err := strconv.Atoi(parts[1])
+	if err != nil || len(parts[1]) != 2 || month < 1 || month > 12 {
+		return tp, false
+	}
+	tp.month = time.Month(month)
+	return tp, true
+}
+
+// Timeline returns a virtual album of the timeline at path, grouping every
+// photo in the gallery by the year and month it was taken. The root of the
+// timeline holds an album for each year, each year an album for each of
+// its months and each month its photos. It returns false if there are no
+// photos in the period.
+func (x *Index) Timeline(path string) (*Album, bool) {
+	tp, ok := parseTimelinePath(path)
+	if !ok {
+		return nil, false
+	}
+	views := x.currentViews()
+	result := &Album{
+		Path:        timelinePath,
+		Name:        "Timeline",
+		Breadcrumbs: []Breadcrumb{{Name: "Home", Path: "/"}, {Name: "Timeline", Path: timelinePath}},
+		Virtual:     true,
+	}
+	if tp.month != 0 {
+		for _, photo := range views.months[tp] {
+			photo.Path = strings.TrimSuffix(tp.path(), "/") + photo.Path
+			result.Images = append(result.Images, photo)
+		}
+	} else {
+		for _, period := range views.periods(tp.year) {
+			result.Images = append(result.Images, Image{
+				Path:    strings.TrimSuffix(period.path(), "/"),
+				Name:    period.name(),
+				Time:    period.start(),
+				IsAlbum: true,
+				Count:   views.count(period),
+			})
+		}
+	}
+	if len(result.Images) == 0 {
+		return nil, false
+	}
+	if tp.year == 0 {
+		return result, true
+	}
+	result.Path = tp.path()
+	result.Name = tp.name()
+	result.Time = tp.start()
+	if tp.month == 0 {
+		result.Sort = SortByTimeReversed
+	} else {
+		result.Breadcrumbs = append(result.Breadcrumbs, timelinePeriod{year: tp.year}.breadcrumb())
+		result.Sort = SortByTaken
+	}
+	result.Breadcrumbs = append(result.Breadcrumbs, tp.breadcrumb())
+	result.Prev, result.Next = views.timelineNeighbours(tp)
+	return result, true
+}
+
+// periods returns, from the earliest, the years holding photos or, if year
+// is set, the months of that year holding photos.
+func (v *indexViews) periods(year int) []timelinePeriod {
+	var periods []timelinePeriod
+	for _, month := range v.monthOrder {
+		period := timelinePeriod{year: month.year}
+		if year != 0 {
+			if month.year != year {
+				continue
+			}
+			period.month = month.month
+		}
+		if len(periods) == 0 || periods[len(periods)-1] != period {
+			periods = append(periods, period)
+		}
+	}
+	return periods
+}
+
+// count returns the number of photos taken in the period.
+func (v *indexViews) count(tp timelinePeriod) int {
+	if tp.month != 0 {
+		return len(v.months[tp])
+	}
+	count := 0
+	for _, month := range v.monthOrder {
+		if month.year == tp.year {
+			count += len(v.months[month])
+		}
+	}
+	return count
+}
+
+// timelineNeighbours returns the periods either side of tp that hold
+// photos.
+func (v *indexViews) timelineNeighbours(tp timelinePeriod) (*Breadcrumb, *Breadcrumb) {
+	var prevCrumb, nextCrumb *Breadcrumb
+	for _, month := range v.monthOrder {
+		period := month
+		if tp.month == 0 {
+			period.month = 0
+		}
+		crumb := period.breadcrumb()
+		switch start := period.start(); {
+		case start.Before(tp.start()):
+			prevCrumb = &crumb
+		case start.After(tp.start()) && nextCrumb == nil:
+			nextCrumb = &crumb
+		}
+	}
+	return prevCrumb, nextCrumb
+}
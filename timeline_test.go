@@ -0,0 +1,130 @@
+package galldir_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jamesfcarter/galldir"
+)
+
+func TestIndexTimeline(t *testing.T) {
+	tests := []struct {
+		path   string
+		name   string
+		images []string
+		prev   string
+		next   string
+	}{
+		{"/timeline/2019", "2019", []string{"/timeline/2019/06"}, "", "/timeline/2020/"},
+		{"/timeline/2019/06/", "June 2019", []string{"/timeline/2019/06/sorted/IMG_10.jpg", "/timeline/2019/06/sorted/IMG_9.jpg"}, "", "/timeline/2020/07/"},
+		{"/timeline/2020/07", "July 2020", []string{"/timeline/2020/07/tagged/xmp.jpg"}, "/timeline/2019/06/", ""},
+		{"/timeline/2019/07/", "", nil, "", ""},
+		{"/timeline/1999/", "", nil, "", ""},
+		{"/timeline/2019/6/", "", nil, "", ""},
+		{"/timeline/nineteen/", "", nil, "", ""},
+	}
+	x := testIndex(t)
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			album, ok := x.Timeline(tc.path)
+			if ok != (tc.images != nil) {
+				t.Fatalf("unexpected existence: %v", ok)
+			}
+			if !ok {
+				return
+			}
+			if album.Name != tc.name {
+				t.Errorf("unexpected name: %s", album.Name)
+			}
+			var paths []string
+			for _, im := range album.Images {
+				paths = append(paths, im.Path)
+			}
+			sort.Strings(paths)
+			if strings.Join(paths, " ") != strings.Join(tc.images, " ") {
+				t.Errorf("unexpected images: %v", paths)
+			}
+			if album.Prev != nil && album.Prev.Path != tc.prev || album.Prev == nil && tc.prev != "" {
+				t.Errorf("unexpected previous: %v", album.Prev)
+			}
+			if tc.next != "" && (album.Next == nil || album.Next.Path != tc.next) {
+				t.Errorf("unexpected next: %v", album.Next)
+			}
+		})
+	}
+}
+
+func TestIndexTimelineCounts(t *testing.T) {
+	album, ok := testIndex(t).Timeline("/timeline/")
+	if !ok {
+		t.Fatal("no timeline")
+	}
+	counts := make(map[string]int)
+	for _, im := range album.Albums() {
+		counts[im.Name] = im.Count
+	}
+	if counts["2019"] != 2 || counts["2020"] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+}
+
+func TestServeTimeline(t *testing.T) {
+	server := testServer(t)
+	server.Provider = galldir.NewProvider(http.Dir("testdata/sorted"))
+	server.Index = galldir.NewIndex(server.Provider)
+	server.Index.Crawl()
+	tests := []struct {
+		url      string
+		status   int
+		contains string
+	}{
+		{"/timeline/", http.StatusOK, `<a href="/timeline/2019">`},
+		{"/timeline/2019/", http.StatusOK, `June 2019<br />2 photos`},
+		{"/timeline/2019/06/", http.StatusOK, `<a href="/timeline/2019/06/IMG_9.jpg" data-sub-html=`},
+		{"/timeline/2019/06/IMG_9.jpg?view=page", http.StatusOK, `<h1>IMG_9.jpg</h1>`},
+		{"/timeline/2019/05/", http.StatusNotFound, "Not Found"},
+		{"/timeline/2019/06/anything", http.StatusNotFound, "Not Found"},
+		{"/timeline/2019/06/anything/feed.atom", http.StatusNotFound, "Not Found"},
+		{"/timeline/2019/06/feed.atom", http.StatusOK, "<title>June 2019</title>"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+			if w.Code != tc.status {
+				t.Fatalf("unexpected status: %d", w.Code)
+			}
+			if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+				t.Errorf("unexpected body: %s", body)
+			}
+		})
+	}
+}
+
+func TestServeTimelineDirectory(t *testing.T) {
+	tests := []struct {
+		dir      string
+		url      string
+		contains string
+	}{
+		{"timeline", "/timeline/img_2.png", "PNG"},
+		{"timeline", "/timeline/img_2.png?view=page", `<h1>img_2.png</h1>`},
+		{"timeline/holiday", "/timeline/holiday/", `href="/timeline/holiday/img_2.png"`},
+		{"timeline/holiday", "/timeline/holiday/img_2.png", "PNG"},
+	}
+	for _, tc := range tests {
+		server := shadowedServer(t, tc.dir)
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest("GET", tc.url, nil))
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: unexpected status: %d", tc.url, w.Code)
+			continue
+		}
+		if body := w.Body.String(); !strings.Contains(body, tc.contains) {
+			t.Errorf("%s: unexpected body: %s", tc.url, body)
+		}
+	}
+}
@@ -35,3 +35,27 @@ func NameFromPath(path string) string {
 	}
 	return strings.Title(string(str))
 }
+
+// Breadcrumb is a link to one of the albums leading to a path.
+type Breadcrumb struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// Breadcrumbs returns a Breadcrumb for each album from the root of the
+// gallery down to and including path.
+func Breadcrumbs(path string) []Breadcrumb {
+	crumbs := []Breadcrumb{{Name: "Home", Path: "/"}}
+	crumbPath := "/"
+	for _, dir := range strings.Split(strings.Trim(path, "/"), "/") {
+		if dir == "" {
+			continue
+		}
+		crumbPath += dir + "/"
+		crumbs = append(crumbs, Breadcrumb{
+			Name: NameFromPath(dir),
+			Path: crumbPath,
+		})
+	}
+	return crumbs
+}
@@ -44,3 +44,26 @@ func TestNameFromPath(t *testing.T) {
 		})
 	}
 }
+
+func TestBreadcrumbs(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected []string
+	}{
+		{"/", []string{"Home /"}},
+		{"/foo_bar/2018_01_01", []string{"Home /", "Foo Bar /foo_bar/", "2018-01-01 /foo_bar/2018_01_01/"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			crumbs := galldir.Breadcrumbs(tc.path)
+			if len(crumbs) != len(tc.expected) {
+				t.Fatalf("unexpected number of breadcrumbs: %d", len(crumbs))
+			}
+			for i, crumb := range crumbs {
+				if r := crumb.Name + " " + crumb.Path; r != tc.expected[i] {
+					t.Errorf("unexpected breadcrumb: %s", r)
+				}
+			}
+		})
+	}
+}